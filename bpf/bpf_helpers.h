@@ -39,6 +39,14 @@ static int (*bpf_perf_event_output)(void *ctx, void *map,
 				    unsigned long long flags, void *data,
 				    int size) =
 	(void *) BPF_FUNC_perf_event_output;
+/* ring buffer helpers, available since linux 5.8.
+ * The ids are not part of the bundled uapi headers.
+ */
+static void *(*bpf_ringbuf_reserve)(void *ringbuf, unsigned long long size,
+				    unsigned long long flags) =
+	(void *) 131;
+static void (*bpf_ringbuf_submit)(void *data, unsigned long long flags) =
+	(void *) 132;
 static int (*bpf_skb_get_tunnel_key)(void *ctx, void *key, int size, int flags) =
 	(void *) BPF_FUNC_skb_get_tunnel_key;
 static int (*bpf_skb_set_tunnel_key)(void *ctx, void *key, int size, int flags) =
@@ -155,18 +163,18 @@ static int (*bpf_l4_csum_replace)(void *ctx, int off, int from, int to, int flag
 # define memcpy(dest, src, n)   __builtin_memcpy((dest), (src), (n))
 #endif
 
-#if __BYTE_ORDER == __LITTLE_ENDIAN
+#if __BYTE_ORDER__ == __ORDER_LITTLE_ENDIAN__
 # define __bpf_ntohs(x)		__builtin_bswap16(x)
 # define __bpf_htons(x)		__builtin_bswap16(x)
 # define __bpf_ntohl(x)		__builtin_bswap32(x)
 # define __bpf_htonl(x)		__builtin_bswap32(x)
-#elif __BYTE_ORDER == __BIG_ENDIAN
+#elif __BYTE_ORDER__ == __ORDER_BIG_ENDIAN__
 # define __bpf_ntohs(x)		(x)
 # define __bpf_htons(x)		(x)
 # define __bpf_ntohl(x)		(x)
 # define __bpf_htonl(x)		(x)
 #else
-# error "Fix your __BYTE_ORDER?!"
+# error "Fix your __BYTE_ORDER__?!"
 #endif
 
 #define bpf_htons(x)				\
@@ -14,12 +14,41 @@
 #include "bpf_helpers.h"
 
 #define SAMPLE_SIZE 128u
+// dns answers do not fit into SAMPLE_SIZE
+#define DNS_SAMPLE_SIZE 512u
+#define DNS_PORT 53
+// the server name of a tls ClientHello may be anywhere
+// in the extensions: sample the whole segment
+#define TLS_SAMPLE_SIZE 1500u
+#define TLS_CONTENT_HANDSHAKE 0x16
+// the host and the other headers of http
+// requests and responses follow the first line
+#define HTTP_SAMPLE_SIZE 1024u
 #define ETH_HLEN 14
 
+// the tc hook which captured the packet
+#define DIRECTION_INGRESS 0
+#define DIRECTION_EGRESS 1
+
 #ifndef __packed
 #define __packed __attribute__((packed))
 #endif
 
+// the program is attached in direct-action mode: TC_ACT_UNSPEC
+// continues with the next filter instead of accepting the packet
+#ifndef TC_ACT_UNSPEC
+#define TC_ACT_UNSPEC -1
+#endif
+
+#ifndef BPF_MAP_TYPE_RINGBUF
+#define BPF_MAP_TYPE_RINGBUF 27
+#endif
+
+#define TCP_FLAG_FIN 0x01
+#define TCP_FLAG_SYN 0x02
+#define TCP_FLAG_RST 0x04
+#define TCP_FLAGS_OFFSET 13
+
 #define min(x, y) ((x) < (y) ? (x) : (y))
 
 #define bpf_printk(fmt, ...)					\
@@ -33,6 +62,10 @@
 struct trace_metadata {
     __u32 ifindex;
     __u16 pkt_len;
+    __u64 ktime_ns; // CLOCK_MONOTONIC
+    __u8 direction;
+    __u16 cap_len; // sampled bytes following the metadata
+    __u32 sample_rate; // packets of the flow represented by the sample
 } __packed;
 
 struct bpf_map_def SEC("maps/EVENTS_MAP") EVENTS_MAP = {
@@ -42,26 +75,353 @@ struct bpf_map_def SEC("maps/EVENTS_MAP") EVENTS_MAP = {
     .max_entries = 0, // this is changed at runtime to num cpus
 };
 
-static __always_inline void send_trace(struct __sk_buff *skb, __u64 sample_size) {
+// the ring buffer replaces EVENTS_MAP if the kernel supports it.
+// The userspace removes the maps and programs which are not used.
+struct bpf_map_def SEC("maps/EVENTS_RINGBUF") EVENTS_RINGBUF = {
+    .type = BPF_MAP_TYPE_RINGBUF,
+    .key_size = 0,
+    .value_size = 0,
+    .max_entries = 0, // this is changed at runtime to the buffer size
+};
+
+// RINGBUF_LOST counts the samples which did not fit into the ring buffer
+struct bpf_map_def SEC("maps/RINGBUF_LOST") RINGBUF_LOST = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(__u32),
+    .value_size = sizeof(__u64),
+    .max_entries = 1,
+};
+
+// actions of the filter maps
+#define FILTER_INCLUDE 1
+#define FILTER_EXCLUDE 2
+
+// tracer_config is written by the agent before the programs are attached
+struct tracer_config {
+    // keep per flow counters and emit samples only for
+    // connection events and packets with payload
+    __u32 aggregate;
+    // the union of the actions in each filter map,
+    // the lookups are skipped if a map is empty
+    __u32 cidr_filters;
+    __u32 port_filters;
+    __u32 protocol_filters;
+    // sample 1 in sample_rate packets, 0 and 1 sample all packets
+    __u32 sample_rate;
+    __u32 pad;
+    // per flow token bucket: a sample costs flow_token_ns of credit,
+    // the credit is capped at flow_burst_ns. 0 disables the limit.
+    __u64 flow_token_ns;
+    __u64 flow_burst_ns;
+};
+
+struct bpf_map_def SEC("maps/CONFIG") CONFIG = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(__u32),
+    .value_size = sizeof(struct tracer_config),
+    .max_entries = 1,
+};
+
+// flow_key is one direction of a flow on an interface
+struct flow_key {
+    __u32 ifindex;
+    __u8 saddr[16]; // ipv4 addresses use the first 4 bytes
+    __u8 daddr[16];
+    __be16 sport;
+    __be16 dport;
+    __u8 proto;
+    __u8 ip_version;
+    __u8 direction;
+    __u8 pad;
+};
+
+struct flow_stats {
+    __u64 packets;
+    __u64 bytes;
+    __u64 first_seen_ns; // CLOCK_MONOTONIC
+    __u64 last_seen_ns;
+    __u8 tcp_flags; // union of the flags of all segments
+    __u8 pad[7];
+};
+
+struct bpf_map_def SEC("maps/FLOWS") FLOWS = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(struct flow_key),
+    .value_size = sizeof(struct flow_stats),
+    .max_entries = 0, // this is changed at runtime
+};
+
+// cidr_key is the key of the cidr filters. The prefix
+// includes the ip version, so both versions share one trie.
+struct cidr_key {
+    __u32 prefixlen;
+    __u8 data[20]; // ip version followed by the address
+};
+
+// the filter maps are written by the agent at any time,
+// the values are FILTER_INCLUDE or FILTER_EXCLUDE
+struct bpf_map_def SEC("maps/FILTER_CIDRS") FILTER_CIDRS = {
+    .type = BPF_MAP_TYPE_LPM_TRIE,
+    .key_size = sizeof(struct cidr_key),
+    .value_size = sizeof(__u8),
+    .max_entries = 1024,
+    .map_flags = BPF_F_NO_PREALLOC,
+};
+
+// the keys are ports in host byte order
+struct bpf_map_def SEC("maps/FILTER_PORTS") FILTER_PORTS = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(__u16),
+    .value_size = sizeof(__u8),
+    .max_entries = 1024,
+};
+
+struct bpf_map_def SEC("maps/FILTER_PROTOCOLS") FILTER_PROTOCOLS = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(__u8),
+    .value_size = sizeof(__u8),
+    .max_entries = 256,
+};
+
+// flow_sampling is the sampling state of a flow
+struct flow_sampling {
+    __u64 credit_ns;
+    __u64 last_ns;
+    __u32 skipped; // packets which were not sampled since the last sample
+    __u8 payload_seen;
+    __u8 pad[3];
+};
+
+struct bpf_map_def SEC("maps/SAMPLING") SAMPLING = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(struct flow_key),
+    .value_size = sizeof(struct flow_sampling),
+    .max_entries = 0, // this is changed at runtime
+};
+
+static __always_inline struct tracer_config *get_config() {
+    __u32 key = 0;
+    return bpf_map_lookup_elem(&CONFIG, &key);
+}
+
+static __always_inline __u8 lookup_cidr(__u8 ip_version, __u8 *addr) {
+    struct cidr_key key = {
+        .prefixlen = 8 + (ip_version == 4 ? 32 : 128),
+    };
+    key.data[0] = ip_version;
+    __builtin_memcpy(&key.data[1], addr, 16);
+    __u8 *action = bpf_map_lookup_elem(&FILTER_CIDRS, &key);
+    return action != NULL ? *action : 0;
+}
+
+static __always_inline __u8 lookup_port(__be16 port) {
+    __u16 key = bpf_ntohs(port);
+    __u8 *action = bpf_map_lookup_elem(&FILTER_PORTS, &key);
+    return action != NULL ? *action : 0;
+}
+
+// matches_filter applies the actions of the source and destination:
+// an excluded endpoint drops the packet. If the filter has includes,
+// at least one endpoint must be included.
+static __always_inline int matches_filter(__u32 filters, __u8 src, __u8 dst) {
+    if (src == FILTER_EXCLUDE || dst == FILTER_EXCLUDE) {
+        return 0;
+    }
+    return !(filters & FILTER_INCLUDE) || src == FILTER_INCLUDE || dst == FILTER_INCLUDE;
+}
+
+// filter_packet returns 0 if the packet must not be sampled.
+// Packets without ports do not match included ports.
+static __always_inline int filter_packet(struct tracer_config *config, struct flow_key *key, int has_ports) {
+    if (config == NULL) {
+        return 1;
+    }
+    if (config->protocol_filters) {
+        __u8 *action = bpf_map_lookup_elem(&FILTER_PROTOCOLS, &key->proto);
+        __u8 proto = action != NULL ? *action : 0;
+        if (!matches_filter(config->protocol_filters, proto, proto)) {
+            return 0;
+        }
+    }
+    if (config->cidr_filters) {
+        __u8 src = lookup_cidr(key->ip_version, key->saddr);
+        __u8 dst = lookup_cidr(key->ip_version, key->daddr);
+        if (!matches_filter(config->cidr_filters, src, dst)) {
+            return 0;
+        }
+    }
+    if (config->port_filters) {
+        __u8 src = has_ports ? lookup_port(key->sport) : 0;
+        __u8 dst = has_ports ? lookup_port(key->dport) : 0;
+        if (!matches_filter(config->port_filters, src, dst)) {
+            return 0;
+        }
+    }
+    return 1;
+}
+
+// update_flow adds the packet to the counters of its flow
+static __always_inline void update_flow(struct flow_key *key, __u64 len, __u8 tcp_flags) {
+    __u64 now = bpf_ktime_get_ns();
+    struct flow_stats *stats = bpf_map_lookup_elem(&FLOWS, key);
+    if (stats == NULL) {
+        struct flow_stats init = {
+            .packets = 1,
+            .bytes = len,
+            .first_seen_ns = now,
+            .last_seen_ns = now,
+            .tcp_flags = tcp_flags,
+        };
+        // a concurrent insert of the same flow wins
+        bpf_map_update_elem(&FLOWS, key, &init, BPF_NOEXIST);
+        return;
+    }
+    __sync_fetch_and_add(&stats->packets, 1);
+    __sync_fetch_and_add(&stats->bytes, len);
+    stats->last_seen_ns = now;
+    stats->tcp_flags |= tcp_flags;
+}
+
+// sample_packet returns the number of packets of the flow which the sample
+// represents, 0 if the packet is not sampled. The first packet with payload
+// of each flow is always sampled, the L7 parsers need it. So are TCP SYN,
+// FIN and RST segments, the connection events must not be lost.
+static __always_inline __u32 sample_packet(struct tracer_config *config, struct flow_key *key, int has_payload, int control) {
+    if (config == NULL || (config->sample_rate <= 1 && config->flow_token_ns == 0)) {
+        return 1;
+    }
+    __u64 now = bpf_ktime_get_ns();
+    struct flow_sampling *state = bpf_map_lookup_elem(&SAMPLING, key);
+    if (state == NULL) {
+        struct flow_sampling init = {
+            .credit_ns = config->flow_burst_ns,
+            .last_ns = now,
+        };
+        bpf_map_update_elem(&SAMPLING, key, &init, BPF_NOEXIST);
+        state = bpf_map_lookup_elem(&SAMPLING, key);
+        if (state == NULL) {
+            return 1;
+        }
+    }
+    int keep = 1;
+    if (has_payload && !state->payload_seen) {
+        state->payload_seen = 1;
+    } else if (control) {
+        // connection events bypass the sampling and the rate limit
+    } else if (config->sample_rate > 1 && bpf_get_prandom_u32() % config->sample_rate != 0) {
+        keep = 0;
+    } else if (config->flow_token_ns) {
+        __u64 credit = state->credit_ns + (now - state->last_ns);
+        if (credit > config->flow_burst_ns) {
+            credit = config->flow_burst_ns;
+        }
+        if (credit < config->flow_token_ns) {
+            keep = 0;
+        } else {
+            credit -= config->flow_token_ns;
+        }
+        state->credit_ns = credit;
+        state->last_ns = now;
+    }
+    if (!keep) {
+        __sync_fetch_and_add(&state->skipped, 1);
+        return 0;
+    }
+    // concurrent updates of other cpus may be lost
+    __u32 weight = state->skipped + 1;
+    state->skipped = 0;
+    return weight;
+}
+
+static __always_inline int is_dns(__be16 source, __be16 dest) {
+    return source == bpf_htons(DNS_PORT) || dest == bpf_htons(DNS_PORT);
+}
+
+static __always_inline int is_tls_handshake(struct __sk_buff *skb, __u32 offset) {
+    __u8 hdr[2];
+    if (bpf_skb_load_bytes(skb, offset, hdr, sizeof(hdr)) < 0) {
+        return 0;
+    }
+    // record type handshake, major version 3
+    return hdr[0] == TLS_CONTENT_HANDSHAKE && hdr[1] == 0x03;
+}
+
+// has_prefix compares the first four bytes of buf with the string s
+#define has_prefix(buf, s) \
+    ((buf)[0] == (s)[0] && (buf)[1] == (s)[1] && (buf)[2] == (s)[2] && (buf)[3] == (s)[3])
+
+static __always_inline int is_http(struct __sk_buff *skb, __u32 offset) {
+    char hdr[4];
+    if (bpf_skb_load_bytes(skb, offset, hdr, sizeof(hdr)) < 0) {
+        return 0;
+    }
+    // request methods and the version of the status line
+    return has_prefix(hdr, "GET ") || has_prefix(hdr, "POST") ||
+        has_prefix(hdr, "PUT ") || has_prefix(hdr, "HEAD") ||
+        has_prefix(hdr, "DELE") || has_prefix(hdr, "PATC") ||
+        has_prefix(hdr, "OPTI") || has_prefix(hdr, "CONN") ||
+        has_prefix(hdr, "TRAC") || has_prefix(hdr, "HTTP");
+}
+
+static __always_inline void perf_output(struct __sk_buff *skb, struct trace_metadata *metadata, __u64 sample_size) {
+    int ret = bpf_perf_event_output(skb, &EVENTS_MAP,
+            (sample_size << 32) | BPF_F_CURRENT_CPU,
+            metadata, sizeof(*metadata));
+    if (ret != 0) {
+        bpf_printk("trace failed: %d\n", ret);
+    }
+}
+
+// ringbuf_output copies the sample into a ring buffer record.
+// The reserved size must be constant, size is one of the sample sizes.
+static __always_inline void ringbuf_output(struct __sk_buff *skb, struct trace_metadata *metadata, __u64 sample_size, const __u64 size) {
+    struct trace_metadata *event = bpf_ringbuf_reserve(&EVENTS_RINGBUF, sizeof(*metadata) + size, 0);
+    if (event == NULL) {
+        __u32 key = 0;
+        __u64 *lost = bpf_map_lookup_elem(&RINGBUF_LOST, &key);
+        if (lost != NULL) {
+            __sync_fetch_and_add(lost, 1);
+        }
+        return;
+    }
+    *event = *metadata;
+    sample_size = min(sample_size, size);
+    event->cap_len = 0;
+    if (sample_size > 0 && bpf_skb_load_bytes(skb, 0, event + 1, sample_size) == 0) {
+        event->cap_len = sample_size;
+    }
+    bpf_ringbuf_submit(event, 0);
+}
+
+static __always_inline void send_trace(struct __sk_buff *skb, __u64 sample_size, __u8 direction, __u32 sample_rate, const int ringbuf) {
 
     uint64_t skb_len = (uint64_t)skb->len;
 
     struct trace_metadata metadata = {
         .ifindex = skb->ifindex,
         .pkt_len = skb_len,
+        .ktime_ns = bpf_ktime_get_ns(),
+        .direction = direction,
+        .cap_len = sample_size,
+        .sample_rate = sample_rate,
     };
 
     bpf_printk("trace sample size: %llu\n", sample_size);
-    int ret = bpf_perf_event_output(skb, &EVENTS_MAP,
-            (sample_size << 32) | BPF_F_CURRENT_CPU,
-            &metadata, sizeof(metadata));
-    if (ret != 0) {
-        bpf_printk("trace failed: %d\n", ret);
+    if (!ringbuf) {
+        perf_output(skb, &metadata, sample_size);
+    } else if (sample_size <= SAMPLE_SIZE) {
+        ringbuf_output(skb, &metadata, sample_size, SAMPLE_SIZE);
+    } else if (sample_size <= DNS_SAMPLE_SIZE) {
+        ringbuf_output(skb, &metadata, sample_size, DNS_SAMPLE_SIZE);
+    } else if (sample_size <= HTTP_SAMPLE_SIZE) {
+        ringbuf_output(skb, &metadata, sample_size, HTTP_SAMPLE_SIZE);
+    } else {
+        ringbuf_output(skb, &metadata, sample_size, TLS_SAMPLE_SIZE);
     }
 }
 
-SEC("action/ingress")
-int ingress(struct __sk_buff *skb)
+// ringbuf is a constant: the code of the unused output is eliminated
+static __always_inline int handle_packet(struct __sk_buff *skb, __u8 direction, const int ringbuf)
 {
     bpf_printk("got packet: %d\n", skb->data_end);
     void *data_end = (void *)(long)skb->data_end;
@@ -74,6 +434,7 @@ int ingress(struct __sk_buff *skb)
     struct ipv6hdr *ip6;
     struct udphdr *udp;
     struct tcphdr *tcp;
+    struct icmphdr_common *icmp;
 
     __u32 tcp_header_length = 0;
     __u32 ip_header_length = 0;
@@ -81,68 +442,158 @@ int ingress(struct __sk_buff *skb)
     __u32 payload_length = 0;
     __u16 ip_len = 0;
     __u64 sample_size = 0;
+    __u8 tcp_flags = 0;
+    int has_payload = 0;
+    // TCP SYN, FIN or RST
+    int control = 0;
+    // whether the packet is sampled if the flows are aggregated
+    int significant = 1;
+    __u32 sample_rate = 0;
 
+    struct tracer_config *config = get_config();
+    struct flow_key key = {
+        .ifindex = skb->ifindex,
+        .direction = direction,
+    };
 
     eth_type = parse_ethhdr(&nh, data_end, &eth);
     if (eth_type < 0) {
         bpf_printk("return invalid eth type: %d\n", eth_type);
-        return TC_ACT_OK;
+        return TC_ACT_UNSPEC;
     }
 
     if (eth_type == bpf_htons(ETH_P_IP)) {
         ip_type = parse_iphdr(&nh, data_end, &ip);
         if (ip == NULL) {
             bpf_printk("ip is null: %d\n", ip);
-            return TC_ACT_OK;
+            return TC_ACT_UNSPEC;
         }
         ip_header_length = ip->ihl << 2;
-        ip_len = bpf_ntohs(ip->tot_len) >> 8;
+        ip_len = bpf_ntohs(ip->tot_len);
+        __builtin_memcpy(key.saddr, &ip->saddr, 4);
+        __builtin_memcpy(key.daddr, &ip->daddr, 4);
+        key.ip_version = 4;
     } else if (eth_type == bpf_htons(ETH_P_IPV6)) {
-        // ip_type = parse_ip6hdr(&nh, data_end, &ip6);
-        // if (ip6 == NULL) {
-        //     bpf_printk("ip6 is null: %d\n", ip);
-        //     return TC_ACT_OK;
-        // }
-        // ip_header_length = 40 << 2; // fixed header size
-        //ip_len = bpf_ntohs(ip6->payload_len) >> 8;
+        ip_type = parse_ip6hdr(&nh, data_end, &ip6);
+        if (ip_type < 0) {
+            bpf_printk("return ip6 hdr: %d\n", ip_type);
+            return TC_ACT_UNSPEC;
+        }
+        // fixed header size, extension headers are not followed:
+        // nexthdr must be the l4 protocol
+        ip_header_length = sizeof(struct ipv6hdr);
+        ip_len = ip_header_length + bpf_ntohs(ip6->payload_len);
+        __builtin_memcpy(key.saddr, &ip6->saddr, 16);
+        __builtin_memcpy(key.daddr, &ip6->daddr, 16);
+        key.ip_version = 6;
     } else {
         bpf_printk("return eth type: %lu / %lu\n", eth_type, ETH_P_IP);
-        return TC_ACT_OK;
+        return TC_ACT_UNSPEC;
     }
+    key.proto = ip_type;
 
     if (ip_type == IPPROTO_UDP) {
         if (parse_udphdr(&nh, data_end, &udp) < 0) {
             bpf_printk("return udp hdr: %d\n", eth_type);
-            return TC_ACT_OK;
+            return TC_ACT_UNSPEC;
         }
         if (udp == NULL) {
             bpf_printk("wtf udp is null: %d\n", eth_type);
-            return TC_ACT_OK;
+            return TC_ACT_UNSPEC;
         }
         payload_offset = ETH_HLEN + ip_header_length;
-        payload_length = (bpf_ntohs(udp->len)>>8); // udp->len = header + payload
+        payload_length = bpf_ntohs(udp->len); // udp->len = header + payload
         bpf_printk("udp len be: %d\n", udp->len);
         bpf_printk("payload_length: %lu\n", payload_length);
         bpf_printk("payload_offset: %lu\n", payload_offset);
         sample_size = min(payload_offset + payload_length, SAMPLE_SIZE);
-        send_trace(skb, sample_size);
+        if (is_dns(udp->source, udp->dest)) {
+            sample_size = min((__u64)skb->len, DNS_SAMPLE_SIZE);
+        }
+        key.sport = udp->source;
+        key.dport = udp->dest;
+        has_payload = payload_length > sizeof(struct udphdr);
+        significant = has_payload;
     } else if (ip_type == IPPROTO_TCP) {
         if (parse_tcphdr(&nh, data_end, &tcp) < 0) {
             bpf_printk("return tcp hdr: %d\n", eth_type);
-            return TC_ACT_OK;
+            return TC_ACT_UNSPEC;
         }
         if (tcp == NULL) {
             bpf_printk("wtf tcp is null: %d\n", eth_type);
-            return TC_ACT_OK;
+            return TC_ACT_UNSPEC;
         }
         tcp_header_length = tcp->doff << 2;
         payload_offset = ETH_HLEN + ip_header_length + tcp_header_length;
-        payload_length = ip_len - ip_header_length - tcp_header_length;
+        if (ip_len > ip_header_length + tcp_header_length) {
+            payload_length = ip_len - ip_header_length - tcp_header_length;
+        }
         sample_size = min(payload_offset + payload_length, SAMPLE_SIZE);
-        send_trace(skb, sample_size);
+        if (is_dns(tcp->source, tcp->dest)) {
+            sample_size = min((__u64)skb->len, DNS_SAMPLE_SIZE);
+        } else if (payload_length > 0 && is_tls_handshake(skb, payload_offset)) {
+            sample_size = min((__u64)skb->len, TLS_SAMPLE_SIZE);
+        } else if (payload_length > 0 && is_http(skb, payload_offset)) {
+            sample_size = min((__u64)skb->len, HTTP_SAMPLE_SIZE);
+        }
+        tcp_flags = ((__u8 *)tcp)[TCP_FLAGS_OFFSET];
+        key.sport = tcp->source;
+        key.dport = tcp->dest;
+        // segments without payload are only counted
+        has_payload = payload_length > 0;
+        control = (tcp_flags & (TCP_FLAG_SYN | TCP_FLAG_FIN | TCP_FLAG_RST)) != 0;
+        significant = has_payload || control;
+    } else if (ip_type == IPPROTO_ICMP || ip_type == IPPROTO_ICMPV6) {
+        if (parse_icmphdr_common(&nh, data_end, &icmp) < 0) {
+            bpf_printk("return icmp hdr: %d\n", eth_type);
+            return TC_ACT_UNSPEC;
+        }
+        // error messages carry the ip and l4 header of the
+        // original packet: sample as much as we can
+        sample_size = min((__u64)skb->len, SAMPLE_SIZE);
+    } else {
+        return TC_ACT_UNSPEC;
     }
 
-    return TC_ACT_OK;
+    if (!filter_packet(config, &key, ip_type != IPPROTO_ICMP && ip_type != IPPROTO_ICMPV6)) {
+        return TC_ACT_UNSPEC;
+    }
+    if (config != NULL && config->aggregate) {
+        update_flow(&key, skb->len, tcp_flags);
+        if (!significant) {
+            return TC_ACT_UNSPEC;
+        }
+    }
+    sample_rate = sample_packet(config, &key, has_payload, control);
+    if (sample_rate == 0) {
+        return TC_ACT_UNSPEC;
+    }
+    send_trace(skb, sample_size, direction, sample_rate, ringbuf);
+    return TC_ACT_UNSPEC;
+}
+
+SEC("classifier/ingress")
+int ingress(struct __sk_buff *skb)
+{
+    return handle_packet(skb, DIRECTION_INGRESS, 0);
+}
+
+SEC("classifier/egress")
+int egress(struct __sk_buff *skb)
+{
+    return handle_packet(skb, DIRECTION_EGRESS, 0);
+}
+
+SEC("classifier/ingress_ringbuf")
+int ingress_ringbuf(struct __sk_buff *skb)
+{
+    return handle_packet(skb, DIRECTION_INGRESS, 1);
+}
+
+SEC("classifier/egress_ringbuf")
+int egress_ringbuf(struct __sk_buff *skb)
+{
+    return handle_packet(skb, DIRECTION_EGRESS, 1);
 }
 
 char _license[] SEC("license") = "GPL";
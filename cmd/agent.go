@@ -4,10 +4,13 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"runtime"
 	"syscall"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/moolen/juno/pkg/agent/controller"
+	"github.com/moolen/juno/pkg/tracer"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -17,15 +20,65 @@ import (
 func init() {
 	flags := agentCmd.PersistentFlags()
 	flags.String("iface", "veth", "target interfaces for bpf injection")
-	flags.Duration("sync-interval", time.Second*60, "poll interval to attach eBPF programs to interfaces")
+	flags.Duration("sync-interval", time.Second*60, "interval to reconcile the eBPF programs of interfaces, new interfaces are attached immediately")
 	flags.Duration("perf-poll-interval", time.Millisecond, "poll interval on perf map")
+	flags.MarkDeprecated("perf-poll-interval", "samples are read as soon as they are available")
+	flags.Int("decode-workers", runtime.NumCPU(), "number of workers which decode the samples")
+	flags.Bool("ring-buffer", true, "use a BPF ring buffer if the kernel supports it, perf buffers otherwise")
+	flags.Int("ring-buffer-size", 4*1024*1024, "size of the ring buffer in bytes, rounded up to a power of two")
+	flags.Int("perf-buffer-size", 64*1024, "size of the perf buffer of each cpu in bytes")
+	flags.Bool("flow-aggregation", false, "count packets per flow in the kernel and only sample connection events and packets with payload")
+	flags.Duration("flow-aggregation-interval", time.Second*10, "interval in which the flow counters are summarized")
+	flags.Int("flow-aggregation-max-flows", 65536, "upper limit of flows which are counted, the least recently used flows are evicted")
+	flags.Int("sample-rate", 1, "sample 1 in N packets, the first packet with payload of each flow and TCP SYN, FIN and RST are always sampled")
+	flags.Int("flow-rate-limit", 0, "upper limit of samples per second of each flow, 0 disables the limit")
+	flags.Int("flow-rate-burst", 0, "samples a flow may send at once after it was idle, defaults to the rate limit")
+	flags.String("config", "", "config file with the settings of the agent, the filters are reloaded when it changes")
+	flags.StringSlice("filter-include-cidrs", nil, "only sample packets from or to these CIDRs")
+	flags.StringSlice("filter-exclude-cidrs", nil, "do not sample packets from or to these CIDRs")
+	flags.StringSlice("filter-include-ports", nil, "only sample packets from or to these TCP and UDP ports")
+	flags.StringSlice("filter-exclude-ports", nil, "do not sample packets from or to these TCP and UDP ports")
+	flags.StringSlice("filter-include-protocols", nil, "only sample packets of these protocols: tcp, udp, icmpv4, icmpv6 or protocol numbers")
+	flags.StringSlice("filter-exclude-protocols", nil, "do not sample packets of these protocols")
 	flags.String("k8s-node", "", "kubernetes node name")
+	flags.StringSlice("http-headers", nil, "HTTP headers to record. All headers are recorded if empty")
+	flags.StringSlice("http-redact-headers", []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}, "HTTP headers whose values are redacted")
+	flags.String("cache-key-mode", string(tracer.KeyModePlain), "how redis and memcached keys are recorded: plain, hash or redact")
+	flags.Bool("tcp-reassembly", false, "reassemble tcp streams before parsing L7 protocols")
+	flags.Int("tcp-reassembly-max-pages", 4096, "upper limit of buffered out of order pages of all connections")
+	flags.Int("tcp-reassembly-max-conn-pages", 4, "upper limit of buffered out of order pages per connection")
+	flags.Int("tcp-reassembly-max-streams", 8192, "upper limit of reassembled tcp streams, each buffers up to 16KiB")
+	flags.Duration("tcp-reassembly-idle-timeout", time.Minute*2, "time after which idle tcp streams are evicted")
 
 	viper.BindPFlags(flags)
 	viper.BindEnv("iface", "TARGET_INTERFACES")
 	viper.BindEnv("sync-interval", "SYNC_INTERVAL")
-	viper.BindEnv("perf-poll-interval", "PERF_POLL_INTERVAL")
+	viper.BindEnv("decode-workers", "DECODE_WORKERS")
+	viper.BindEnv("ring-buffer", "RING_BUFFER")
+	viper.BindEnv("ring-buffer-size", "RING_BUFFER_SIZE")
+	viper.BindEnv("perf-buffer-size", "PERF_BUFFER_SIZE")
+	viper.BindEnv("flow-aggregation", "FLOW_AGGREGATION")
+	viper.BindEnv("flow-aggregation-interval", "FLOW_AGGREGATION_INTERVAL")
+	viper.BindEnv("flow-aggregation-max-flows", "FLOW_AGGREGATION_MAX_FLOWS")
+	viper.BindEnv("sample-rate", "SAMPLE_RATE")
+	viper.BindEnv("flow-rate-limit", "FLOW_RATE_LIMIT")
+	viper.BindEnv("flow-rate-burst", "FLOW_RATE_BURST")
+	viper.BindEnv("config", "AGENT_CONFIG")
+	viper.BindEnv("filter-include-cidrs", "FILTER_INCLUDE_CIDRS")
+	viper.BindEnv("filter-exclude-cidrs", "FILTER_EXCLUDE_CIDRS")
+	viper.BindEnv("filter-include-ports", "FILTER_INCLUDE_PORTS")
+	viper.BindEnv("filter-exclude-ports", "FILTER_EXCLUDE_PORTS")
+	viper.BindEnv("filter-include-protocols", "FILTER_INCLUDE_PROTOCOLS")
+	viper.BindEnv("filter-exclude-protocols", "FILTER_EXCLUDE_PROTOCOLS")
 	viper.BindEnv("k8s-node", "KUBERNETES_NODE")
+	viper.BindEnv("http-headers", "HTTP_HEADERS")
+	viper.BindEnv("http-redact-headers", "HTTP_REDACT_HEADERS")
+	viper.BindEnv("cache-key-mode", "CACHE_KEY_MODE")
+	viper.BindEnv("tcp-reassembly", "TCP_REASSEMBLY")
+	viper.BindEnv("tcp-reassembly-max-pages", "TCP_REASSEMBLY_MAX_PAGES")
+	viper.BindEnv("tcp-reassembly-max-conn-pages", "TCP_REASSEMBLY_MAX_CONN_PAGES")
+	viper.BindEnv("tcp-reassembly-max-streams", "TCP_REASSEMBLY_MAX_STREAMS")
+	viper.BindEnv("tcp-reassembly-idle-timeout", "TCP_REASSEMBLY_IDLE_TIMEOUT")
 	rootCmd.AddCommand(agentCmd)
 }
 
@@ -34,17 +87,67 @@ var agentCmd = &cobra.Command{
 	Short: "The agent captures network traffic on specific interfaces",
 	Run: func(cmd *cobra.Command, args []string) {
 		log.Infof("starting agent")
+		if config := viper.GetString("config"); config != "" {
+			viper.SetConfigFile(config)
+			if err := viper.ReadInConfig(); err != nil {
+				log.Fatal(err)
+			}
+		}
+		keyMode, err := tracer.ParseKeyMode(viper.GetString("cache-key-mode"))
+		if err != nil {
+			log.Fatal(err)
+		}
 		bpfController, err := controller.New(
 			viper.GetString("iface"),
 			viper.GetString("k8s-node"),
 			viper.GetDuration("sync-interval"),
-			viper.GetDuration("perf-poll-interval"),
+			viper.GetInt("decode-workers"),
+			tracer.BufferOptions{
+				RingBuffer:     viper.GetBool("ring-buffer"),
+				RingBufferSize: viper.GetInt("ring-buffer-size"),
+				PerfBufferSize: viper.GetInt("perf-buffer-size"),
+			},
+			tracer.AggregationOptions{
+				Enabled:  viper.GetBool("flow-aggregation"),
+				Interval: viper.GetDuration("flow-aggregation-interval"),
+				MaxFlows: viper.GetInt("flow-aggregation-max-flows"),
+			},
+			filterOptions(),
+			tracer.SamplingOptions{
+				Rate:      viper.GetInt("sample-rate"),
+				FlowRate:  viper.GetInt("flow-rate-limit"),
+				FlowBurst: viper.GetInt("flow-rate-burst"),
+			},
+			tracer.ParserOptions{
+				Headers: tracer.NewHeaderFilter(
+					viper.GetStringSlice("http-headers"),
+					viper.GetStringSlice("http-redact-headers"),
+				),
+				CacheKeys: keyMode,
+				Reassembly: tracer.ReassemblyOptions{
+					Enabled:                       viper.GetBool("tcp-reassembly"),
+					MaxBufferedPages:              viper.GetInt("tcp-reassembly-max-pages"),
+					MaxBufferedPagesPerConnection: viper.GetInt("tcp-reassembly-max-conn-pages"),
+					MaxStreams:                    viper.GetInt("tcp-reassembly-max-streams"),
+					IdleTimeout:                   viper.GetDuration("tcp-reassembly-idle-timeout"),
+				},
+			},
 		)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		stopChan := make(chan os.Signal)
+		if viper.GetString("config") != "" {
+			viper.OnConfigChange(func(e fsnotify.Event) {
+				log.Infof("config %s changed, reloading filters", e.Name)
+				if err := bpfController.Tracer.SetFilters(filterOptions()); err != nil {
+					log.Errorf("error reloading filters: %s", err)
+				}
+			})
+			viper.WatchConfig()
+		}
+
+		stopChan := make(chan os.Signal, 1)
 		signal.Notify(stopChan, os.Interrupt)
 		signal.Notify(stopChan, syscall.SIGTERM)
 		go func() {
@@ -62,3 +165,15 @@ var agentCmd = &cobra.Command{
 		http.ListenAndServe(":2112", nil)
 	},
 }
+
+// filterOptions returns the filters of the eBPF programs
+func filterOptions() tracer.FilterOptions {
+	return tracer.FilterOptions{
+		IncludeCIDRs:     viper.GetStringSlice("filter-include-cidrs"),
+		ExcludeCIDRs:     viper.GetStringSlice("filter-exclude-cidrs"),
+		IncludePorts:     viper.GetStringSlice("filter-include-ports"),
+		ExcludePorts:     viper.GetStringSlice("filter-exclude-ports"),
+		IncludeProtocols: viper.GetStringSlice("filter-include-protocols"),
+		ExcludeProtocols: viper.GetStringSlice("filter-exclude-protocols"),
+	}
+}
@@ -15,15 +15,21 @@ import (
 
 func init() {
 	flags := serverCmd.PersistentFlags()
-	flags.String("target", "dns:///localhost:3000", "specify the grpc server to ask for traces. you may specify a dns+srv based discovery")
+	flags.String("target", "dns:///localhost:3000", "address of the agents to collect traces from. the host is resolved periodically to discover all agents")
 	flags.Int("listen", 3001, "specify the port to listen on")
+	flags.Int("http-listen", 3002, "specify the port to serve the service graph on")
 	flags.Duration("sync-interval", time.Second*60, "sync intervall for k8s resources")
 	flags.Int("cache-buffer-size", 3000, "cache buffer size")
+	flags.Int("trace-buffer-size", 16384, "number of traces to keep in memory")
+	flags.Duration("graph-ttl", time.Minute*5, "time after which unseen edges are removed from the service graph")
 	viper.BindPFlags(flags)
 	viper.BindEnv("target", "TARGET_ADDR")
 	viper.BindEnv("listen", "LISTEN")
+	viper.BindEnv("http-listen", "HTTP_LISTEN")
 	viper.BindEnv("sync-interval", "SYNC_INTERVAL")
 	viper.BindEnv("cache-buffer-size", "CACHE_BUFFER_SIZE")
+	viper.BindEnv("trace-buffer-size", "TRACE_BUFFER_SIZE")
+	viper.BindEnv("graph-ttl", "GRAPH_TTL")
 	rootCmd.AddCommand(serverCmd)
 }
 
@@ -40,14 +46,17 @@ var serverCmd = &cobra.Command{
 			kubeClient,
 			viper.GetString("target"),
 			viper.GetInt("listen"),
+			viper.GetInt("http-listen"),
 			viper.GetDuration("sync-interval"),
 			viper.GetInt("cache-buffer-size"),
+			viper.GetInt("trace-buffer-size"),
+			viper.GetDuration("graph-ttl"),
 		)
 		if err != nil {
 			log.Fatal(err)
 		}
 		ctx, cancel := context.WithCancel(context.Background())
-		stopChan := make(chan os.Signal)
+		stopChan := make(chan os.Signal, 1)
 		signal.Notify(stopChan, os.Interrupt)
 		signal.Notify(stopChan, syscall.SIGTERM)
 
@@ -18,17 +18,23 @@ type Controller struct {
 	ring     *ring.Ring
 	srv      *TraceServer
 
-	// this bool signals shutdown
-	stop bool
+	// ctx is cancelled on shutdown
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // New ...
 func New(
 	ifacePrefix, nodeName string,
 	syncInterval time.Duration,
-	perfPollInterval time.Duration) (*Controller, error) {
+	workers int,
+	buffers tracer.BufferOptions,
+	aggregation tracer.AggregationOptions,
+	filters tracer.FilterOptions,
+	sampling tracer.SamplingOptions,
+	parsers tracer.ParserOptions) (*Controller, error) {
 	ring := ring.NewRing(2048)
-	t, err := tracer.NewTracer(ifacePrefix, perfPollInterval, syncInterval)
+	t, err := tracer.NewTracer(ifacePrefix, syncInterval, workers, buffers, aggregation, filters, sampling, parsers)
 	if err != nil {
 		return nil, err
 	}
@@ -37,26 +43,28 @@ func New(
 		return nil, err
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	return &Controller{
 		Tracer:   t,
 		nodeName: nodeName,
 		ring:     ring,
 		srv:      srv,
+		ctx:      ctx,
+		cancel:   cancel,
 	}, nil
 }
 
 func (c *Controller) pollEvents() {
 	log.Infof("start polling perfMap events")
+	events := traceEventCounter.WithLabelValues(c.nodeName)
 	for {
 		select {
 		case trace := <-c.Tracer.Read():
-			traceEventCounter.WithLabelValues(c.nodeName).Inc()
+			events.Inc()
 			trace.NodeName = c.nodeName
-			c.ring.Write(&trace)
-		default:
-			if c.stop {
-				return
-			}
+			c.ring.Write(trace)
+		case <-c.ctx.Done():
+			return
 		}
 	}
 }
@@ -81,12 +89,12 @@ func getTargetReference(ep *corev1.Endpoints, targetAddr string) (*corev1.Object
 func (c *Controller) Start() {
 	log.Debugf("starting controller")
 	go c.pollEvents()
-	go c.srv.Serve(context.Background())
+	go c.srv.Serve(c.ctx)
 	c.Tracer.Start()
 }
 
 // Stop ..
 func (c *Controller) Stop() {
+	c.cancel()
 	c.Tracer.Stop()
-	c.stop = true
 }
@@ -6,27 +6,36 @@ import (
 	"github.com/moolen/juno/pkg/ring"
 	pb "github.com/moolen/juno/proto"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// GetTraces ..
+// GetTraces streams the traces matching the request.
+// See ring.Query for the semantics of the request.
 func (o *TraceServer) GetTraces(req *pb.GetTracesRequest, gfs pb.Tracer_GetTracesServer) error {
-	rr := ring.NewRingReader(o.ring, 0)
-
-	for {
-		select {
-		case <-gfs.Context().Done():
-			return gfs.Context().Err()
-		default:
-		}
-
-		t := rr.NextFollow(gfs.Context())
-		err := gfs.Send(&pb.GetTracesResponse{
+	if hasNamespaceFilter(req.Allowlist) || hasNamespaceFilter(req.Denylist) {
+		return status.Errorf(codes.InvalidArgument, "invalid request: namespace filters are only supported by the server")
+	}
+	q, err := ring.NewQuery(req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+	return q.Run(gfs.Context(), o.ring, func(t *pb.Trace) error {
+		return gfs.Send(&pb.GetTracesResponse{
 			Trace: t,
 		})
-		if err != nil {
-			return err
+	})
+}
+
+// hasNamespaceFilter returns true if a filter matches namespaces.
+// The agent does not know the namespaces, they are resolved by the server.
+func hasNamespaceFilter(ff []*pb.TraceFilter) bool {
+	for _, f := range ff {
+		if len(f.SourceNamespace) > 0 || len(f.DestinationNamespace) > 0 {
+			return true
 		}
 	}
+	return false
 }
 
 // ServerStatus returns some details
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moolen/juno/pkg/ring"
+	pb "github.com/moolen/juno/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeTracesServer struct {
+	pb.Tracer_GetTracesServer
+	traces []*pb.Trace
+}
+
+func (s *fakeTracesServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeTracesServer) Send(res *pb.GetTracesResponse) error {
+	s.traces = append(s.traces, res.Trace)
+	return nil
+}
+
+func TestGetTraces(t *testing.T) {
+	o := &TraceServer{
+		ring: ring.NewRing(7),
+	}
+	for _, node := range []string{"a", "b", "c"} {
+		o.ring.Write(&pb.Trace{NodeName: node})
+	}
+	for i, row := range []struct {
+		req      *pb.GetTracesRequest
+		expected int
+		code     codes.Code
+	}{
+		{
+			req:      &pb.GetTracesRequest{},
+			expected: 2,
+		},
+		{
+			req: &pb.GetTracesRequest{
+				Allowlist: []*pb.TraceFilter{{NodeName: []string{"a"}}},
+			},
+			expected: 1,
+		},
+		{
+			req: &pb.GetTracesRequest{
+				Allowlist: []*pb.TraceFilter{{SourceNamespace: []string{"default"}}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			req: &pb.GetTracesRequest{
+				Denylist: []*pb.TraceFilter{{DestinationNamespace: []string{"kube-system"}}},
+			},
+			code: codes.InvalidArgument,
+		},
+	} {
+		gfs := &fakeTracesServer{}
+		err := o.GetTraces(row.req, gfs)
+		if code := status.Code(err); code != row.code {
+			t.Errorf("[%d] unexpected code. expected %s, found %s", i, row.code, code)
+			continue
+		}
+		if len(gfs.traces) != row.expected {
+			t.Errorf("[%d] unexpected number of traces. expected %d, found %d", i, row.expected, len(gfs.traces))
+		}
+	}
+}
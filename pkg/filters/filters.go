@@ -0,0 +1,351 @@
+package filters
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+// FilterFunc is the function which decides whether a trace matches
+type FilterFunc func(t *pb.Trace) bool
+
+// FilterFuncs is a list of FilterFunc
+type FilterFuncs []FilterFunc
+
+// MatchAll returns true if all filters match.
+// An empty list matches all traces.
+func (fs FilterFuncs) MatchAll(t *pb.Trace) bool {
+	for _, f := range fs {
+		if !f(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchOne returns true if at least one filter matches
+func (fs FilterFuncs) MatchOne(t *pb.Trace) bool {
+	for _, f := range fs {
+		if f(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns true if the trace passes the allowlist and the denylist.
+// An empty allowlist allows all traces.
+func Apply(allowlist, denylist FilterFuncs, t *pb.Trace) bool {
+	if len(allowlist) > 0 && !allowlist.MatchOne(t) {
+		return false
+	}
+	return !denylist.MatchOne(t)
+}
+
+// BuildFilterList builds a list of filters, one for every TraceFilter
+func BuildFilterList(ff []*pb.TraceFilter) (FilterFuncs, error) {
+	var filters FilterFuncs
+	for _, f := range ff {
+		fn, err := BuildFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, fn)
+	}
+	return filters, nil
+}
+
+// BuildFilter builds a filter which matches if all fields of the TraceFilter match
+func BuildFilter(f *pb.TraceFilter) (FilterFunc, error) {
+	var fs FilterFuncs
+	if len(f.SourceIp) > 0 {
+		fn, err := filterByIP(f.SourceIp, (*pb.IP).GetSource)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, fn)
+	}
+	if len(f.DestinationIp) > 0 {
+		fn, err := filterByIP(f.DestinationIp, (*pb.IP).GetDestination)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, fn)
+	}
+	if len(f.Protocol) > 0 {
+		fn, err := filterByProtocol(f.Protocol)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, fn)
+	}
+	if len(f.SourcePort) > 0 {
+		fs = append(fs, filterByPort(f.SourcePort, sourcePort))
+	}
+	if len(f.DestinationPort) > 0 {
+		fs = append(fs, filterByPort(f.DestinationPort, destinationPort))
+	}
+	if len(f.TcpFlags) > 0 {
+		fs = append(fs, filterByTCPFlags(f.TcpFlags))
+	}
+	if len(f.L7Type) > 0 {
+		fn, err := filterByL7Type(f.L7Type)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, fn)
+	}
+	if len(f.HttpMethod) > 0 {
+		fs = append(fs, filterByHTTPMethod(f.HttpMethod))
+	}
+	if len(f.HttpUrlPrefix) > 0 {
+		fs = append(fs, filterByHTTPURLPrefix(f.HttpUrlPrefix))
+	}
+	if len(f.DnsQuery) > 0 {
+		fs = append(fs, filterByDNSQuery(f.DnsQuery))
+	}
+	if len(f.NodeName) > 0 {
+		fs = append(fs, filterByNodeName(f.NodeName))
+	}
+	if len(f.SourceNamespace) > 0 {
+		fs = append(fs, filterByNamespace(f.SourceNamespace, (*pb.Trace).GetSource))
+	}
+	if len(f.DestinationNamespace) > 0 {
+		fs = append(fs, filterByNamespace(f.DestinationNamespace, (*pb.Trace).GetDestination))
+	}
+	return fs.MatchAll, nil
+}
+
+func filterByIP(addrs []string, getIP func(*pb.IP) string) (FilterFunc, error) {
+	var nets []*net.IPNet
+	for _, addr := range addrs {
+		if !strings.Contains(addr, "/") {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ip address: %s", addr)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr: %s", addr)
+		}
+		nets = append(nets, n)
+	}
+	return func(t *pb.Trace) bool {
+		ip := net.ParseIP(getIP(t.GetIP()))
+		if ip == nil {
+			return false
+		}
+		for _, n := range nets {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func filterByProtocol(protocols []string) (FilterFunc, error) {
+	var matchers []func(*pb.Layer4) bool
+	for _, p := range protocols {
+		switch strings.ToLower(p) {
+		case "tcp":
+			matchers = append(matchers, func(l4 *pb.Layer4) bool { return l4.GetTCP() != nil })
+		case "udp":
+			matchers = append(matchers, func(l4 *pb.Layer4) bool { return l4.GetUDP() != nil })
+		case "icmpv4", "icmp":
+			matchers = append(matchers, func(l4 *pb.Layer4) bool { return l4.GetICMPv4() != nil })
+		case "icmpv6":
+			matchers = append(matchers, func(l4 *pb.Layer4) bool { return l4.GetICMPv6() != nil })
+		default:
+			return nil, fmt.Errorf("invalid protocol: %s", p)
+		}
+	}
+	return func(t *pb.Trace) bool {
+		for _, m := range matchers {
+			if m(t.GetL4()) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func sourcePort(l4 *pb.Layer4) (uint32, bool) {
+	if tcp := l4.GetTCP(); tcp != nil {
+		return tcp.SourcePort, true
+	}
+	if udp := l4.GetUDP(); udp != nil {
+		return udp.SourcePort, true
+	}
+	return 0, false
+}
+
+func destinationPort(l4 *pb.Layer4) (uint32, bool) {
+	if tcp := l4.GetTCP(); tcp != nil {
+		return tcp.DestinationPort, true
+	}
+	if udp := l4.GetUDP(); udp != nil {
+		return udp.DestinationPort, true
+	}
+	return 0, false
+}
+
+func filterByPort(ports []uint32, getPort func(*pb.Layer4) (uint32, bool)) FilterFunc {
+	return func(t *pb.Trace) bool {
+		port, ok := getPort(t.GetL4())
+		if !ok {
+			return false
+		}
+		for _, p := range ports {
+			if p == port {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func filterByTCPFlags(flags []*pb.TCPFlags) FilterFunc {
+	return func(t *pb.Trace) bool {
+		set := t.GetL4().GetTCP().GetFlags()
+		if set == nil {
+			return false
+		}
+		for _, f := range flags {
+			if (!f.FIN || set.FIN) &&
+				(!f.SYN || set.SYN) &&
+				(!f.RST || set.RST) &&
+				(!f.PSH || set.PSH) &&
+				(!f.ACK || set.ACK) &&
+				(!f.URG || set.URG) &&
+				(!f.ECE || set.ECE) &&
+				(!f.CWR || set.CWR) &&
+				(!f.NS || set.NS) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func filterByL7Type(types []string) (FilterFunc, error) {
+	var matchers []func(*pb.Layer7) bool
+	for _, typ := range types {
+		switch strings.ToLower(typ) {
+		case "dns":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetDns() != nil })
+		case "http":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetHttp() != nil })
+		case "http2":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetHttp2() != nil })
+		case "kafka":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetKafka() != nil })
+		case "cache":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetCache() != nil })
+		case "sql":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetSql() != nil })
+		case "tls":
+			matchers = append(matchers, func(l7 *pb.Layer7) bool { return l7.GetTls() != nil })
+		default:
+			return nil, fmt.Errorf("invalid l7 type: %s", typ)
+		}
+	}
+	return func(t *pb.Trace) bool {
+		for _, m := range matchers {
+			if m(t.GetL7()) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func filterByHTTPMethod(methods []string) FilterFunc {
+	return func(t *pb.Trace) bool {
+		http := t.GetL7().GetHttp()
+		if http == nil {
+			return false
+		}
+		for _, m := range methods {
+			if strings.EqualFold(m, http.Method) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func filterByHTTPURLPrefix(prefixes []string) FilterFunc {
+	return func(t *pb.Trace) bool {
+		http := t.GetL7().GetHttp()
+		if http == nil {
+			return false
+		}
+		for _, p := range prefixes {
+			if strings.HasPrefix(http.Url, p) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func normalizeDomain(d string) string {
+	return strings.ToLower(strings.TrimSuffix(d, "."))
+}
+
+func filterByDNSQuery(queries []string) FilterFunc {
+	var domains []string
+	for _, q := range queries {
+		domains = append(domains, normalizeDomain(q))
+	}
+	return func(t *pb.Trace) bool {
+		dns := t.GetL7().GetDns()
+		if dns == nil {
+			return false
+		}
+		query := normalizeDomain(dns.Query)
+		for _, d := range domains {
+			if query == d || strings.HasSuffix(query, "."+d) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func filterByNodeName(names []string) FilterFunc {
+	return func(t *pb.Trace) bool {
+		for _, n := range names {
+			if n == t.NodeName {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func filterByNamespace(namespaces []string, getEndpoint func(*pb.Trace) *pb.Endpoint) FilterFunc {
+	return func(t *pb.Trace) bool {
+		ep := getEndpoint(t)
+		if ep == nil {
+			return false
+		}
+		for _, ns := range namespaces {
+			if ns == ep.Namespace {
+				return true
+			}
+		}
+		return false
+	}
+}
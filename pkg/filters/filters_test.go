@@ -0,0 +1,134 @@
+package filters
+
+import (
+	"testing"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+func TestFilters(t *testing.T) {
+	httpTrace := &pb.Trace{
+		NodeName: "node-a",
+		IP: &pb.IP{
+			Source:      "10.0.1.5",
+			Destination: "10.0.2.7",
+		},
+		L4: &pb.Layer4{
+			Protocol: &pb.Layer4_TCP{
+				TCP: &pb.TCP{
+					SourcePort:      39198,
+					DestinationPort: 8080,
+					Flags: &pb.TCPFlags{
+						PSH: true,
+						ACK: true,
+					},
+				},
+			},
+		},
+		L7: &pb.Layer7{
+			Record: &pb.Layer7_Http{
+				Http: &pb.HTTP{
+					Method: "GET",
+					Url:    "/api/v1/users",
+				},
+			},
+		},
+		Destination: &pb.Endpoint{
+			Namespace: "api",
+		},
+	}
+	dnsTrace := &pb.Trace{
+		NodeName: "node-b",
+		IP: &pb.IP{
+			Source:      "10.0.1.5",
+			Destination: "fd00::10",
+		},
+		L4: &pb.Layer4{
+			Protocol: &pb.Layer4_UDP{
+				UDP: &pb.UDP{
+					SourcePort:      41000,
+					DestinationPort: 53,
+				},
+			},
+		},
+		L7: &pb.Layer7{
+			Record: &pb.Layer7_Dns{
+				Dns: &pb.DNS{
+					Query: "api.example.com.",
+				},
+			},
+		},
+	}
+
+	for i, row := range []struct {
+		filter *pb.TraceFilter
+		http   bool
+		dns    bool
+	}{
+		{filter: &pb.TraceFilter{}, http: true, dns: true},
+		{filter: &pb.TraceFilter{SourceIp: []string{"10.0.1.5"}}, http: true, dns: true},
+		{filter: &pb.TraceFilter{DestinationIp: []string{"10.0.2.0/24"}}, http: true},
+		{filter: &pb.TraceFilter{DestinationIp: []string{"fd00::/64"}}, dns: true},
+		{filter: &pb.TraceFilter{Protocol: []string{"udp"}}, dns: true},
+		{filter: &pb.TraceFilter{Protocol: []string{"TCP", "UDP"}}, http: true, dns: true},
+		{filter: &pb.TraceFilter{Protocol: []string{"icmpv4", "icmp", "icmpv6"}}},
+		{filter: &pb.TraceFilter{DestinationPort: []uint32{53, 8080}}, http: true, dns: true},
+		{filter: &pb.TraceFilter{SourcePort: []uint32{8080}}},
+		{filter: &pb.TraceFilter{TcpFlags: []*pb.TCPFlags{{ACK: true}}}, http: true},
+		{filter: &pb.TraceFilter{TcpFlags: []*pb.TCPFlags{{SYN: true, ACK: true}}}},
+		{filter: &pb.TraceFilter{L7Type: []string{"dns"}}, dns: true},
+		{filter: &pb.TraceFilter{L7Type: []string{"http2"}}},
+		{filter: &pb.TraceFilter{HttpMethod: []string{"get"}}, http: true},
+		{filter: &pb.TraceFilter{HttpUrlPrefix: []string{"/api/v2"}}},
+		{filter: &pb.TraceFilter{HttpUrlPrefix: []string{"/api/v1"}}, http: true},
+		{filter: &pb.TraceFilter{DnsQuery: []string{"example.com"}}, dns: true},
+		{filter: &pb.TraceFilter{DnsQuery: []string{"ample.com"}}},
+		{filter: &pb.TraceFilter{NodeName: []string{"node-b"}}, dns: true},
+		{filter: &pb.TraceFilter{DestinationNamespace: []string{"api"}}, http: true},
+		{filter: &pb.TraceFilter{SourceNamespace: []string{"api"}}},
+		{filter: &pb.TraceFilter{SourceIp: []string{"10.0.1.5"}, NodeName: []string{"node-a"}}, http: true},
+	} {
+		fn, err := BuildFilter(row.filter)
+		if err != nil {
+			t.Fatalf("[%d] unexpected err: %s", i, err)
+		}
+		if fn(httpTrace) != row.http {
+			t.Errorf("[%d] unexpected http result. expected %v", i, row.http)
+		}
+		if fn(dnsTrace) != row.dns {
+			t.Errorf("[%d] unexpected dns result. expected %v", i, row.dns)
+		}
+	}
+}
+
+func TestBuildFilterErrors(t *testing.T) {
+	for i, f := range []*pb.TraceFilter{
+		{SourceIp: []string{"10.0.0.300"}},
+		{DestinationIp: []string{"10.0.0.0/33"}},
+		{Protocol: []string{"sctp"}},
+		{L7Type: []string{"smtp"}},
+	} {
+		if _, err := BuildFilter(f); err == nil {
+			t.Errorf("[%d] expected error", i)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	trace := &pb.Trace{NodeName: "node-a"}
+	nodeA, _ := BuildFilterList([]*pb.TraceFilter{{NodeName: []string{"node-a"}}})
+	nodeB, _ := BuildFilterList([]*pb.TraceFilter{{NodeName: []string{"node-b"}}})
+
+	if !Apply(nil, nil, trace) {
+		t.Errorf("empty filters should match")
+	}
+	if !Apply(nodeA, nodeB, trace) {
+		t.Errorf("allowlist should match")
+	}
+	if Apply(nodeB, nil, trace) {
+		t.Errorf("allowlist should not match")
+	}
+	if Apply(nil, nodeA, trace) {
+		t.Errorf("denylist should match")
+	}
+}
@@ -31,7 +31,7 @@ func NewEndpointCache(source cache.ListerWatcher, syncInterval time.Duration, bu
 }
 
 func (s *EndpointCache) getMetadataForIP(ip string) (map[string]string, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (s *EndpointCache) GetMetadataByIP(ip string) (map[string]string, error) {
 }
 
 func (s *EndpointCache) getByIP(ip string) (*v1.Endpoints, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func endpointIPIndex(obj interface{}) ([]string, error) {
 	var out []string
 	for _, sub := range endpoints.Subsets {
 		for _, addr := range sub.Addresses {
-			out = append(out, addr.IP)
+			out = append(out, normalizeIP(addr.IP))
 		}
 	}
 	return out, nil
@@ -0,0 +1,14 @@
+package k8s
+
+import "net"
+
+// normalizeIP returns the canonical string representation of ip,
+// so IPv6 addresses are indexed the same way the tracer formats them.
+// Invalid addresses are returned unchanged.
+func normalizeIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	return parsed.String()
+}
@@ -31,7 +31,7 @@ func NewNodeCache(source cache.ListerWatcher, syncInterval time.Duration, buffer
 }
 
 func (s *NodeCache) getMetadataForIP(ip string) (map[string]string, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (s *NodeCache) GetMetadataByIP(ip string) (map[string]string, error) {
 }
 
 func (s *NodeCache) getByIP(ip string) (*v1.Node, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func nodeIPIndex(obj interface{}) ([]string, error) {
 	node := obj.(*v1.Node)
 	var out []string
 	for _, addr := range node.Status.Addresses {
-		out = append(out, addr.Address)
+		out = append(out, normalizeIP(addr.Address))
 	}
 
 	return out, nil
@@ -31,7 +31,7 @@ func NewPodCache(source cache.ListerWatcher, syncInterval time.Duration, bufferS
 }
 
 func (s *PodCache) getMetadataForIP(ip string) (map[string]string, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (s *PodCache) GetMetadataByIP(ip string) (map[string]string, error) {
 }
 
 func (s *PodCache) getByIP(ip string) (*v1.Pod, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +73,20 @@ func (s *PodCache) GetByIP(ip string) (*v1.Pod, error) {
 func podIPIndex(obj interface{}) ([]string, error) {
 	po := obj.(*v1.Pod)
 	var out []string
-	if po.Status.PodIP != "" {
-		out = append(out, po.Status.PodIP)
+	seen := make(map[string]bool)
+	add := func(ip string) {
+		ip = normalizeIP(ip)
+		if ip == "" || seen[ip] {
+			return
+		}
+		seen[ip] = true
+		out = append(out, ip)
+	}
+	// podIPs contains the IPv4 and IPv6 address in dual-stack clusters,
+	// podIP is always the first of them
+	add(po.Status.PodIP)
+	for _, podIP := range po.Status.PodIPs {
+		add(podIP.IP)
 	}
 
 	return out, nil
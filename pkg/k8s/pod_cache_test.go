@@ -0,0 +1,67 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kt "k8s.io/client-go/tools/cache/testing"
+)
+
+func TestFindDualStackPod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := kt.NewFakeControllerSource()
+	c := NewPodCache(source, time.Second, bufferSize)
+	source.Add(NewPod("foo", "default", "10.0.1.5", "10.0.1.5", "FD00:0:0::A"))
+	source.Add(NewPod("bar", "default", "10.0.1.6"))
+	err := c.Run(ctx)
+	if err != nil {
+		t.Error(err)
+	}
+	defer source.Shutdown()
+
+	for i, row := range []struct {
+		ip   string
+		name string
+	}{
+		{ip: "10.0.1.5", name: "foo"},
+		{ip: "fd00::a", name: "foo"},
+		{ip: "fd00:0::A", name: "foo"},
+		{ip: "10.0.1.6", name: "bar"},
+	} {
+		po, err := c.GetByIP(row.ip)
+		if err != nil {
+			t.Errorf("[%d] unexpected err: %s", i, err)
+			continue
+		}
+		if po.ObjectMeta.Name != row.name {
+			t.Errorf("[%d] should have found pod %s. found: %s", i, row.name, po.ObjectMeta.Name)
+		}
+	}
+	if _, err := c.GetByIP("fd00::b"); err == nil {
+		t.Errorf("should not have found a pod")
+	}
+}
+
+func NewPod(name, namespace, podIP string, podIPs ...string) *v1.Pod {
+	po := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			ResourceVersion:   fmt.Sprintf("%d", time.Now().UnixNano()),
+			CreationTimestamp: metav1.Now(),
+		},
+		Status: v1.PodStatus{
+			PodIP: podIP,
+		},
+	}
+	for _, ip := range podIPs {
+		po.Status.PodIPs = append(po.Status.PodIPs, v1.PodIP{IP: ip})
+	}
+	return po
+}
@@ -31,7 +31,7 @@ func NewServiceCache(source cache.ListerWatcher, syncInterval time.Duration, buf
 }
 
 func (s *ServiceCache) getMetadataForIP(ip string) (map[string]string, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (s *ServiceCache) GetMetadataByIP(ip string) (map[string]string, error) {
 }
 
 func (s *ServiceCache) getByIP(ip string) (*v1.Service, error) {
-	items, err := s.indexer.ByIndex(indexByIP, ip)
+	items, err := s.indexer.ByIndex(indexByIP, normalizeIP(ip))
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func serviceIPIndex(obj interface{}) ([]string, error) {
 	service := obj.(*v1.Service)
 	var out []string
 	if service.Spec.ClusterIP != "" {
-		out = append(out, service.Spec.ClusterIP)
+		out = append(out, normalizeIP(service.Spec.ClusterIP))
 	}
 
 	return out, nil
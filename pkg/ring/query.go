@@ -0,0 +1,166 @@
+package ring
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/moolen/juno/pkg/filters"
+	pb "github.com/moolen/juno/proto"
+)
+
+// MaxTraceDelay bounds the disorder of the ring: a trace may be written
+// after traces which happened up to MaxTraceDelay later. The agent decodes
+// the samples in parallel and the server merges the traces of the agents.
+// Queries scan this far beyond the first trace outside of their time range.
+const MaxTraceDelay = 10 * time.Second
+
+// Query describes a filtered, time bounded read of the ring
+type Query struct {
+	// Number of past traces to read. If Number and Since are
+	// not set all traces are read
+	Number uint64
+	Since  *time.Time
+	Until  *time.Time
+	// Follow keeps reading new traces until the context is canceled
+	Follow    bool
+	Allowlist filters.FilterFuncs
+	Denylist  filters.FilterFuncs
+}
+
+// NewQuery creates a query from a GetTracesRequest
+func NewQuery(req *pb.GetTracesRequest) (*Query, error) {
+	var err error
+	q := &Query{
+		Number: req.Number,
+		Follow: req.Follow,
+	}
+	q.Allowlist, err = filters.BuildFilterList(req.Allowlist)
+	if err != nil {
+		return nil, err
+	}
+	q.Denylist, err = filters.BuildFilterList(req.Denylist)
+	if err != nil {
+		return nil, err
+	}
+	if req.Since != nil {
+		since, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return nil, err
+		}
+		q.Since = &since
+	}
+	if req.Until != nil {
+		until, err := ptypes.Timestamp(req.Until)
+		if err != nil {
+			return nil, err
+		}
+		q.Until = &until
+	}
+	return q, nil
+}
+
+// Run calls send for every trace in the ring matching the query.
+// It returns when the ring has been read or the context is canceled
+// in follow mode. Following stops MaxTraceDelay after Until, even if
+// no newer trace is written.
+func (q *Query) Run(ctx context.Context, r *Ring, send func(*pb.Trace) error) error {
+	follow, followCtx := q.Follow, ctx
+	if follow && q.Until != nil {
+		var cancel context.CancelFunc
+		followCtx, cancel = context.WithDeadline(ctx, q.Until.Add(MaxTraceDelay))
+		defer cancel()
+	}
+	rr := NewRingReader(r, q.start(r))
+	for {
+		var t *pb.Trace
+		if follow {
+			t = rr.NextFollow(followCtx)
+			if t == nil {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				// no trace within Until can follow,
+				// read the rest of the ring
+				follow = false
+				continue
+			}
+		} else {
+			t = rr.Next()
+			if t == nil {
+				return nil
+			}
+		}
+		ts, ok := traceTime(t)
+		if q.Until != nil && ok && ts.After(*q.Until) {
+			if ts.After(q.Until.Add(MaxTraceDelay)) {
+				return nil
+			}
+			continue
+		}
+		if q.Since != nil && (!ok || ts.Before(*q.Since)) {
+			continue
+		}
+		if !filters.Apply(q.Allowlist, q.Denylist, t) {
+			continue
+		}
+		if err := send(t); err != nil {
+			return err
+		}
+	}
+}
+
+// start walks the ring backwards to find the position of the
+// oldest trace within the requested number of traces and time range.
+// Traces before Since are skipped until one is older than MaxTraceDelay.
+func (q *Query) start(r *Ring) uint64 {
+	write := atomic.LoadUint64(&r.write)
+	if write == 0 {
+		return 0
+	}
+	// this position may be written right now, it is
+	// the first one to read if no history is requested
+	start := write - 1
+	var count uint64
+	oldest := r.OldestWrite()
+	for start > oldest {
+		t, ok := r.read(start - 1)
+		if !ok {
+			break
+		}
+		if t == nil {
+			start--
+			continue
+		}
+		if q.Since != nil {
+			ts, ok := traceTime(t)
+			if ok && ts.Before(q.Since.Add(-MaxTraceDelay)) {
+				break
+			}
+			if !ok || ts.Before(*q.Since) {
+				start--
+				continue
+			}
+		}
+		start--
+		if filters.Apply(q.Allowlist, q.Denylist, t) {
+			count++
+		}
+		if q.Number != 0 && count == q.Number {
+			break
+		}
+	}
+	return start
+}
+
+func traceTime(t *pb.Trace) (time.Time, bool) {
+	if t.Time == nil {
+		return time.Time{}, false
+	}
+	ts, err := ptypes.Timestamp(t.Time)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts, true
+}
@@ -0,0 +1,229 @@
+package ring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/moolen/juno/pkg/filters"
+	pb "github.com/moolen/juno/proto"
+)
+
+func newTestRing(t *testing.T, base time.Time, n int) *Ring {
+	r := NewRing(15)
+	for i := 0; i < n; i++ {
+		ts, err := ptypes.TimestampProto(base.Add(time.Duration(i) * time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		node := "a"
+		if i%2 == 1 {
+			node = "b"
+		}
+		r.Write(&pb.Trace{
+			Time:     ts,
+			NodeName: node,
+		})
+	}
+	return r
+}
+
+func runQuery(t *testing.T, r *Ring, q *Query) []int64 {
+	var out []int64
+	err := q.Run(context.Background(), r, func(tr *pb.Trace) error {
+		out = append(out, tr.Time.Seconds)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestQuery(t *testing.T) {
+	base := time.Unix(100, 0)
+	nodeB, _ := filters.BuildFilterList([]*pb.TraceFilter{{NodeName: []string{"b"}}})
+	since := base.Add(5 * time.Second)
+	until := base.Add(7 * time.Second)
+	for i, row := range []struct {
+		writes   int
+		query    *Query
+		expected []int64
+	}{
+		{
+			writes:   0,
+			query:    &Query{},
+			expected: nil,
+		},
+		{
+			// the last write is not readable yet
+			writes:   6,
+			query:    &Query{},
+			expected: []int64{100, 101, 102, 103, 104},
+		},
+		{
+			// the oldest traces have been overwritten
+			writes:   20,
+			query:    &Query{},
+			expected: []int64{104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118},
+		},
+		{
+			writes:   10,
+			query:    &Query{Number: 3},
+			expected: []int64{106, 107, 108},
+		},
+		{
+			writes:   10,
+			query:    &Query{Number: 2, Allowlist: nodeB},
+			expected: []int64{105, 107},
+		},
+		{
+			writes:   10,
+			query:    &Query{Since: &since},
+			expected: []int64{105, 106, 107, 108},
+		},
+		{
+			writes:   10,
+			query:    &Query{Since: &since, Until: &until},
+			expected: []int64{105, 106, 107},
+		},
+		{
+			writes:   10,
+			query:    &Query{Number: 2, Since: &since},
+			expected: []int64{107, 108},
+		},
+	} {
+		r := newTestRing(t, base, row.writes)
+		found := runQuery(t, r, row.query)
+		if len(found) != len(row.expected) {
+			t.Errorf("[%d] unexpected traces. expected %v, found %v", i, row.expected, found)
+			continue
+		}
+		for j := range found {
+			if found[j] != row.expected[j] {
+				t.Errorf("[%d] unexpected traces. expected %v, found %v", i, row.expected, found)
+				break
+			}
+		}
+	}
+}
+
+func TestQueryFollow(t *testing.T) {
+	base := time.Unix(100, 0)
+	r := newTestRing(t, base, 7)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var found []int64
+	err := (&Query{Number: 2, Follow: true}).Run(ctx, r, func(tr *pb.Trace) error {
+		found = append(found, tr.Time.Seconds)
+		switch len(found) {
+		case 2:
+			// makes the last trace readable
+			ts, _ := ptypes.TimestampProto(base.Add(7 * time.Second))
+			r.Write(&pb.Trace{Time: ts})
+		case 3:
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("unexpected err: %v", err)
+	}
+	expected := []int64{104, 105, 106}
+	if len(found) != len(expected) {
+		t.Fatalf("unexpected traces. expected %v, found %v", expected, found)
+	}
+	for j := range found {
+		if found[j] != expected[j] {
+			t.Fatalf("unexpected traces. expected %v, found %v", expected, found)
+		}
+	}
+}
+
+func TestQueryFollowUntil(t *testing.T) {
+	base := time.Now().Add(-MaxTraceDelay - 10*time.Second)
+	r := newTestRing(t, base, 5)
+	until := base.Add(2 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var found []int64
+	// no trace after until is written to the ring
+	err := (&Query{Number: 5, Until: &until, Follow: true}).Run(ctx, r, func(tr *pb.Trace) error {
+		found = append(found, tr.Time.Seconds-base.Unix())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected err: %v", err)
+	}
+	expected := []int64{0, 1, 2}
+	if len(found) != len(expected) {
+		t.Fatalf("unexpected traces. expected %v, found %v", expected, found)
+	}
+	for j := range found {
+		if found[j] != expected[j] {
+			t.Fatalf("unexpected traces. expected %v, found %v", expected, found)
+		}
+	}
+}
+
+func TestQueryDisorder(t *testing.T) {
+	since := time.Unix(102, 0)
+	until := time.Unix(103, 0)
+	for i, row := range []struct {
+		writes   []int64
+		query    *Query
+		expected []int64
+	}{
+		{
+			writes:   []int64{90, 100, 102, 101, 104, 103, 96, 105, 106, 99},
+			query:    &Query{Since: &since},
+			expected: []int64{102, 104, 103, 105, 106},
+		},
+		{
+			writes:   []int64{90, 100, 102, 101, 104, 103, 96, 105, 106, 99},
+			query:    &Query{Until: &until},
+			expected: []int64{90, 100, 102, 101, 103, 96},
+		},
+		{
+			writes:   []int64{90, 100, 102, 101, 104, 103, 96, 105, 106, 99},
+			query:    &Query{Since: &since, Until: &until},
+			expected: []int64{102, 103},
+		},
+		{
+			writes:   []int64{90, 100, 102, 101, 104, 103, 96, 105, 106, 99},
+			query:    &Query{Number: 3, Since: &since},
+			expected: []int64{103, 105, 106},
+		},
+		{
+			// traces beyond MaxTraceDelay end the scan
+			writes:   []int64{102, 80, 103, 104, 99},
+			query:    &Query{Since: &since},
+			expected: []int64{103, 104},
+		},
+		{
+			writes:   []int64{100, 101, 120, 102, 99},
+			query:    &Query{Until: &until},
+			expected: []int64{100, 101},
+		},
+	} {
+		r := NewRing(15)
+		for _, sec := range row.writes {
+			r.Write(&pb.Trace{Time: &timestamp.Timestamp{Seconds: sec}})
+		}
+		found := runQuery(t, r, row.query)
+		if len(found) != len(row.expected) {
+			t.Errorf("[%d] unexpected traces. expected %v, found %v", i, row.expected, found)
+			continue
+		}
+		for j := range found {
+			if found[j] != row.expected[j] {
+				t.Errorf("[%d] unexpected traces. expected %v, found %v", i, row.expected, found)
+				break
+			}
+		}
+	}
+}
@@ -154,6 +154,15 @@ func (r *Ring) LastWrite() uint64 {
 	return atomic.LoadUint64(&r.write) - 1
 }
 
+// OldestWrite returns the oldest element written which can still be read.
+func (r *Ring) OldestWrite() uint64 {
+	write := atomic.LoadUint64(&r.write)
+	if write > r.dataLen {
+		return write - r.dataLen
+	}
+	return 0
+}
+
 // read reads the *pb.Trace from the given read position. Returns false if
 // that position is no longer available to be read, returns true otherwise.
 func (r *Ring) read(read uint64) (*pb.Trace, bool) {
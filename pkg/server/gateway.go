@@ -26,7 +26,7 @@ const (
 )
 
 // NewGateway ..
-func NewGateway(address string) (*TraceProviderClient, error) {
+func NewGateway(ctx context.Context, address string) (*TraceProviderClient, error) {
 	log.Infof("creating grpc gateway: %s", address)
 	callOpts := []retry.CallOption{
 		retry.WithBackoff(retry.BackoffLinear(RetryInterval)),
@@ -41,7 +41,7 @@ func NewGateway(address string) (*TraceProviderClient, error) {
 		grpc.WithBlock(),
 		grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
 	}
-	conn, err := grpc.DialContext(context.TODO(), address, dialOpts...)
+	conn, err := grpc.DialContext(ctx, address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error dialing grpc server: %v", err)
 	}
@@ -2,140 +2,433 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"os/exec"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/awalterschulze/gographviz"
+	"github.com/moolen/juno/pkg/ipcache"
+	sg "github.com/moolen/statusgraph/pkg/store"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
+// Graph is a concurrency-safe service dependency graph.
+// Nodes are identified by their ServiceID, edges point
+// from a client to a connector (port) of a server.
+// Edges which have not been seen within ttl are removed
+// by the garbage collector, see RunGC.
 type Graph struct {
 	mu    sync.RWMutex
-	nodes []*Node          `json:"nodes"`
-	edges map[Node][]*Node `json:"edges"`
+	ttl   time.Duration
+	nodes map[string]*Node
+	edges map[edgeKey]*Edge
 }
 
+// Node is a service in the graph
 type Node struct {
-	ServiceID string `json:"service_id"`
+	ServiceID string
+	Namespace string
+	Labels    map[string]string
+	Connector []sg.NodeConnector
+}
+
+// Edge is a directed connection between two services
+type Edge struct {
+	Source    string
+	Target    string
+	Connector sg.NodeConnector
+	LastSeen  time.Time
+}
+
+type edgeKey struct {
+	source string
+	target string
+	port   string
 }
 
 // NewGraph returns a new graph
-func NewGraph() *Graph {
+func NewGraph(ttl time.Duration) *Graph {
 	return &Graph{
 		mu:    sync.RWMutex{},
-		nodes: make([]*Node, 0),
-		edges: make(map[Node][]*Node),
+		ttl:   ttl,
+		nodes: make(map[string]*Node),
+		edges: make(map[edgeKey]*Edge),
 	}
 }
 
+// FindNode returns a copy of the node with the given id
+// or nil if it does not exist
 func (g *Graph) FindNode(id string) *Node {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
-	for _, node := range g.nodes {
-		if node.ServiceID == id {
-			return node
-		}
+	n, ok := g.nodes[id]
+	if !ok {
+		return nil
 	}
-	return nil
+	return n.copy()
 }
 
-// AddNode adds a node
+// AddNode adds a node. If the node already exists
+// the connectors are merged
 func (g *Graph) AddNode(n *Node) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	g.nodes = append(g.nodes, n)
+	g.addNode(n)
+}
+
+func (g *Graph) addNode(n *Node) *Node {
+	existing, ok := g.nodes[n.ServiceID]
+	if !ok {
+		existing = &Node{ServiceID: n.ServiceID}
+		g.nodes[n.ServiceID] = existing
+	}
+	if n.Namespace != "" {
+		existing.Namespace = n.Namespace
+	}
+	if n.Labels != nil {
+		existing.Labels = n.Labels
+	}
+	for _, c := range n.Connector {
+		if !hasConnector(existing.Connector, c) {
+			existing.Connector = append(existing.Connector, c)
+		}
+	}
+	return existing
 }
 
-// AddEdge adds an edge
-func (g *Graph) AddEdge(n1, n2 *Node) {
+// EnsureEdge adds both nodes and an edge from n1 to every connector of n2.
+// If n2 has no connector a plain edge is created.
+// Existing edges are refreshed.
+func (g *Graph) EnsureEdge(n1, n2 *Node) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	if g.edges == nil {
-		g.edges = make(map[Node][]*Node)
+	g.addNode(n1)
+	g.addNode(n2)
+	now := time.Now()
+	connectors := n2.Connector
+	if len(connectors) == 0 {
+		connectors = []sg.NodeConnector{{}}
+	}
+	for _, c := range connectors {
+		key := edgeKey{
+			source: n1.ServiceID,
+			target: n2.ServiceID,
+			port:   c.Label,
+		}
+		e, ok := g.edges[key]
+		if !ok {
+			e = &Edge{
+				Source:    n1.ServiceID,
+				Target:    n2.ServiceID,
+				Connector: c,
+			}
+			g.edges[key] = e
+		}
+		e.LastSeen = now
 	}
-	g.edges[*n1] = append(g.edges[*n1], n2)
 }
 
-func (g *Graph) EnsureEdge(n1, n2 *Node) {
+// Observe adds the connection between the given statusgraph nodes.
+// The endpoints are used to annotate the nodes with their namespace and
+// labels. They are looked up by node name, see getIdentity.
+func (g *Graph) Observe(src, dst *sg.Node, endpoints map[string]*ipcache.Endpoint) {
+	g.EnsureEdge(
+		newNode(src, endpoints[src.Name]),
+		newNode(dst, endpoints[dst.Name]),
+	)
+}
+
+func newNode(n *sg.Node, ep *ipcache.Endpoint) *Node {
+	node := &Node{
+		ServiceID: n.Name,
+		Connector: n.Connector,
+	}
+	if ep != nil {
+		node.Namespace = ep.Namespace
+		node.Labels = ep.Labels
+	}
+	return node
+}
+
+// Expire removes all edges that have not been seen since ttl
+// and all nodes which are no longer connected to any other node.
+func (g *Graph) Expire(now time.Time) {
 	g.mu.Lock()
-	found := false
-	for _, v := range g.edges[*n1] {
-		if v == n2 {
-			found = true
+	defer g.mu.Unlock()
+	connected := make(map[string]bool)
+	for key, e := range g.edges {
+		if now.Sub(e.LastSeen) > g.ttl {
+			log.Debugf("expiring edge %s -> %s:%s", e.Source, e.Target, e.Connector.Label)
+			delete(g.edges, key)
+			continue
+		}
+		connected[e.Source] = true
+		connected[e.Target] = true
+	}
+	for id := range g.nodes {
+		if !connected[id] {
+			delete(g.nodes, id)
+		}
+	}
+}
+
+// RunGC periodically expires stale edges until the context is canceled
+func (g *Graph) RunGC(ctx context.Context) {
+	interval := g.ttl / 2
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			g.Expire(now)
+		}
+	}
+}
+
+// GraphFilter narrows down the graph, see Subgraph
+type GraphFilter struct {
+	// Namespace and Selector select nodes by their namespace and labels
+	Namespace string
+	Selector  labels.Selector
+	// Node selects the neighbourhood of a node within the given number of hops
+	Node string
+	Hops int
+}
+
+// Subgraph returns a copy of the graph which contains only the nodes
+// matched by namespace and selector, including the edges to their peers.
+// If a node is set the result is further reduced to its neighbourhood.
+func (g *Graph) Subgraph(f GraphFilter) *Graph {
+	nodes, edges := g.snapshot()
+	if f.Namespace != "" || f.Selector != nil {
+		seeds := make(map[string]bool)
+		for _, n := range nodes {
+			if f.Namespace != "" && n.Namespace != f.Namespace {
+				continue
+			}
+			if f.Selector != nil && !f.Selector.Matches(labels.Set(n.Labels)) {
+				continue
+			}
+			seeds[n.ServiceID] = true
+		}
+		keep := make(map[string]bool)
+		var matched []*Edge
+		for _, e := range edges {
+			if seeds[e.Source] || seeds[e.Target] {
+				matched = append(matched, e)
+				keep[e.Source] = true
+				keep[e.Target] = true
+			}
+		}
+		for id := range seeds {
+			keep[id] = true
+		}
+		nodes, edges = filterNodes(nodes, keep), matched
+	}
+	if f.Node != "" {
+		visited := map[string]bool{f.Node: true}
+		frontier := []string{f.Node}
+		for i := 0; i < f.Hops && len(frontier) > 0; i++ {
+			var next []string
+			for _, id := range frontier {
+				for _, e := range edges {
+					var peer string
+					if e.Source == id {
+						peer = e.Target
+					} else if e.Target == id {
+						peer = e.Source
+					}
+					if peer != "" && !visited[peer] {
+						visited[peer] = true
+						next = append(next, peer)
+					}
+				}
+			}
+			frontier = next
+		}
+		var matched []*Edge
+		for _, e := range edges {
+			if visited[e.Source] && visited[e.Target] {
+				matched = append(matched, e)
+			}
 		}
+		nodes, edges = filterNodes(nodes, visited), matched
+	}
+
+	sub := NewGraph(g.ttl)
+	for _, n := range nodes {
+		sub.nodes[n.ServiceID] = n
+	}
+	for _, e := range edges {
+		sub.edges[edgeKey{
+			source: e.Source,
+			target: e.Target,
+			port:   e.Connector.Label,
+		}] = e
 	}
-	g.mu.Unlock()
-	if !found {
-		g.AddEdge(n1, n2)
+	return sub
+}
+
+func filterNodes(nodes []*Node, keep map[string]bool) []*Node {
+	var out []*Node
+	for _, n := range nodes {
+		if keep[n.ServiceID] {
+			out = append(out, n)
+		}
 	}
+	return out
 }
 
-func (g *Graph) WriteDotGraph(target string) error {
+// snapshot returns a sorted copy of all nodes and edges
+func (g *Graph) snapshot() ([]*Node, []*Edge) {
 	g.mu.RLock()
+	defer g.mu.RUnlock()
+	nodes := make([]*Node, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		nodes = append(nodes, n.copy())
+	}
+	edges := make([]*Edge, 0, len(g.edges))
+	for _, e := range g.edges {
+		c := *e
+		edges = append(edges, &c)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ServiceID < nodes[j].ServiceID
+	})
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Source != edges[j].Source {
+			return edges[i].Source < edges[j].Source
+		}
+		if edges[i].Target != edges[j].Target {
+			return edges[i].Target < edges[j].Target
+		}
+		return edges[i].Connector.Label < edges[j].Connector.Label
+	})
+	return nodes, edges
+}
+
+// DotGraph returns the graph in DOT format
+func (g *Graph) DotGraph() (string, error) {
+	nodes, edges := g.snapshot()
 	graphAst, _ := gographviz.ParseString(`digraph G {}`)
 	graph := gographviz.NewGraph()
 	if err := gographviz.Analyse(graphAst, graph); err != nil {
-		return err
+		return "", err
 	}
 
-	for i := 0; i < len(g.nodes); i++ {
-		err := graph.AddNode("G", sanitize(g.nodes[i].ServiceID), nil)
+	for _, n := range nodes {
+		err := graph.AddNode("G", sanitize(n.ServiceID), nil)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
-	for i := 0; i < len(g.nodes); i++ {
-		near := g.edges[*g.nodes[i]]
-		for j := 0; j < len(near); j++ {
-			graph.AddEdge(sanitize(g.nodes[i].ServiceID), sanitize(near[j].ServiceID), true, nil)
+	for _, e := range edges {
+		var attrs map[string]string
+		if e.Connector.Label != "" {
+			attrs = map[string]string{
+				"label": fmt.Sprintf("%q", e.Connector.Label),
+			}
+		}
+		err := graph.AddEdge(sanitize(e.Source), sanitize(e.Target), true, attrs)
+		if err != nil {
+			return "", err
 		}
 	}
+	return graph.String(), nil
+}
 
-	g.mu.RUnlock()
-
-	output := graph.String()
+// WriteDot writes the graph in DOT format
+func (g *Graph) WriteDot(w io.Writer) error {
+	output, err := g.DotGraph()
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, output)
+	return err
+}
 
-	cmd := exec.Command("dot", "-Tsvg")
+// WriteSVG renders the graph as svg. This requires graphviz to be installed.
+func (g *Graph) WriteSVG(ctx context.Context, w io.Writer) error {
+	output, err := g.DotGraph()
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, "dot", "-Tsvg")
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 	cmd.Stdin = bytes.NewBufferString(output)
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
-	err := cmd.Run()
+	err = cmd.Run()
 	if err != nil {
 		return fmt.Errorf("error running dot: %s \nstdout: %s\n stderr: %s", err, stdout.String(), stderr.String())
 	}
-	return ioutil.WriteFile(target, stdout.Bytes(), 0777)
+	_, err = w.Write(stdout.Bytes())
+	return err
 }
 
 func (g *Graph) JSONGraph() ([]byte, error) {
-	g.mu.RLock()
+	nodes, edges := g.snapshot()
 	var export ExportGraph
-	for i := 0; i < len(g.nodes); i++ {
-		export.Nodes = append(export.Nodes, ExportNode{
-			ID:        g.nodes[i].ServiceID,
-			ServiceID: g.nodes[i].ServiceID,
+	for _, n := range nodes {
+		en := ExportNode{
+			ID:        n.ServiceID,
+			ServiceID: n.ServiceID,
+			Namespace: n.Namespace,
+			Labels:    n.Labels,
 			Type:      "rect",
-		})
-	}
-	for i := 0; i < len(g.nodes); i++ {
-		near := g.edges[*g.nodes[i]]
-		for j := 0; j < len(near); j++ {
-			export.Edges = append(export.Edges, ExportEdge{
-				Source: g.nodes[i].ServiceID,
-				Target: near[j].ServiceID,
-				Type:   "regular",
+		}
+		for _, c := range n.Connector {
+			en.Connector = append(en.Connector, ExportConnector{
+				Name:  c.Name,
+				Label: c.Label,
 			})
 		}
+		export.Nodes = append(export.Nodes, en)
+	}
+	for _, e := range edges {
+		export.Edges = append(export.Edges, ExportEdge{
+			Source:    e.Source,
+			Target:    e.Target,
+			Connector: e.Connector.Label,
+			Type:      "regular",
+		})
 	}
-	g.mu.RUnlock()
 	return json.Marshal(export)
 }
 
+func (n *Node) copy() *Node {
+	c := &Node{
+		ServiceID: n.ServiceID,
+		Namespace: n.Namespace,
+		Labels:    n.Labels,
+	}
+	c.Connector = append(c.Connector, n.Connector...)
+	return c
+}
+
+func hasConnector(list []sg.NodeConnector, c sg.NodeConnector) bool {
+	for _, v := range list {
+		if v.Label == c.Label {
+			return true
+		}
+	}
+	return false
+}
+
 var replacer = strings.NewReplacer("/", "", "_", "", "-", "")
 
 func sanitize(in string) string {
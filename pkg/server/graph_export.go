@@ -7,13 +7,22 @@ type ExportGraph struct {
 }
 
 type ExportNode struct {
-	ID        string `json:"id"`
-	ServiceID string `json:"service_id"`
-	Type      string `json:"type"`
+	ID        string            `json:"id"`
+	ServiceID string            `json:"service_id"`
+	Namespace string            `json:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Type      string            `json:"type"`
+	Connector []ExportConnector `json:"connector,omitempty"`
+}
+
+type ExportConnector struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
 }
 
 type ExportEdge struct {
-	Source string `json:"source"`
-	Target string `json:"target"`
-	Type   string `json:"type"`
+	Source    string `json:"source"`
+	Target    string `json:"target"`
+	Connector string `json:"connector,omitempty"`
+	Type      string `json:"type"`
 }
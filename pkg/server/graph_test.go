@@ -1,9 +1,17 @@
 package server
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moolen/juno/pkg/ipcache"
+	sg "github.com/moolen/statusgraph/pkg/store"
+	"k8s.io/apimachinery/pkg/labels"
+)
 
 func TestGraph(t *testing.T) {
-	g := NewGraph()
+	g := NewGraph(time.Minute)
 
 	n1 := &Node{
 		ServiceID: "1",
@@ -21,6 +29,139 @@ func TestGraph(t *testing.T) {
 
 	g.EnsureEdge(n1, n2)
 	g.EnsureEdge(n1, n3)
+	g.EnsureEdge(n1, n3)
+
+	nodes, edges := g.snapshot()
+	if len(nodes) != 3 {
+		t.Errorf("unexpected number of nodes: %d", len(nodes))
+	}
+	if len(edges) != 2 {
+		t.Errorf("unexpected number of edges: %d", len(edges))
+	}
+
+	dot, err := g.DotGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(dot, "1->2") || !strings.Contains(dot, "1->3") {
+		t.Errorf("unexpected dot graph: %s", dot)
+	}
+}
+
+func TestGraphObserve(t *testing.T) {
+	g := NewGraph(time.Minute)
+	src := &sg.Node{Name: "frontend"}
+	dst := &sg.Node{
+		Name: "backend",
+		Connector: []sg.NodeConnector{
+			{Name: "http", Label: "8080"},
+		},
+	}
+	g.Observe(src, dst, nil)
+	g.Observe(src, &sg.Node{
+		Name: "backend",
+		Connector: []sg.NodeConnector{
+			{Name: "grpc", Label: "9090"},
+		},
+	}, nil)
 
-	g.WriteDotGraph("/tmp/graph.svg")
+	n := g.FindNode("backend")
+	if n == nil {
+		t.Fatalf("expected to find backend node")
+	}
+	if len(n.Connector) != 2 {
+		t.Errorf("expected connectors to be merged, found %v", n.Connector)
+	}
+	_, edges := g.snapshot()
+	if len(edges) != 2 {
+		t.Fatalf("unexpected number of edges: %d", len(edges))
+	}
+	if edges[0].Connector.Label != "8080" || edges[1].Connector.Label != "9090" {
+		t.Errorf("unexpected edge connectors: %v, %v", edges[0].Connector, edges[1].Connector)
+	}
+}
+
+func TestGraphExpire(t *testing.T) {
+	g := NewGraph(time.Minute)
+	g.Observe(&sg.Node{Name: "a"}, &sg.Node{Name: "b"}, nil)
+	g.Observe(&sg.Node{Name: "b"}, &sg.Node{Name: "c"}, nil)
+
+	g.mu.Lock()
+	for k, e := range g.edges {
+		if k.source == "a" {
+			e.LastSeen = e.LastSeen.Add(-time.Hour)
+		}
+	}
+	g.mu.Unlock()
+
+	g.Expire(time.Now())
+	nodes, edges := g.snapshot()
+	if len(edges) != 1 || edges[0].Source != "b" {
+		t.Errorf("expected only b -> c to survive, found %v", edges)
+	}
+	if len(nodes) != 2 || g.FindNode("a") != nil {
+		t.Errorf("expected orphaned node to be removed, found %v", nodes)
+	}
+}
+
+func TestSubgraph(t *testing.T) {
+	g := NewGraph(time.Minute)
+	endpoints := map[string]*ipcache.Endpoint{
+		"frontend": {Namespace: "web", Labels: map[string]string{"tier": "frontend"}},
+		"backend":  {Namespace: "api", Labels: map[string]string{"tier": "backend"}},
+		"db":       {Namespace: "data", Labels: map[string]string{"tier": "db"}},
+		"cache":    {Namespace: "data", Labels: map[string]string{"tier": "cache"}},
+	}
+	g.Observe(&sg.Node{Name: "frontend"}, &sg.Node{Name: "backend"}, endpoints)
+	g.Observe(&sg.Node{Name: "backend"}, &sg.Node{Name: "db"}, endpoints)
+	g.Observe(&sg.Node{Name: "backend"}, &sg.Node{Name: "cache"}, endpoints)
+
+	for i, row := range []struct {
+		filter GraphFilter
+		nodes  []string
+		edges  int
+	}{
+		{
+			filter: GraphFilter{},
+			nodes:  []string{"backend", "cache", "db", "frontend"},
+			edges:  3,
+		},
+		{
+			filter: GraphFilter{Namespace: "web"},
+			nodes:  []string{"backend", "frontend"},
+			edges:  1,
+		},
+		{
+			filter: GraphFilter{Selector: labels.SelectorFromSet(labels.Set{"tier": "db"})},
+			nodes:  []string{"backend", "db"},
+			edges:  1,
+		},
+		{
+			filter: GraphFilter{Node: "frontend", Hops: 1},
+			nodes:  []string{"backend", "frontend"},
+			edges:  1,
+		},
+		{
+			filter: GraphFilter{Node: "frontend", Hops: 2},
+			nodes:  []string{"backend", "cache", "db", "frontend"},
+			edges:  3,
+		},
+		{
+			filter: GraphFilter{Namespace: "data", Node: "db", Hops: 2},
+			nodes:  []string{"backend", "cache", "db"},
+			edges:  2,
+		},
+	} {
+		nodes, edges := g.Subgraph(row.filter).snapshot()
+		var ids []string
+		for _, n := range nodes {
+			ids = append(ids, n.ServiceID)
+		}
+		if strings.Join(ids, ",") != strings.Join(row.nodes, ",") {
+			t.Errorf("[%d] unexpected nodes. expected %v, found %v", i, row.nodes, ids)
+		}
+		if len(edges) != row.edges {
+			t.Errorf("[%d] unexpected number of edges. expected %d, found %d", i, row.edges, len(edges))
+		}
+	}
 }
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const defaultHops = 1
+
+// newGraphHandler serves the service graph in different formats:
+//
+//	/graph.json  statusgraph compatible json
+//	/graph.dot   graphviz dot
+//	/graph.svg   svg rendered by graphviz
+//
+// The graph can be filtered with the following query parameters:
+//
+//	namespace=<ns>        nodes in the given namespace and their peers
+//	selector=<selector>   nodes matching the label selector and their peers
+//	node=<id>&hops=<n>    neighbourhood of the node within n hops
+func newGraphHandler(g *Graph) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graph.json", func(w http.ResponseWriter, r *http.Request) {
+		sub, err := subgraphFromRequest(g, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, err := sub.JSONGraph()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+	mux.HandleFunc("/graph.dot", func(w http.ResponseWriter, r *http.Request) {
+		sub, err := subgraphFromRequest(g, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var buf bytes.Buffer
+		if err := sub.WriteDot(&buf); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		w.Write(buf.Bytes())
+	})
+	mux.HandleFunc("/graph.svg", func(w http.ResponseWriter, r *http.Request) {
+		sub, err := subgraphFromRequest(g, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var buf bytes.Buffer
+		if err := sub.WriteSVG(r.Context(), &buf); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Write(buf.Bytes())
+	})
+	return mux
+}
+
+func subgraphFromRequest(g *Graph, r *http.Request) (*Graph, error) {
+	q := r.URL.Query()
+	f := GraphFilter{
+		Namespace: q.Get("namespace"),
+		Node:      q.Get("node"),
+		Hops:      defaultHops,
+	}
+	if sel := q.Get("selector"); sel != "" {
+		selector, err := labels.Parse(sel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %s", err)
+		}
+		f.Selector = selector
+	}
+	if hops := q.Get("hops"); hops != "" {
+		n, err := strconv.Atoi(hops)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid hops: %s", hops)
+		}
+		f.Hops = n
+	}
+	return g.Subgraph(f), nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	log.Error(err)
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moolen/juno/pkg/ipcache"
+	sg "github.com/moolen/statusgraph/pkg/store"
+)
+
+func TestGraphHandler(t *testing.T) {
+	g := NewGraph(time.Minute)
+	endpoints := map[string]*ipcache.Endpoint{
+		"frontend": {Namespace: "web"},
+		"backend":  {Namespace: "api"},
+	}
+	g.Observe(&sg.Node{Name: "frontend"}, &sg.Node{
+		Name: "backend",
+		Connector: []sg.NodeConnector{
+			{Name: "http", Label: "8080"},
+		},
+	}, endpoints)
+	srv := httptest.NewServer(newGraphHandler(g))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/graph.json?namespace=api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	var export ExportGraph
+	if err := json.NewDecoder(res.Body).Decode(&export); err != nil {
+		t.Fatal(err)
+	}
+	if len(export.Nodes) != 2 || len(export.Edges) != 1 {
+		t.Fatalf("unexpected graph: %#v", export)
+	}
+	if export.Edges[0].Source != "frontend" || export.Edges[0].Connector != "8080" {
+		t.Errorf("unexpected edge: %#v", export.Edges[0])
+	}
+
+	res, err = http.Get(srv.URL + "/graph.dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	dot, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(dot), "frontend->backend") {
+		t.Errorf("unexpected dot graph: %s", dot)
+	}
+
+	for _, query := range []string{"selector=a%20in%20(", "hops=-1", "hops=foo"} {
+		res, err = http.Get(srv.URL + "/graph.json?" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected bad request for %s, got %d", query, res.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/moolen/juno/pkg/filters"
+	"github.com/moolen/juno/pkg/ring"
+	pb "github.com/moolen/juno/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 10000
+)
+
+// GetTraces streams the traces of all agents matching the request.
+// See ring.Query for the semantics of the request.
+func (o *Observer) GetTraces(req *pb.GetTracesRequest, gfs pb.Observer_GetTracesServer) error {
+	q, err := ring.NewQuery(req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+	}
+	return q.Run(gfs.Context(), o.ring, func(t *pb.Trace) error {
+		return gfs.Send(&pb.GetTracesResponse{
+			Trace: t,
+		})
+	})
+}
+
+// ListTraces returns a page of traces. The page token is the
+// position in the trace buffer. Tokens ahead of the buffer are
+// rejected, e.g. those issued before the server restarted.
+func (o *Observer) ListTraces(ctx context.Context, req *pb.ListTracesRequest) (*pb.ListTracesResponse, error) {
+	size := int(req.PageSize)
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+	start := o.ring.OldestWrite()
+	if req.PageToken != "" {
+		pos, err := strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil || pos > o.ring.LastWrite()+1 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %s", req.PageToken)
+		}
+		// continue at the token unless those traces
+		// have been overwritten in the meantime
+		if pos > start {
+			start = pos
+		}
+	}
+	allowlist, denylist, err := buildFilters(req.Allowlist, req.Denylist)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListTracesResponse{}
+	rr := ring.NewRingReader(o.ring, start)
+	next := start
+	for len(res.Traces) < size {
+		t := rr.Next()
+		if t == nil {
+			break
+		}
+		next++
+		if !filters.Apply(allowlist, denylist, t) {
+			continue
+		}
+		res.Traces = append(res.Traces, t)
+	}
+	res.NextPageToken = strconv.FormatUint(next, 10)
+	return res, nil
+}
+
+func buildFilters(allow, deny []*pb.TraceFilter) (filters.FilterFuncs, filters.FilterFuncs, error) {
+	allowlist, err := filters.BuildFilterList(allow)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid allowlist: %s", err)
+	}
+	denylist, err := filters.BuildFilterList(deny)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid denylist: %s", err)
+	}
+	return allowlist, denylist, nil
+}
+
+// ServerStatus returns some details
+func (o *Observer) ServerStatus(context.Context, *pb.ServerStatusRequest) (*pb.ServerStatusResponse, error) {
+	return &pb.ServerStatusResponse{
+		MaxFlows:  o.ring.Cap(),
+		NumFlows:  o.ring.Len(),
+		NumAgents: uint32(o.peers.Len()),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moolen/juno/pkg/ring"
+	pb "github.com/moolen/juno/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListTraces(t *testing.T) {
+	o := &Observer{
+		ring: ring.NewRing(7),
+	}
+	for i := 0; i < 12; i++ {
+		o.ring.Write(&pb.Trace{
+			L4: &pb.Layer4{
+				Protocol: &pb.Layer4_TCP{
+					TCP: &pb.TCP{SourcePort: uint32(i)},
+				},
+			},
+		})
+	}
+
+	var ports []uint32
+	token := ""
+	for i := 0; i < 10; i++ {
+		res, err := o.ListTraces(context.Background(), &pb.ListTracesRequest{
+			PageSize:  3,
+			PageToken: token,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Traces) == 0 {
+			break
+		}
+		for _, tr := range res.Traces {
+			ports = append(ports, tr.GetL4().GetTCP().GetSourcePort())
+		}
+		token = res.NextPageToken
+	}
+	// the ring holds 8 traces, the last write is not readable yet
+	expected := []uint32{4, 5, 6, 7, 8, 9, 10}
+	if len(ports) != len(expected) {
+		t.Fatalf("unexpected traces. expected %v, found %v", expected, ports)
+	}
+	for i := range expected {
+		if ports[i] != expected[i] {
+			t.Fatalf("unexpected traces. expected %v, found %v", expected, ports)
+		}
+	}
+
+	for _, token := range []string{"foo", "13", "20"} {
+		_, err := o.ListTraces(context.Background(), &pb.ListTracesRequest{
+			PageToken: token,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected error for invalid page token %q, got %v", token, err)
+		}
+	}
+	// the position of the next write
+	_, err := o.ListTraces(context.Background(), &pb.ListTracesRequest{
+		PageToken: "12",
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"hash/fnv"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/moolen/juno/pkg/ring"
+	pb "github.com/moolen/juno/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+// peerManager maintains a trace stream to every agent behind the target.
+// The target host is resolved periodically, so new agents are picked up
+// and removed agents are disconnected.
+type peerManager struct {
+	host     string
+	port     string
+	interval time.Duration
+	handle   func(*pb.Trace)
+
+	mu    sync.Mutex
+	peers map[string]context.CancelFunc
+}
+
+func newPeerManager(target string, interval time.Duration, handle func(*pb.Trace)) (*peerManager, error) {
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(target, "dns:///"))
+	if err != nil {
+		return nil, err
+	}
+	return &peerManager{
+		host:     host,
+		port:     port,
+		interval: interval,
+		handle:   handle,
+		peers:    make(map[string]context.CancelFunc),
+	}, nil
+}
+
+// Run resolves the peers until the context is canceled
+func (m *peerManager) Run(ctx context.Context) {
+	for {
+		m.sync(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.interval):
+		}
+	}
+}
+
+// Len returns the number of peers
+func (m *peerManager) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.peers)
+}
+
+func (m *peerManager) sync(ctx context.Context) {
+	addrs, err := net.DefaultResolver.LookupHost(ctx, m.host)
+	if err != nil {
+		log.Errorf("error resolving peers for %s: %s", m.host, err)
+		return
+	}
+	current := make(map[string]bool)
+	for _, addr := range addrs {
+		current[net.JoinHostPort(addr, m.port)] = true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for addr, cancel := range m.peers {
+		if !current[addr] {
+			log.Infof("removing peer %s", addr)
+			cancel()
+			delete(m.peers, addr)
+		}
+	}
+	for addr := range current {
+		if _, ok := m.peers[addr]; ok {
+			continue
+		}
+		log.Infof("adding peer %s", addr)
+		peerCtx, cancel := context.WithCancel(ctx)
+		m.peers[addr] = cancel
+		go m.follow(peerCtx, addr)
+	}
+}
+
+// follow streams the traces of a single peer until the context is canceled.
+// The stream is re-established if it breaks. In that case the traces since
+// ring.MaxTraceDelay before the newest received trace are requested again,
+// as the ring of the peer is not strictly ordered by time. Traces which
+// were received already are dropped.
+func (m *peerManager) follow(ctx context.Context, addr string) {
+	received := newReceivedTraces()
+	for {
+		err := m.stream(ctx, addr, received)
+		if err != nil {
+			log.Errorf("error streaming traces from %s: %s", addr, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// stream follows the traces of a peer which have not been received yet
+func (m *peerManager) stream(ctx context.Context, addr string, received *receivedTraces) error {
+	gw, err := NewGateway(ctx, addr)
+	if err != nil {
+		return err
+	}
+	defer gw.conn.Close()
+	cl, err := gw.client.GetTraces(ctx, &pb.GetTracesRequest{
+		Since:  received.since(),
+		Follow: true,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := cl.Recv()
+		if err != nil {
+			return err
+		}
+		if res.Trace == nil || !received.add(res.Trace) {
+			continue
+		}
+		m.handle(res.Trace)
+	}
+}
+
+// receivedTraces remembers the traces of a peer which happened
+// within ring.MaxTraceDelay before the newest received trace
+type receivedTraces struct {
+	newest time.Time
+	pruned time.Time
+	seen   map[uint64]time.Time
+}
+
+func newReceivedTraces() *receivedTraces {
+	return &receivedTraces{
+		seen: make(map[uint64]time.Time),
+	}
+}
+
+// since returns the time to resume the stream from
+func (r *receivedTraces) since() *timestamp.Timestamp {
+	if r.newest.IsZero() {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(r.newest.Add(-ring.MaxTraceDelay))
+	if err != nil {
+		return nil
+	}
+	return ts
+}
+
+// add records the trace and returns false if it was received already.
+// Traces without a time are never requested again, so they are not recorded.
+func (r *receivedTraces) add(trace *pb.Trace) bool {
+	ts, err := ptypes.Timestamp(trace.Time)
+	if err != nil {
+		return true
+	}
+	// the trace is hashed before it is handled, the handler may modify it
+	data, err := proto.Marshal(trace)
+	if err != nil {
+		return true
+	}
+	h := fnv.New64a()
+	h.Write(data)
+	key := h.Sum64()
+	if _, ok := r.seen[key]; ok {
+		return false
+	}
+	if ts.After(r.newest) {
+		r.newest = ts
+		if r.newest.Sub(r.pruned) >= time.Second {
+			r.prune()
+		}
+	}
+	if !ts.Before(r.newest.Add(-ring.MaxTraceDelay)) {
+		r.seen[key] = ts
+	}
+	return true
+}
+
+// prune forgets the traces which will not be requested again
+func (r *receivedTraces) prune() {
+	oldest := r.newest.Add(-ring.MaxTraceDelay)
+	for key, ts := range r.seen {
+		if ts.Before(oldest) {
+			delete(r.seen, key)
+		}
+	}
+	r.pruned = r.newest
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"testing"
+
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	pb "github.com/moolen/juno/proto"
+)
+
+func TestReceivedTraces(t *testing.T) {
+	newTrace := func(sec int64, node string) *pb.Trace {
+		return &pb.Trace{
+			Time:     &timestamp.Timestamp{Seconds: sec},
+			NodeName: node,
+		}
+	}
+	received := newReceivedTraces()
+	if since := received.since(); since != nil {
+		t.Errorf("unexpected since: %v", since)
+	}
+	for i, row := range []struct {
+		trace    *pb.Trace
+		expected bool
+	}{
+		{trace: newTrace(100, "a"), expected: true},
+		{trace: newTrace(105, "a"), expected: true},
+		{trace: newTrace(103, "a"), expected: true},
+		{trace: newTrace(105, "b"), expected: true},
+		{trace: &pb.Trace{NodeName: "a"}, expected: true},
+		{trace: &pb.Trace{NodeName: "a"}, expected: true},
+		// resumed stream
+		{trace: newTrace(100, "a"), expected: false},
+		{trace: newTrace(103, "a"), expected: false},
+		{trace: newTrace(104, "a"), expected: true},
+		{trace: newTrace(105, "a"), expected: false},
+		{trace: newTrace(105, "b"), expected: false},
+		{trace: newTrace(120, "a"), expected: true},
+		// older than the resume window
+		{trace: newTrace(100, "a"), expected: true},
+		{trace: newTrace(100, "a"), expected: true},
+	} {
+		if added := received.add(row.trace); added != row.expected {
+			t.Errorf("[%d] unexpected add result. expected %t, found %t", i, row.expected, added)
+		}
+	}
+	if since := received.since(); since == nil || since.Seconds != 110 {
+		t.Errorf("unexpected since: %v", since)
+	}
+	if len(received.seen) != 1 {
+		t.Errorf("unexpected number of remembered traces: %d", len(received.seen))
+	}
+}
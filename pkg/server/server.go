@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"strconv"
 	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/moolen/juno/pkg/ipcache"
+	"github.com/moolen/juno/pkg/ring"
 	pb "github.com/moolen/juno/proto"
 	sg "github.com/moolen/statusgraph/pkg/store"
 	log "github.com/sirupsen/logrus"
@@ -17,23 +19,30 @@ import (
 )
 
 type Observer struct {
-	listener net.Listener
-	server   *grpc.Server
-	gw       *TraceProviderClient
-	ipcache  *ipcache.State
+	listener   net.Listener
+	server     *grpc.Server
+	httpServer *http.Server
+	peers      *peerManager
+	ipcache    *ipcache.State
+	ring       *ring.Ring
+	graph      *Graph
+	names      *serverNames
 }
 
-func New(client *kubernetes.Clientset, target string, port int, syncInterval time.Duration, bufferSize int) (*Observer, error) {
-	gw, err := NewGateway(target)
-	if err != nil {
-		return nil, err
-	}
+func New(client *kubernetes.Clientset, target string, port, httpPort int, syncInterval time.Duration, bufferSize, traceBufferSize int, graphTTL time.Duration) (*Observer, error) {
 	ipcache := ipcache.New(client, syncInterval, bufferSize)
 	ipcache.Run()
 	server := &Observer{
-		gw:      gw,
 		ipcache: ipcache,
+		ring:    ring.NewRing(traceBufferSize),
+		graph:   NewGraph(graphTTL),
+		names:   newServerNames(graphTTL),
+	}
+	peers, err := newPeerManager(target, syncInterval, server.handleTrace)
+	if err != nil {
+		return nil, err
 	}
+	server.peers = peers
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return nil, err
@@ -43,76 +52,81 @@ func New(client *kubernetes.Clientset, target string, port int, syncInterval tim
 		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
 		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
 	)
+	pb.RegisterObserverServer(grpcServer, server)
 	server.server = grpcServer
+	server.httpServer = &http.Server{
+		Addr:    fmt.Sprintf(":%d", httpPort),
+		Handler: newGraphHandler(server.graph),
+	}
 	return server, nil
 }
 
-func (o *Observer) fetchTraces() {
-	log.Infof("fetch traces: make client call")
-	cl, err := o.gw.client.GetTraces(context.Background(), &pb.GetTracesRequest{})
-	if err != nil {
-		log.Error(err)
+// Graph returns the live service graph
+func (o *Observer) Graph() *Graph {
+	return o.graph
+}
+
+// handleTrace enriches a trace received from an agent, stores it
+// and adds it to the service graph
+func (o *Observer) handleTrace(t *pb.Trace) {
+	if t.IP == nil {
 		return
 	}
+	srcEP, srcErr := o.lookupEndpoint(t.IP.GetSource())
+	dstEP, dstErr := o.lookupEndpoint(t.IP.GetDestination())
+	t.Source = toEndpoint(srcEP)
+	t.Destination = toEndpoint(dstEP)
+	o.ring.Write(t)
 
-	log.Infof("run recv loop")
-	//NewGraph()
-
-	for {
-		trace, err := cl.Recv()
-		if err != nil {
-			log.Error(err)
-			return
+	if srcErr != nil {
+		log.Debugf("could not find endpoint for src: %s", t.IP.GetSource())
+		return
+	}
+	if dstErr != nil {
+		log.Debugf("could not find endpoint for dst: %s", t.IP.GetDestination())
+		return
+	}
+	// public addresses are named by the TLS server name of the connection
+	if addr, name := o.names.observe(t, time.Now()); addr != "" {
+		if addr == t.IP.GetDestination() && isExternal(addr, dstEP) {
+			dstEP = &ipcache.Endpoint{Name: name}
+		} else if addr == t.IP.GetSource() && isExternal(addr, srcEP) {
+			srcEP = &ipcache.Endpoint{Name: name}
 		}
+	}
+	srcID, dstID, err := buildID(t, srcEP, dstEP)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+	o.graph.Observe(srcID, dstID, map[string]*ipcache.Endpoint{
+		getIdentity(t.IP.GetSource(), srcEP):      srcEP,
+		getIdentity(t.IP.GetDestination(), dstEP): dstEP,
+	})
+}
 
-		// add metadata
-		srcEP, err := o.ipcache.GetEndpointByIP(trace.Trace.IP.GetSource())
-		if err != nil {
-			log.Debugf("could not find endpoint for src: %s", trace.Trace.IP.GetSource())
-			continue
-		}
-		dstEP, err := o.ipcache.GetEndpointByIP(trace.Trace.IP.GetDestination())
-		if err != nil {
-			log.Debugf("could not find endpoint for dst: %s", trace.Trace.IP.GetDestination())
-			continue
-		}
-		log.Infof("srcEP %v, dstEP %v", srcEP, dstEP)
-		// build service id
-		// srcID, dstID, err := buildID(trace.Trace, srcEP, dstEP)
-		// if err != nil {
-		// 	log.Debug(err)
-		// 	continue
-		// }
-
-		// add to graph
-		// var srcNode, dstNode *Node
-		// srcNode = g.FindNode(srcID)
-		// if srcNode == nil {
-		// 	srcNode = &Node{
-		// 		ServiceID: srcID,
-		// 	}
-		// 	g.AddNode(srcNode)
-		// }
-		// dstNode = g.FindNode(dstID)
-		// if dstNode == nil {
-		// 	dstNode = &Node{
-		// 		ServiceID: dstID,
-		// 	}
-		// 	g.AddNode(dstNode)
-		// }
-		// g.EnsureEdge(srcNode, dstNode)
-
-		// // this is for debugging RN
-		// data, err := g.JSONGraph()
-		// g.WriteDotGraph("graph.svg")
-		// if err != nil {
-		// 	log.Error(err)
-		// }
-		// err = ioutil.WriteFile("graph.json", data, os.ModePerm)
-		// if err != nil {
-		// 	log.Error(err)
-		// }
+// lookupEndpoint returns the endpoint for the given ip.
+// Public addresses which are not known to the cluster
+// resolve to an empty endpoint, see getIdentity.
+func (o *Observer) lookupEndpoint(addr string) (*ipcache.Endpoint, error) {
+	ep, err := o.ipcache.GetEndpointByIP(addr)
+	if err == nil {
+		return ep, nil
+	}
+	if isPublicIP(net.ParseIP(addr)) {
+		return &ipcache.Endpoint{}, nil
+	}
+	return nil, err
+}
 
+func toEndpoint(ep *ipcache.Endpoint) *pb.Endpoint {
+	if ep == nil || ep.Name == "" {
+		return nil
+	}
+	return &pb.Endpoint{
+		Namespace: ep.Namespace,
+		Name:      ep.Name,
+		Labels:    ep.Labels,
 	}
 }
 
@@ -139,46 +153,36 @@ func buildID(t *pb.Trace, srcEP, dstEP *ipcache.Endpoint) (*sg.Node, *sg.Node, e
 
 	dst.Name = getIdentity(t.IP.Destination, dstEP)
 	src.Name = getIdentity(t.IP.Source, srcEP)
+	// the agent knows the client if it has seen the start of the connection
+	if reply := t.GetIsReply(); reply != nil {
+		if reply.Value {
+			src.Connector = connectorFor(sport, srcEP)
+			return dst, src, nil
+		}
+		dst.Connector = connectorFor(dport, dstEP)
+		return src, dst, nil
+	}
+	// otherwise guess by the ports
 	if deph && seph {
 		if !portMatchesEndpoint(dport, dstEP) && !portMatchesEndpoint(sport, srcEP) {
 			return nil, nil, fmt.Errorf("ephemere connection: %s:%d -> %s:%d (%#v | %#v)", t.IP.Source, sport, t.IP.Destination, dport, srcEP, dstEP)
 		}
 		if portMatchesEndpoint(dport, dstEP) {
-			dst.Connector = []sg.NodeConnector{
-				{
-					Name:  dstEP.Ports[0].Name,
-					Label: strconv.Itoa(int(dstEP.Ports[0].Port)),
-				},
-			}
+			dst.Connector = connectorFor(dport, dstEP)
 			return src, dst, nil
 		}
 		if portMatchesEndpoint(sport, srcEP) {
-			src.Connector = []sg.NodeConnector{
-				{
-					Name:  srcEP.Ports[0].Name,
-					Label: strconv.Itoa(int(srcEP.Ports[0].Port)),
-				},
-			}
+			src.Connector = connectorFor(sport, srcEP)
 			return dst, src, nil
 		}
 	}
 
 	if !seph {
-		src.Connector = []sg.NodeConnector{
-			{
-				Name:  srcEP.Ports[0].Name,
-				Label: strconv.Itoa(int(srcEP.Ports[0].Port)),
-			},
-		}
+		src.Connector = connectorFor(sport, srcEP)
 	}
 
 	if !deph {
-		dst.Connector = []sg.NodeConnector{
-			{
-				Name:  dstEP.Ports[0].Name,
-				Label: strconv.Itoa(int(dstEP.Ports[0].Port)),
-			},
-		}
+		dst.Connector = connectorFor(dport, dstEP)
 	}
 
 	// switch directions if dport is ephemeral
@@ -188,6 +192,21 @@ func buildID(t *pb.Trace, srcEP, dstEP *ipcache.Endpoint) (*sg.Node, *sg.Node, e
 	return src, dst, nil
 }
 
+// connectorFor returns the connector for the given port.
+// The port name is taken from the endpoint if it is known.
+func connectorFor(port uint32, ep *ipcache.Endpoint) []sg.NodeConnector {
+	c := sg.NodeConnector{
+		Label: strconv.Itoa(int(port)),
+	}
+	for _, p := range ep.Ports {
+		if p.Port == port {
+			c.Name = p.Name
+			break
+		}
+	}
+	return []sg.NodeConnector{c}
+}
+
 func portMatchesEndpoint(port uint32, ep *ipcache.Endpoint) bool {
 	for _, p := range ep.Ports {
 		if p.Port == port {
@@ -204,9 +223,21 @@ func isEphemeralPort(port uint32) bool {
 	return false
 }
 
+// isExternal returns true if the address is
+// public and not known to the cluster
+func isExternal(addr string, ep *ipcache.Endpoint) bool {
+	return ep.Name == "" && isPublicIP(net.ParseIP(addr))
+}
+
+// getIdentity returns the name of the endpoint in the service graph.
+// Public addresses are named "www" unless the endpoint is named
+// by the server name of the connection.
 func getIdentity(addr string, t *ipcache.Endpoint) string {
 	ip := net.ParseIP(addr)
 	if isPublicIP(ip) {
+		if t.Name != "" && t.Namespace == "" {
+			return t.Name
+		}
 		return "www"
 	}
 	for _, l := range []string{"app", "k8s-app"} {
@@ -233,17 +264,29 @@ func isPublicIP(IP net.IP) bool {
 			return true
 		}
 	}
-	return false
+	// unique local addresses fc00::/7 are private
+	if len(IP) != net.IPv6len || IP[0]&0xfe == 0xfc {
+		return false
+	}
+	return IP.IsGlobalUnicast()
 }
 
 func (srv *Observer) Serve(ctx context.Context) {
 	log.Infof("serve")
-	go srv.fetchTraces()
+	go srv.peers.Run(ctx)
+	go srv.graph.RunGC(ctx)
+	go func() {
+		log.Infof("http listening on %s", srv.httpServer.Addr)
+		if err := srv.httpServer.ListenAndServe(); err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
 	log.Fatal(srv.server.Serve(srv.listener))
 
 }
 
 func (srv *Observer) Stop() {
 	log.Infof("stop")
+	srv.httpServer.Shutdown(context.Background())
 	srv.server.GracefulStop()
 }
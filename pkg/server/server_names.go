@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+// serverNames remembers the TLS server name of connections.
+// The name is only sent in the ClientHello, later traces of
+// the connection are named by the connection.
+// Entries which have not been seen within ttl are removed.
+type serverNames struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	lastExpire time.Time
+	conns      map[connKey]*serverName
+}
+
+// connKey identifies a connection from client to server
+type connKey struct {
+	client string
+	server string
+}
+
+type serverName struct {
+	name     string
+	lastSeen time.Time
+}
+
+func newServerNames(ttl time.Duration) *serverNames {
+	return &serverNames{
+		ttl:   ttl,
+		conns: make(map[connKey]*serverName),
+	}
+}
+
+// observe returns the address and name of the server of the connection
+// which the trace belongs to. The address is empty if the name is unknown.
+func (s *serverNames) observe(t *pb.Trace, now time.Time) (string, string) {
+	tcp := t.GetL4().GetTCP()
+	if t.IP == nil || tcp == nil {
+		return "", ""
+	}
+	src := net.JoinHostPort(t.IP.Source, strconv.Itoa(int(tcp.SourcePort)))
+	dst := net.JoinHostPort(t.IP.Destination, strconv.Itoa(int(tcp.DestinationPort)))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.Sub(s.lastExpire) > s.ttl {
+		s.expire(now)
+		s.lastExpire = now
+	}
+	if tls := t.GetL7().GetTls(); tls != nil && tls.Handshake == pb.TLSHandshakeClientHello && tls.ServerName != "" {
+		s.conns[connKey{client: src, server: dst}] = &serverName{
+			name:     tls.ServerName,
+			lastSeen: now,
+		}
+		return t.IP.Destination, tls.ServerName
+	}
+	if sn, ok := s.conns[connKey{client: src, server: dst}]; ok {
+		sn.lastSeen = now
+		return t.IP.Destination, sn.name
+	}
+	if sn, ok := s.conns[connKey{client: dst, server: src}]; ok {
+		sn.lastSeen = now
+		return t.IP.Source, sn.name
+	}
+	return "", ""
+}
+
+// expire drops idle connections
+func (s *serverNames) expire(now time.Time) {
+	for key, sn := range s.conns {
+		if now.Sub(sn.lastSeen) > s.ttl {
+			delete(s.conns, key)
+		}
+	}
+}
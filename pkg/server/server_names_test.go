@@ -0,0 +1,123 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moolen/juno/pkg/ipcache"
+	pb "github.com/moolen/juno/proto"
+)
+
+func newTLSTrace(src, dst string, sport, dport uint32, tls *pb.TLS) *pb.Trace {
+	t := &pb.Trace{
+		IP: &pb.IP{
+			Source:      src,
+			Destination: dst,
+		},
+		L4: &pb.Layer4{
+			Protocol: &pb.Layer4_TCP{
+				TCP: &pb.TCP{
+					SourcePort:      sport,
+					DestinationPort: dport,
+				},
+			},
+		},
+	}
+	if tls != nil {
+		t.L7 = &pb.Layer7{
+			Record: &pb.Layer7_Tls{
+				Tls: tls,
+			},
+		}
+	}
+	return t
+}
+
+func TestServerNames(t *testing.T) {
+	names := newServerNames(time.Minute)
+	now := time.Now()
+	clientHello := &pb.TLS{
+		Handshake:  pb.TLSHandshakeClientHello,
+		ServerName: "api.stripe.com",
+	}
+	for i, row := range []struct {
+		trace *pb.Trace
+		now   time.Time
+		addr  string
+		name  string
+	}{
+		{
+			// unknown connection
+			trace: newTLSTrace("10.0.0.1", "54.187.174.169", 40000, 443, nil),
+			now:   now,
+		},
+		{
+			trace: newTLSTrace("10.0.0.1", "54.187.174.169", 40000, 443, clientHello),
+			now:   now,
+			addr:  "54.187.174.169",
+			name:  "api.stripe.com",
+		},
+		{
+			// response
+			trace: newTLSTrace("54.187.174.169", "10.0.0.1", 443, 40000, nil),
+			now:   now.Add(30 * time.Second),
+			addr:  "54.187.174.169",
+			name:  "api.stripe.com",
+		},
+		{
+			// other connection
+			trace: newTLSTrace("10.0.0.1", "54.187.174.169", 40001, 443, nil),
+			now:   now.Add(30 * time.Second),
+		},
+		{
+			// still seen by the response
+			trace: newTLSTrace("10.0.0.1", "54.187.174.169", 40000, 443, nil),
+			now:   now.Add(80 * time.Second),
+			addr:  "54.187.174.169",
+			name:  "api.stripe.com",
+		},
+		{
+			// expired
+			trace: newTLSTrace("10.0.0.1", "54.187.174.169", 40000, 443, nil),
+			now:   now.Add(200 * time.Second),
+		},
+	} {
+		addr, name := names.observe(row.trace, row.now)
+		if addr != row.addr || name != row.name {
+			t.Errorf("[%d] unexpected server name. expected %s/%s, got %s/%s", i, row.addr, row.name, addr, name)
+		}
+	}
+}
+
+func TestGetIdentity(t *testing.T) {
+	for i, row := range []struct {
+		addr     string
+		ep       *ipcache.Endpoint
+		expected string
+	}{
+		{
+			addr:     "10.0.0.1",
+			ep:       &ipcache.Endpoint{Name: "pod-1", Namespace: "default", Labels: map[string]string{"app": "web"}},
+			expected: "web",
+		},
+		{
+			addr:     "10.0.0.1",
+			ep:       &ipcache.Endpoint{Name: "pod-1", Namespace: "default"},
+			expected: "pod-1",
+		},
+		{
+			addr:     "8.8.8.8",
+			ep:       &ipcache.Endpoint{},
+			expected: "www",
+		},
+		{
+			addr:     "54.187.174.169",
+			ep:       &ipcache.Endpoint{Name: "api.stripe.com"},
+			expected: "api.stripe.com",
+		},
+	} {
+		if id := getIdentity(row.addr, row.ep); id != row.expected {
+			t.Errorf("[%d] unexpected identity. expected %s, got %s", i, row.expected, id)
+		}
+	}
+}
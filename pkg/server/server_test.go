@@ -1,8 +1,10 @@
 package server
 
 import (
+	"net"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/google/go-cmp/cmp"
 	"github.com/moolen/juno/pkg/ipcache"
 	pb "github.com/moolen/juno/proto"
@@ -185,6 +187,83 @@ func TestBuildID(t *testing.T) {
 				},
 			},
 		},
+		{
+			// the agent observed the start of the connection:
+			// the ports are not used to guess the direction
+			// sauce:8080 -> dest:40000
+			trace: &pb.Trace{
+				IP: &pb.IP{
+					Source:      "10.0.3.11",
+					Destination: "10.0.3.22",
+				},
+				L4: &pb.Layer4{
+					Protocol: &pb.Layer4_TCP{
+						TCP: &pb.TCP{
+							SourcePort:      8080,
+							DestinationPort: 40000,
+						},
+					},
+				},
+				IsReply: &wrappers.BoolValue{Value: false},
+			},
+			src: &ipcache.Endpoint{
+				Name:      "sauce",
+				Namespace: "sauce-ns",
+			},
+			dst: &ipcache.Endpoint{
+				Name:      "dest",
+				Namespace: "dest-ns",
+			},
+			expSrc: &sg.Node{
+				Name: "sauce",
+			},
+			expDst: &sg.Node{
+				Name: "dest",
+				Connector: []sg.NodeConnector{
+					{
+						Label: "40000",
+					},
+				},
+			},
+		},
+		{
+			// reply of the connection above
+			// dest:40000 -> sauce:8080
+			trace: &pb.Trace{
+				IP: &pb.IP{
+					Source:      "10.0.3.22",
+					Destination: "10.0.3.11",
+				},
+				L4: &pb.Layer4{
+					Protocol: &pb.Layer4_TCP{
+						TCP: &pb.TCP{
+							SourcePort:      40000,
+							DestinationPort: 8080,
+						},
+					},
+				},
+				IsReply: &wrappers.BoolValue{Value: true},
+			},
+			src: &ipcache.Endpoint{
+				Name:      "dest",
+				Namespace: "dest-ns",
+			},
+			dst: &ipcache.Endpoint{
+				Name:      "sauce",
+				Namespace: "sauce-ns",
+			},
+			expSrc: &sg.Node{
+				Name: "sauce",
+			},
+			expDst: &sg.Node{
+				Name: "dest",
+				Connector: []sg.NodeConnector{
+					{
+						Label: "40000",
+					},
+				},
+			},
+		},
 	}
 
 	for i, row := range tbl {
@@ -201,3 +280,25 @@ func TestBuildID(t *testing.T) {
 
 	}
 }
+
+func TestIsPublicIP(t *testing.T) {
+	for i, row := range []struct {
+		ip     string
+		public bool
+	}{
+		{ip: "10.0.1.5"},
+		{ip: "192.168.0.1"},
+		{ip: "172.20.0.1"},
+		{ip: "127.0.0.1"},
+		{ip: "8.8.8.8", public: true},
+		{ip: "::1"},
+		{ip: "fe80::1"},
+		{ip: "fd00::10"},
+		{ip: "2001:4860:4860::8888", public: true},
+		{ip: "invalid"},
+	} {
+		if isPublicIP(net.ParseIP(row.ip)) != row.public {
+			t.Errorf("[%d] unexpected result for %s. expected %v", i, row.ip, row.public)
+		}
+	}
+}
@@ -68,7 +68,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _tcptracerSockEbpfO = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x7b\x0d\x70\x5c\x57\x75\xff\xef\x7d\xee\x4a\xfb\x24\x4b\xab\xaf\x95\xb3\xb6\x56\x96\x65\xc9\x21\x5e\x59\xb6\x22\x3b\x76\x3e\x2c\xdb\x8a\x2d\x7f\x2a\x92\xec\x38\xe1\xe3\x79\xb5\xfb\x56\xbb\xf1\x6a\x77\xb3\xfb\xd6\x1f\x24\xc4\x86\x24\x90\x04\xc2\xdf\x10\xfe\xd4\x50\x32\x36\x14\x86\xb4\x65\x20\xb4\x50\x7b\xc8\x14\x33\x74\xda\x64\x08\x50\x33\x2d\xe0\xe9\x40\x1b\x48\x80\xb4\xa4\x21\x2d\x69\x6b\x20\x19\x75\xce\x7d\xf7\xbe\xf7\xb4\xd2\xda\x9d\x16\x66\x3a\xd3\x37\x63\xdf\x73\xce\x3d\xf7\x7c\xdd\x73\xee\xbd\xef\xdd\xd5\xc9\xd1\x3d\xb7\xcb\x92\x04\xf1\x48\xf8\x0f\x78\x98\xef\x19\xf2\xc0\x2d\xfc\xff\x76\x48\xb8\xd8\xee\xd0\x22\x12\x60\xd9\x9b\x62\x04\xf7\xa6\xea\x71\xdf\xd2\x17\xe6\x04\x7d\xa6\x60\x33\x7a\x31\x91\x3c\x72\xdf\xd2\xcb\x8c\x9e\x48\x8e\xb3\x71\x17\x3f\xe9\x8c\x0f\x48\xc0\xe5\xb9\xb9\xb9\xf3\x32\xd0\x04\xe0\x61\x00\x3a\xf1\x25\x1c\xbe\x44\x6a\x8f\xc3\xbf\x95\xf3\xcb\x40\x23\x80\x35\xe1\xed\x0c\xbf\x77\xd4\x70\xda\x6d\x0d\xac\x9d\x51\x80\x20\x80\xfd\x93\x0e\x7f\x9b\x22\xe1\x91\xa7\x80\x03\xca\x6a\xbc\x9b\xe4\x8c\x72\x39\x1a\x10\x26\x39\x6d\xab\x9c\xf1\x5b\x9b\xb8\x9c\xe6\xf9\x72\x7a\x84\x1c\x99\xc9\xb9\x38\xe1\xe0\x07\x94\x2e\x47\xde\x16\x2e\x0f\x00\x85\x44\xf4\xaf\x69\xba\x8e\xcb\x6d\xe5\x72\xdb\xae\x2e\x57\x16\x72\x5b\x17\xd8\xb9\x14\x5e\xff\x9a\x36\x6e\xe7\xd6\x08\x97\xdb\xf9\x5f\xb4\xb7\x6e\x81\xbd\xcb\xe7\xd9\xab\x71\xb9\x51\x2e\x77\xd9\xa2\x72\x85\x1d\x77\x2a\xc0\xdc\x1c\xe9\xe9\x65\xfd\xe7\x03\xce\xbc\xb4\x29\x37\xe3\xbd\x3f\x04\xce\x4b\x40\xae\x52\x8f\xe4\xd2\xd7\xdc\x7c\x88\xf5\xe6\x2a\x04\xc7\x06\x62\xbd\xf7\x2d\x7d\xd9\xa5\xdb\x99\x98\x4d\xf0\x89\xa2\xb5\xc9\x9f\x3f\x25\xcb\x66\xfc\xa5\x7c\xcc\x12\xf9\xb3\x58\xde\x90\xc5\xe7\x55\x30\x43\x45\xfe\x68\xb8\x99\xf1\x9d\xe7\x49\x5d\x5e\xfa\xfa\x9c\xc0\x63\xbd\xa9\x7a\xbf\x5d\xf3\xf5\x7b\x76\xe5\x8f\x26\x72\x04\x67\x53\xa4\x7f\x51\xbb\xb2\x57\xb3\x8b\x22\x78\x9e\xc5\x69\x6e\x4e\xc3\x6a\x27\x7e\x2b\x39\x9f\x0a\x50\x66\xac\x69\xd5\x9d\x78\xf7\x39\xf4\x99\x00\x40\x21\xbe\x33\x00\xe6\x81\xe0\x5f\x62\x3b\xed\x2d\x23\x21\x08\x3b\x72\xb9\xc5\xeb\x2e\x5b\x8c\x65\x99\xcf\xb1\x7c\xe5\x6a\xf6\x35\x71\xfb\xc0\xe2\xe5\xe4\xe7\xbd\xbd\x75\x4e\xfe\x68\x33\x2c\x8e\x01\x3e\xaf\xa2\xfe\x0e\x68\xcd\x68\xae\xf2\x23\xc8\xfc\x70\x12\x23\xdb\xaf\xb2\xb6\x37\xd8\xc8\x72\x8c\xe2\x4d\xf6\x25\x7d\x71\x4d\x15\x63\x19\x82\x53\xa5\x4d\xb1\x1a\x71\xbd\xaa\xdd\xad\xbe\x75\xe2\xbc\xd3\x8d\xff\xcf\x5b\x92\x33\x6d\x2d\x1e\x97\x4a\xaa\xc8\xe8\x39\x2b\x1f\x13\xf2\xb3\x3d\x8e\xbd\xd7\x5a\x8f\x58\xbc\xad\xfc\x0c\xf1\xd8\x19\xcf\x6e\x2f\xbf\x5e\x71\xf0\x3a\xa0\x37\x57\xa9\x4f\x7e\xdc\xf3\xb7\x98\x38\xc1\xf2\xa8\x90\x48\x99\x42\xef\xb1\xa0\x13\xb7\xc5\xf4\xd2\x4c\x5c\x7c\xd0\xa1\x0b\xfd\x42\xde\xf9\x3a\xcf\xcf\x42\x3a\x5d\x26\x98\xd6\x5f\xbf\x9f\x8b\xe9\x2b\x7f\xfc\x95\x9a\xf1\x64\xfa\xca\xf3\xf5\x2d\x79\x1f\xdc\xfa\x39\x45\xf3\x7b\xaf\xe3\x28\xd5\x39\xe1\xd9\x84\xd3\x9f\x98\xee\x67\xed\x91\xa5\x6f\x30\xf9\xc9\xeb\xaf\x70\x7d\x5e\x7d\x59\x9b\x62\xbd\x2c\xee\xb9\x4a\xbd\xbf\xbe\x66\x8b\x39\x8b\xe0\x58\x39\xfb\x4e\xbf\xfd\x76\x29\x91\x64\x7e\xc5\xca\x89\xab\xe5\x41\xe7\x22\x76\x53\xfd\xc4\xb8\x1c\xaa\x3b\x9e\x16\xd8\xdf\xe1\xb4\x17\x3f\xed\xe5\xed\x95\xb9\xb9\xb9\x8b\xdc\x8f\x88\x8c\x79\x8f\x90\x7b\x5e\x73\xe4\x92\x7c\xa6\x8f\xcf\xf7\x8c\xe4\xe8\x39\xc6\xdb\x36\xe9\xf3\x73\x4e\x1d\xdd\xe4\x8c\xf7\xad\xdf\xac\xce\xdb\x9c\xf5\x35\xcb\xf7\xab\x63\x1a\xaf\x73\xcd\xa9\xf3\x25\x53\x0e\xff\x2d\x23\x41\x37\xee\xd5\x75\x93\xbc\x76\xdd\xd8\x1a\xce\x30\xfa\xbd\x7d\x4e\x61\x8b\xfa\xbd\xd6\x7c\x79\x79\xfc\xdb\x9d\xb7\x5a\xf9\xf3\xbf\x7f\x3e\x6d\xd7\xfe\x7a\x16\x2f\xcf\xff\x6c\xce\x4a\x11\xbc\x29\xd6\x9b\x5a\xdc\xff\xf4\x55\xf3\x96\xce\x1d\x17\xdd\x75\x37\xcd\xf8\x54\x9f\xad\x37\x2c\x7a\x10\xfb\xbf\xf9\xd0\x19\x92\xce\x8f\xb4\xc6\x51\x3d\x50\xae\xd3\xbe\x4b\x7b\x33\xed\xcb\xb4\x77\xd3\xbe\xcd\x3a\x69\xe3\xa3\x4d\x8f\x36\x46\x1f\xb3\x8f\x29\x57\xa1\xc3\x07\x1d\x4c\x78\x1f\xed\x09\x19\x2a\x28\xe2\x27\x84\x36\x87\x69\x2e\x8f\x16\x52\x5a\x44\x69\xe1\xa7\x45\x9f\x0d\x14\x44\x5a\x7d\x99\x55\x3e\x69\x76\xd2\x27\x8d\xd2\x81\x4a\x81\xca\x85\x4a\x85\xe9\x27\x66\xa7\x23\x9d\xa0\x3c\x72\x58\x77\x8c\xef\x41\x32\x97\xc8\xcf\xc4\x8e\x5a\xa5\x72\xb6\x90\x8f\x6d\x88\xaf\x8d\x0f\xae\xd9\x18\xeb\xb7\x13\x33\xe5\x81\x89\xd1\x3d\xa3\x23\x93\xa3\xe6\x86\xb5\x83\x03\xe9\x6c\x3e\x91\x5b\x8d\x81\x72\x29\x39\x30\x5d\x4c\x0f\xd8\xc9\x22\x13\x58\x5a\x53\x2e\x24\x8f\xac\x99\x2e\xa6\xe3\x49\xb7\x17\xa3\x07\x47\xf7\x4d\x4d\x9a\x7b\x47\xc6\x41\x21\x40\x25\x5f\xce\xce\xe4\xad\x54\x2c\x9b\xb7\x71\xc4\x3a\x61\x92\x61\x38\x9a\xc8\x55\x2c\x07\x9c\x4d\x1c\x37\xad\xbc\x5d\xca\x5a\x65\xcc\x26\x8a\x66\x3a\x97\x98\x29\xa3\x98\xcd\xe7\xb3\xf9\x19\xe4\x13\xb3\x56\xb9\x98\x48\x5a\x48\x66\x12\x25\x98\xe6\xc8\xc4\xc4\xc8\x5d\xe6\xe4\xd8\xdd\xa3\xe6\xd4\x5d\xe3\xa3\xa6\x89\xe9\x62\xda\xa4\x81\x29\x2b\x0d\x33\x97\x4d\x5a\xf9\xb2\xc5\x88\xcc\x4a\xb3\x58\xca\xe6\xed\x23\x20\xf5\x44\x2c\x5a\xa5\xb4\x69\x1d\xb5\xf2\xb6\x59\xa8\xd8\xc5\x8a\x8d\x5c\x21\x3f\x13\x63\xff\xcd\xb3\x75\x6c\x7c\x7c\x62\xff\xd4\x7e\x73\x6c\xdc\x03\xb7\xed\xf5\x21\x3b\xfc\xc8\xb8\x8f\x6d\x6a\x9b\x07\x8f\xee\xf0\xe0\xf1\x03\x1e\x7c\x60\xbb\x6f\xb0\x0f\x9e\xf2\xc0\xed\xdb\xb6\xf9\x15\x1c\x1c\x76\x91\x89\xc9\x83\x5e\xcf\x8e\x89\x51\x4f\xdb\xa4\x47\x1f\xd9\xe9\x82\x7b\x7d\x52\xb7\x8e\x8e\x4e\x8d\x7b\x5d\xa3\xfb\xb6\x8d\xf8\x2c\x1c\xdb\xeb\xc2\xdb\xf6\xfb\xfc\x9b\xdc\x36\x35\xcf\xf6\x3d\x63\x53\x9e\xd6\xbd\xe3\x7b\x26\x3d\xdb\x46\xee\xf4\x3a\x46\x0e\x39\xd1\xa5\x78\xba\xc1\x2d\x67\x0a\x25\x1b\xa6\x59\x19\x1c\x86\x69\x4e\x5b\xac\xa9\x64\xf3\xf6\xf0\x90\x69\xc3\x05\x8a\x89\x52\xd9\x32\x2d\x3b\x93\x49\x95\x90\xcf\xa0\x58\x28\x23\x93\x2a\x99\xc9\x4a\xa9\x5c\x28\x21\x95\xb0\x13\xa6\x95\x4f\x81\x73\x64\xcc\x94\x55\xf6\x69\x61\xe9\x92\x31\xcb\x85\x4a\x29\x69\x21\x63\x16\x4b\x05\xbb\x40\xcc\x24\xc4\xc9\xc1\x5c\x06\x19\xf3\x68\x2e\x91\x37\xa7\xb6\x8d\x09\xd0\xca\x27\x13\xc5\x72\x25\x97\xb0\xad\x14\x1f\xc4\xe8\xa4\x23\xcb\x8d\xca\x16\x19\xe6\xfc\x9f\xc9\x91\xf9\x1b\xc1\x0b\x09\x76\xa1\x0c\xbb\x60\x53\x0d\x23\x9b\x42\xba\x94\x98\xa1\xd2\x85\x6d\xe7\xc0\xe4\x25\x0b\x39\x24\x33\x56\xf2\x08\x4c\xb3\x5c\x99\x1d\x1c\x46\x39\x91\x4a\x51\x6e\x57\xd6\xaf\x63\x11\x59\xbf\x0e\x29\x46\xca\x16\x33\x5c\x65\x25\xc5\xb4\xf1\x86\x7b\xc5\x3c\x26\x3d\x19\x94\xad\x7c\xca\x49\x78\x94\x8f\x4c\xa3\x78\xc4\x36\x59\xf9\xcd\x26\x4a\x47\x70\x6f\xc5\xaa\x58\x54\x23\x45\x2a\x29\xe6\x4e\xb1\x64\x95\xad\xbc\xed\x20\x76\x32\x2b\xa8\xe4\x6f\xb1\x94\x2d\x94\xb2\xf6\x09\x64\xf3\x33\x25\xab\x5c\x36\xb3\xe9\x6c\x3e\x65\x1d\x87\x68\xed\xa4\xe9\x00\xc9\x69\x64\x12\xe5\x0c\x11\x92\xb9\x44\xb9\x9c\x4d\xb1\x79\x41\x3e\x51\xcc\x9a\xe4\x7c\x62\x36\x9b\x3b\x81\x92\x35\x5b\xb0\x29\x6c\x43\xc8\x15\x92\x89\x1c\x83\x5c\xe2\xb0\x4b\x1c\x16\xc4\x22\x65\x88\x43\x65\x20\x9b\xec\x59\xcb\x4e\x50\xc8\x8e\x98\xd3\x95\x74\x1a\xce\x3a\xe7\xac\x21\xa6\x59\x19\x26\x89\x36\x39\xcf\x22\x4f\xcc\xcc\x14\x8a\x04\x11\x9c\xc5\xc0\x25\x9b\xa6\x69\xa6\x67\x45\x96\xd9\x49\x16\x56\xde\x94\xad\x7b\x91\x48\x1e\x31\xa9\x2d\x59\xe5\x41\xa4\x68\xfe\xd2\xd9\x3c\xca\x27\xf2\x28\x95\x6d\x14\xcb\x19\xe2\x40\xa5\x34\x03\x8b\xd6\xa6\x63\x25\x1c\xcb\xe6\x53\x85\x63\x44\x32\x8b\x76\x49\xc4\x0e\xd9\x22\x53\x3f\x7f\x65\xaf\x5a\xd3\x89\x29\x63\x25\x52\x56\x49\xf4\xdb\xc9\x6a\x4a\xb6\x48\xc9\xeb\x4c\xaa\x9d\x2c\x52\x26\xd0\x30\x86\x53\xe4\xd2\xb9\xc2\x31\x33\x37\x9d\xf3\x6b\x42\xde\x3a\x6e\xb3\xe2\x28\x14\xcd\x5c\x76\x36\x6b\x23\x9b\x1f\x36\x2b\xa8\x0c\x9b\x94\x5f\x1b\x05\x30\x38\x2c\xa0\xf5\xeb\x18\x0b\xcf\xbe\xa3\xc3\x34\x5a\x3c\x11\xde\x4a\xb8\x43\x80\xe3\x75\x82\x74\xb0\xd6\x86\x1a\xf4\x46\x09\xf0\x11\xfc\x96\x47\x65\x78\xdb\x2f\xbb\xa3\x9e\xe2\xe0\xd3\x1e\x69\x23\x3f\x02\x9d\x56\x5c\xd2\x65\x0e\xae\x55\x5d\xd2\x19\x0e\x3e\xe5\x91\x62\x01\xae\x26\xe0\x92\xce\x71\xf0\x82\x47\xaa\x65\x94\x67\xf7\xef\x88\xa5\xc8\xdb\x33\xae\xab\x53\xc2\xfb\x2d\xae\xab\x53\xb5\x46\x41\xbe\x26\xcb\x6b\xce\xab\x04\x14\x34\xe1\xac\x40\xa0\x8b\x71\x77\x3e\xcd\x83\xb0\x25\xe8\x92\x6a\x89\x12\x09\xc3\x44\xd5\x62\xda\xe9\xd7\x27\x90\x98\x1b\xe9\x43\xff\x23\xe1\xbf\x3d\x26\x2f\x72\x0b\xa6\xe4\x14\x6f\x9f\x83\x60\x99\x10\x60\x44\x12\xa4\x03\x02\x2c\xba\xa4\x71\x01\x3e\xe7\x71\x09\xd0\x53\x37\x5e\xad\x4e\x24\xc7\x6b\x10\x66\x37\xe3\xac\x40\x76\x4a\x2e\x51\x3a\x2b\x90\x73\x1e\x51\x3e\x2b\x90\x2b\x1e\x51\x39\x2b\x10\x2f\x14\xcd\xea\x82\x50\x08\xc5\x4f\xf1\x56\x85\x6d\xe8\x67\x05\x76\xc9\xf5\x7e\xf2\x52\x95\x85\x2a\xec\x26\xdd\x35\xb1\xdf\xf5\x76\x52\x80\xc2\x50\x15\x76\xab\xee\x9a\x7d\xca\x63\x3c\x55\xe5\x89\x0a\x3b\xa2\xbb\xae\x5c\xf6\x18\x2f\x57\xc5\x4f\x85\x1d\xd5\x17\x78\x22\x7a\x4f\xbb\x51\x9e\x10\xeb\xc5\x73\x6e\x11\x4d\x88\xf5\xe2\x8a\x47\x12\x79\xf9\x35\x37\x3f\x27\x6a\xc9\x6e\x5a\x28\xfb\xd4\x42\xd9\x2f\x2b\x35\x05\x05\xb9\x80\x73\xae\xa0\x49\x21\xe8\x92\xe2\x39\x2c\x64\xbb\x2b\xd8\xa4\x58\xd4\x2e\x79\x24\x61\xf7\x61\xb7\x68\x27\xab\xd5\x65\x16\x18\x79\xe7\x42\x23\x17\x94\xfa\xf1\x05\xa3\x16\x2c\x2c\x8b\xb0\x08\xc1\x70\x2d\x9c\x12\x16\x1e\x0a\xd4\x14\x24\xb8\x0f\xbb\xa3\x26\x84\xab\x2f\x7b\xa4\x5a\xa3\xfa\x6b\xb3\x88\x55\xee\x8a\x56\xd3\xcf\x2b\x7c\x01\x8c\xb8\xe6\x2d\xa8\x4b\xb1\x30\x6e\xac\xbd\x30\x3e\xc7\xbb\x5e\x0e\xd6\x94\x22\x35\x4b\xbd\x8d\x2d\x9a\xd2\xd8\xd4\x71\x5d\xe3\x97\xb6\x76\x36\x4b\x61\x1d\x90\x87\xa0\x34\x8e\xb5\xdc\xd6\xb9\x29\xb4\x39\x24\x47\x00\xa5\x45\x52\x1a\x43\x1a\xa1\x80\xda\xc0\x7a\x09\xd9\x18\x02\xb4\x95\x50\x1a\x6f\x0d\x85\x42\x80\x2e\x49\x63\x2d\x40\xa0\x1b\x63\x2d\x1b\x34\x20\x48\x5d\xa1\xd0\xad\x21\xa0\x8e\xd1\x42\x40\xfd\x90\x3b\x1a\x08\x2d\x01\x0d\x30\xda\xa4\xb1\x96\xbe\x4e\xa0\x41\x63\x78\x23\x9d\x01\x96\xac\x62\x70\xd3\x12\x00\xcd\xaa\x34\xd6\x12\x0a\x39\x83\xc2\xfd\x50\x1a\xa3\x4b\x80\x96\x76\x8c\xb5\x28\x8d\x0e\xb5\x35\x2e\x39\x60\x5f\xe7\x58\x4b\x2c\x04\xb4\x69\x70\x08\x24\xa5\x7d\xc8\x87\x74\x30\x6f\xb8\xb4\x88\xe7\x4d\x28\xd4\x10\x32\x98\x4f\x9d\x9e\x30\x12\xb5\x34\x24\x01\xd7\xb9\xa3\x34\x20\xea\x8e\xd2\x88\x7f\x59\x5c\xa2\xc8\x6d\x89\x78\x26\xdc\xd6\x09\x2c\xd7\x20\x77\x78\x7a\xbb\x86\xe6\xa1\xb1\x90\x74\xa0\x03\xe8\x1e\x82\x1c\xf1\xa8\x2b\x96\x49\x83\x2d\x24\xec\x50\xe8\xae\x10\xd0\xa3\x61\xb0\x05\x58\x49\x92\x08\xe8\x1d\xe2\xc0\xaa\x21\x44\x1b\x08\xe8\x0b\x49\xce\xac\xf5\xd3\xc8\x03\x1d\xce\xb8\xd5\x24\x95\xba\xaf\xef\x90\x84\xab\x48\x1a\x22\x3f\x83\x6c\xe1\x32\x30\xc0\xf3\x60\xaf\x2f\x27\x68\x55\x96\xe9\x1c\x74\x1b\x2d\x2f\xab\xea\x44\xf5\x40\x79\x8e\xed\x31\xd2\x4e\x35\x01\xe0\x49\x4a\x9f\x31\xa8\x65\x01\xef\x52\xd5\xfb\x05\xbc\x3b\xa8\xbe\x4f\xc0\x7b\x0c\xf5\x09\x01\xef\x6d\x52\x9f\x14\xf0\xbe\x56\xf5\x33\x00\x3e\x4d\xf0\xfe\x08\xb4\x34\xfb\xf4\xa5\x7f\x91\x9a\x0b\x4e\xbe\x6a\x5f\xa0\xc3\x80\x14\xfc\x12\x99\x1c\x90\xff\x16\x00\xfd\x93\x3f\xe2\x19\xc5\xf8\xeb\x2e\xb0\xca\xab\xff\x21\x80\x9f\xd3\xc8\xf6\x10\xad\xff\x86\x24\x01\x0d\xe4\x6b\x03\x41\x8d\xd0\x5e\x05\xa0\xa9\xa1\x06\x09\x58\x42\x03\xeb\xff\x15\xc0\x32\x09\x90\x56\x85\x56\x48\x62\xc4\x88\xfb\xdf\x56\x17\x62\xf7\x8b\x4d\x1a\x09\x0b\x04\x9b\xc9\x01\x55\x89\x84\xbb\x88\x1a\xbe\x9e\x04\x84\x37\x48\x80\x1c\xde\x2e\x01\x6a\xf8\x0e\x09\xd0\xc3\x6f\x97\x80\x60\x38\x4b\x72\xc3\x15\x09\x68\x0e\xbf\x5b\x02\xda\xc3\x8f\x4b\xc0\xb2\xf0\x19\x09\xe8\x0e\x7f\x46\x02\x56\x87\xff\x44\x02\xe2\xe1\x8b\x12\x30\x10\x7e\x5e\x02\xd6\x85\xbf\x2f\x01\xeb\xc3\x3f\x96\x80\xb7\x85\x5f\x95\x80\x77\x84\xdf\x94\x80\xe9\x70\x48\x06\x66\xc2\x1d\x32\x90\x0b\xaf\x94\x81\x87\xa4\xf0\xa0\x0c\x3c\x22\x85\x47\x64\xe0\x51\x29\xbc\x4f\x06\xe6\xa4\xf0\xdd\x32\x70\x4a\x86\x96\x96\x01\x2d\xd8\xa2\x11\xab\x0c\xa8\xad\x2d\xb4\x55\x3c\x40\x60\x48\x2b\xc8\x40\x40\x6e\x59\x21\x03\x1f\x95\x01\xfd\xba\x16\x72\xf8\xfd\x34\x64\xa0\xf5\x2c\x75\xee\x26\xbf\xa5\xb6\xcf\x31\xf8\xc3\x32\xd0\xf6\x55\x06\x52\x4c\xda\x9e\x25\x70\x0f\xf5\xb5\xbf\x4e\xe0\xbe\x2f\x12\x78\x85\xc0\xfd\x34\xae\x1d\x0a\x10\x18\xff\x77\xa2\xae\x23\x70\x82\x51\x5f\x21\x86\x3b\xc8\x22\x84\x7e\x4f\x06\x3a\xfe\x54\xa6\xe9\xed\x51\xbf\x20\x03\x24\x38\xb0\x12\x08\x91\xac\xd0\x9f\x51\x37\xa9\x69\x0c\x3e\xa6\x7e\x5b\x06\x5e\x24\xd6\xf7\x43\x7d\x51\xc0\x1f\xd0\x55\x92\xf7\x6b\x0a\xf5\xe3\x06\xf4\x5f\xc8\x3c\x1d\x74\x68\xdf\x25\x06\x29\xf4\x1b\x12\xb2\x5a\x01\xd4\xc0\x0d\xaa\xaa\x38\xcc\x81\x35\x50\x97\x08\x38\x2e\xa3\x75\x88\x0c\x7c\xd8\xe7\xee\xc3\x9e\xbb\xef\x65\xee\xd2\x89\x37\xf0\xbe\xc3\x0a\xd0\xfe\x07\x04\x3e\x6a\x29\xc2\xdd\xc7\x58\x7a\x84\x88\x10\xba\x47\x01\x3a\x88\xb7\xb5\xee\x60\xe4\x76\x05\x78\x53\x01\xea\x0e\x49\xaa\x8a\xc8\x7e\x81\xde\x25\xa9\x80\x7a\xa7\x40\x0f\x4b\xea\x5b\xb9\x31\x75\x09\x59\x4d\x0a\x78\x5a\x55\xd3\x02\x4e\xea\x6a\x41\xf0\xa7\x82\x6a\x59\xc0\x56\x9d\x7a\xbf\x02\x04\x55\xa0\x2e\x5d\xaf\x3e\xa6\x00\x2d\x04\xcf\x18\xea\x93\x02\xce\x34\xa1\x85\x22\xb3\x8b\xa2\xe0\x64\xc3\x7b\x08\x8c\xb6\x2c\x57\x81\x8f\x12\xd8\xd1\xf2\x24\x7f\x81\x51\x1b\x5b\x3f\x4b\xfe\x3d\xef\x0b\xc6\xf3\x5e\x30\xbe\xc9\x82\xf1\x25\xe2\xf8\xd6\xac\x0a\xb4\x3f\x4f\xe0\xa5\x12\x81\xcf\x12\xf8\xd7\x4e\x30\x88\x10\x3a\xa6\x02\x1d\xc4\x1b\xac\x6f\x57\x9f\xe1\xae\xd4\x77\x40\xfd\xba\x80\x23\xb2\xfa\xac\x80\x3b\x55\xd7\x95\xfa\xa5\x3a\x3a\xbf\xa5\x00\xf2\x1a\xa9\xed\x32\x6b\xeb\x35\xa0\xed\xa2\x4a\xe0\x83\x3a\xd0\xfe\x1d\x02\x37\xb0\x94\xfa\x2e\x81\x03\x54\x15\xed\x3f\x24\x70\xf0\x83\x3a\xb0\xb4\xfd\x4d\x82\x87\xbf\x40\xbb\x29\x47\x36\x3f\xc3\xb6\xd6\xd0\x12\x0d\xb8\xee\xcb\x2a\x70\x3a\xb4\xd6\x88\x92\x01\x14\x88\xd0\xa0\x81\xe8\x0f\x04\xb2\xce\x50\xa3\x3f\x11\xc8\x7a\x23\x18\xfd\x27\x81\x0c\x19\x46\xb4\x2c\x90\x1b\x8d\xa6\xe8\x15\x81\x0c\x1b\xad\x51\x4d\xe5\xc8\x06\x23\x12\x6d\x14\xc8\x46\x23\x1a\xed\x14\xc8\x4d\x46\x2c\xba\x42\x20\x9b\x8c\x95\xd1\x75\x02\xd9\x6c\xf4\x47\x37\x09\xe4\x66\xe3\x86\xe8\x36\x15\x38\xa2\x03\xa1\x5b\x8c\xb5\xd1\xdb\x45\xcf\xad\xc6\xf6\xe8\x6e\x81\xdc\x66\xec\x8c\x1e\x14\xc8\x16\x63\x4f\x94\xe6\x89\x21\x23\xc6\x78\xf4\xad\xa2\x67\xab\x31\x15\x4d\x0a\x64\xd4\x38\x14\xbd\x47\x20\xb7\x1b\x6f\x8b\x56\x04\xb2\xc3\x38\x1c\x3d\xa9\x02\xc7\x48\xe9\x4e\x23\x15\x7d\x4c\x20\x63\x86\x1d\xfd\x88\x60\xdb\x65\x3c\x14\x3d\x27\x90\xdd\xc6\x23\xd1\x3f\x16\xc8\x3e\xe3\x03\xd0\x09\x62\x75\xa8\xf9\x60\x15\x6c\x81\x79\x9e\x72\xbf\xb9\xe3\x55\x15\xd0\xe5\x6e\x55\xf8\x2e\xaf\x80\xfa\x53\x15\xa0\xec\x94\x7b\x54\xdf\xd2\xde\xe9\x83\xc3\x68\xd5\x34\x20\xf0\x63\x5f\x76\xfe\xd8\xcb\xce\x17\x59\x76\x86\x89\xe3\xa5\x50\x40\x64\xe7\xcf\x9a\x02\x22\x3b\x7f\xea\x64\x27\x11\x42\x6d\x01\xa0\x83\x78\x5b\x0d\x2f\x3b\x0d\x5f\x76\x1a\x11\x59\xed\xd4\x9c\x22\x32\x3a\x55\x75\x99\x80\x97\x06\x23\xbd\x9a\x63\xaa\x11\x95\x55\xc3\x88\x5c\x2f\xd0\x65\xb2\x1a\x34\x22\x03\x02\x5d\x2e\x4b\x01\x23\xb2\x5e\xa0\x5d\xb2\xa4\x1b\x91\x0d\x02\x8d\xc9\x92\x66\x44\x36\x0b\xb4\x5b\x96\x54\x23\x72\x9b\x40\x57\xc8\x92\x62\x44\xb6\x09\xb4\x47\x96\x64\x23\xb2\x43\xa0\x2b\x65\x49\x32\x22\xbb\x05\xda\x2b\x4b\x30\xd4\xfd\x1a\xb7\x7c\xa8\xd1\xad\x25\xe3\xc6\x26\xf5\xa0\xa0\x0f\x87\xb1\xcc\xbf\xd5\x4b\x77\xbf\x43\x03\xe4\x2d\x14\x96\xe5\x44\x64\xc5\xb6\x85\x8a\xad\xeb\x53\x00\x28\xaa\xf2\x36\x8a\x6a\x17\xbd\x01\x51\x9e\xc9\xdb\x09\x65\x6b\xbe\xbc\x93\xad\xf9\x34\x0b\xf2\xed\xb4\x98\x77\xfd\x4a\x02\x58\x91\x4e\x52\x91\x76\x6d\x97\x81\x34\x29\x98\x20\x23\xbb\xb2\x32\x30\x4b\xe8\x1d\x34\xe3\x5d\x9f\x94\x81\xfb\x09\x1d\x67\xe8\x5f\xc9\xc0\xfb\x09\xdd\xcf\xd0\x9f\xcb\xc0\x27\x08\xdd\xc7\xd0\x9f\xa8\xc0\xe7\x09\x1d\xa3\x75\xb6\xeb\x31\x0d\xf8\x22\xa1\x3b\x7e\x49\xc6\x4d\xe8\xc0\x57\x08\xdd\x43\x53\xdb\xf5\xfb\x3a\xf0\x55\x42\x77\xb3\x85\xe9\xeb\x2e\x63\xfb\x37\x08\xdc\xf5\x50\xc8\xb9\x1c\x43\xb7\xfc\x21\xb0\xa5\x61\xeb\x4e\x3a\x23\xad\x58\x23\x7b\xaf\xe1\xa7\x69\x4b\x3f\xd8\x73\x93\x0c\xac\x6c\x52\x00\x72\xa6\x67\xbf\x0c\xf4\x7e\x4f\x02\x68\x8b\x5d\xd5\x68\x11\xba\x5e\x01\x8a\x04\x7c\x4a\x01\xee\x23\xe0\x3a\x15\x78\x2f\x6d\x71\x7d\xe2\xcb\xd1\x29\xbf\xb2\xd9\xc3\xa4\xac\x3f\xaa\x00\x6b\xe9\x44\xf3\xf6\x9e\x7e\x05\x58\x49\x67\x08\x92\xd5\x3b\xa2\x01\x63\x04\xfc\x4a\x03\xa6\xe8\xb4\xd3\x27\xbe\x2d\xed\xf4\xcb\x31\x99\xd1\x7d\xe2\x53\xd4\x23\xfe\xbe\x43\x53\xcc\xa1\xd5\xaa\xef\xb5\x84\x74\x95\x7a\x6e\x54\x81\x95\x77\x4b\xc0\x16\x15\xe8\xfd\x8e\xee\x4c\x2b\xfa\xc4\xb7\xaa\xb5\x7e\x31\xe5\x1c\x89\x89\x1d\xf6\xd3\xee\x67\x6a\x63\xe7\xfc\xb4\x77\x1d\x67\xb4\x4b\x7e\xda\x03\x8c\xb6\xe2\x69\xd5\xfb\xa8\x13\xa1\x98\x9e\x5a\x99\x03\x70\x81\xcc\x78\x5d\x07\xfe\x82\xcc\xe8\x08\x00\xdf\x56\x69\x56\xe8\x48\x24\x7f\x08\xaf\x31\x6b\x56\x3c\xab\x7b\x5f\x68\xb6\x90\xfd\xef\xe9\xf9\x1b\x1d\x58\xf9\x2e\x09\xf8\x7b\x1d\xe8\x1d\xd0\x01\x92\x82\x3e\xf1\x15\x6d\xa3\xdf\x86\x07\x99\xfd\xfd\x64\xc3\x5a\xd2\xfd\xc1\x95\xc3\x42\xf7\xa6\x00\xd7\xfd\x76\xa1\xfb\xb0\x5f\x77\xdf\x6b\xfc\xcd\x89\x7c\x5f\xcd\xe5\xcd\x3b\x73\x36\xf9\xe0\x65\x3e\x38\xea\x83\x5b\x7d\x70\x1b\x42\x8f\x86\x80\x8e\x6e\x3a\xaf\x37\xbc\x2d\x42\x1b\x02\x6d\xe2\x0d\xef\x98\x77\x3c\x68\x30\xd9\xf1\xe0\xdb\x1a\x70\x25\x04\x34\xa4\x25\xf5\xfb\xbc\x7e\x1b\x32\xaa\xfa\xa2\xc6\xd9\xb2\xba\xfa\x73\x01\xdf\x13\x60\x5b\xbf\x6a\x00\x0d\xb9\x20\xdb\xfa\x19\x3c\x1b\x81\xce\xce\x09\x17\xd8\x97\x96\x8e\x88\x0e\x34\x35\x2e\x57\xff\x4d\x03\x22\x06\xd0\xd8\x83\xeb\x9b\x1a\xbb\xd4\xdf\x68\xc0\x56\xc2\x63\x50\x55\x1d\xd8\x47\x70\x37\xd4\x46\x1d\xb8\x9b\xe0\x15\xe4\xb9\x2b\xa9\x09\x3a\x3b\x95\x5c\x60\x5f\x54\xf5\x16\x6f\x89\x77\x3f\xe6\xee\x14\xab\x0c\xbc\x28\x2e\xf6\x54\x7f\xb0\x10\xef\xeb\x2f\x28\x8b\xf3\x8b\xcf\xa7\xe0\x2f\xd0\xe3\xbc\x3d\xc4\xdb\xaf\xf1\xf6\x39\xad\x86\x42\x4e\xdf\x52\x35\xae\x58\x83\xbf\x58\x43\xee\x0b\x35\xf8\x63\x3c\xd3\x8f\xf3\xf6\x14\x6f\xcf\xe8\x8b\xf3\x8b\x57\xf9\xb5\x3c\xdb\x76\xf2\x56\x7c\x1e\xab\x7e\x2e\xd7\xe0\xcf\xf0\xf6\x34\x6f\xcf\x04\x17\x1f\xcf\x7e\x68\xe4\x2c\x18\x33\x06\x40\x6b\x90\xff\xf2\xe9\x7a\xc0\x7f\xd5\x7a\x11\xf0\xee\x3e\x69\x2d\x99\x77\x6b\x14\xa6\x97\x92\x45\xef\x3e\xa9\xde\x7c\x57\x47\x6c\x59\xf5\xdf\xbb\x05\x83\x70\x6f\x32\x5e\x00\x16\x5e\xab\x52\xdd\xcf\xbb\x43\xa1\xa7\x2c\xf9\x4d\xa7\x5a\x12\x57\x09\xb4\x97\x73\x46\xca\x7b\xf7\xaa\x81\x4e\xb5\xf3\x6e\x5f\x69\xa3\xa3\x96\x5e\x0b\xdc\xfb\x37\x3a\x2f\x56\xdd\xe3\xd0\x39\xde\x89\x08\xed\x3e\xce\x15\x5a\x90\x41\xce\xd5\x1a\xed\x67\xce\x55\xe3\x0a\x07\x12\xd7\x8b\x74\xb6\xf4\x2e\x93\xe8\x15\x86\xbb\x4c\xdb\x8f\x7b\x69\x79\x1b\xf7\x59\xdc\x2f\xd3\x1a\xc1\x6e\x16\xe9\xc8\xcc\xa3\xbb\x55\x0c\x58\x78\x87\xdc\xc2\x2c\x61\x17\x7a\x54\x93\xec\x9e\x90\x0a\x92\x5f\x7b\xd2\x4b\x5e\xd5\xb5\xe8\x2f\xfc\x24\xa6\x89\xf6\x6b\xdf\xbd\x27\xed\xd7\xce\x75\x17\x1d\xa4\x5d\x77\xdc\xc7\x80\xf3\xbb\x15\x15\x41\x04\xef\x0f\xa1\xb5\x2a\xad\x44\xbe\xd2\x3b\x8f\x8a\x56\x7a\x23\x93\xa4\xdf\x34\x36\x40\x92\xd8\x99\x8b\xce\x6b\xee\x65\xbe\x7b\xe7\x9f\xcd\x27\x73\x95\x94\x35\x90\xcb\xe6\x2b\xc7\x6b\x90\x07\xe2\x71\x67\x50\xa5\x5c\x72\x7b\xa6\xb3\x76\xd9\x09\x61\xc6\xca\x15\xad\x52\x39\x9e\x21\x15\x8b\xfe\x86\x00\x34\xe5\xf1\x0c\xa5\x8e\x7d\xa2\x68\x95\xd9\x8d\xa5\x55\x8a\x67\xe8\x0d\x95\x51\xe2\x19\x5a\xba\xca\x76\x2a\x9b\xb7\xd7\x90\xef\x79\x87\x92\x2c\xcc\xce\x16\xf2\x8e\xe8\x6c\x9a\x92\x97\x0d\x93\x29\x37\x9c\xb6\x92\xe2\x00\xa9\x72\x54\x24\x8b\x82\xe5\xe8\x30\x87\xf2\x1c\x80\x2a\xa3\xce\xfd\x41\x8f\xf2\x35\x48\x9a\x56\x7f\x8b\xd6\x95\xd2\xb4\x15\xda\xea\x01\xad\xb7\x5b\x0d\x68\x8d\x4a\x4b\x4c\xd3\xf5\xb8\x06\xe5\x0f\x4f\xc6\xb4\x80\xae\xbc\x84\x5d\x5a\x68\x50\x0b\x30\xda\xc7\x3c\x1a\x74\xe5\x13\x84\x29\x2f\x21\xa6\x85\xf4\x1e\x22\x7c\xec\x64\x5c\x0b\x28\x3f\x43\xec\x77\xc9\xaa\xca\x5a\x93\xae\x7c\x0f\x87\xb4\xba\x55\xba\xf2\xe9\x93\x31\xe5\x07\x88\x51\xab\x2b\xff\x8c\x98\xae\x3c\x71\xf2\x25\x5d\xb9\x8c\xb8\x1a\xd0\xea\x95\xa1\x60\x98\xb9\xd3\xac\xf7\x68\x6d\x7a\x4c\x6b\xd4\x07\x09\x57\x5e\x7d\x80\xe4\x34\xe8\xca\x3f\x20\xae\x2b\x9f\x3c\xf9\x1e\xf2\x5d\x57\xce\x48\x44\xae\x53\xee\x8a\xe9\xca\xff\x3b\x19\x53\xfe\x85\x89\x09\xea\xca\x8f\xe0\x84\x25\xa8\xaf\xd2\xf4\x6e\x5d\xf9\xac\x18\xff\x4b\x52\xf9\xf8\xc9\xb4\x26\xe9\xca\x87\xa5\x43\xba\xf2\xea\x03\x71\xad\x4e\x57\xde\x20\xb9\x0f\x9d\x3c\xa4\xbc\x81\x98\x7e\x97\x16\x3f\xa5\x2b\x0f\x9f\x8c\x39\x3d\xb1\xee\x5b\x3e\xb1\x42\xeb\xb8\x45\xeb\x53\x9e\x3e\xb9\x4b\xeb\xec\xd1\x56\x75\x69\xda\x7a\xad\x4f\x39\xf1\x39\x4d\xeb\xd3\x96\xde\xaa\x75\xeb\x37\x6b\x7d\xba\x72\x2c\xae\x2d\xe9\xd7\xea\x9e\xd0\x95\xdb\x63\x8e\x21\xff\x28\x39\x86\x50\x6c\x9a\xf4\x98\xd6\xac\x0f\x68\xba\x1e\x53\x9e\x10\x06\x3d\x28\xc5\x74\xe5\xcd\x07\x6c\x1a\x3e\x10\x17\xc2\x47\xb4\x0e\xe5\xfb\xf8\x9c\xae\xfc\xf2\x81\xb8\xa6\xe9\xca\x70\x4c\x5b\xba\x87\x69\xd1\xf4\x1b\xb4\x25\xdd\x5c\x87\xae\x6c\x8e\xc9\x06\xa4\x6b\xfc\x76\x6a\x9a\xf5\x2b\xb8\x50\xc5\xb7\x8d\xd3\x51\xf5\xbb\xb1\xb7\x70\xfa\x53\x55\x74\x83\xd3\x37\x56\xed\xb7\xaf\x38\x29\xea\xee\xcb\xe2\x29\x71\xfe\xcb\x55\xf4\xbb\x85\x5e\x75\x3e\x7d\x3d\xa7\xf7\x57\xd1\x7b\x38\xfd\x70\x15\x5d\xe5\xf4\x33\x55\xf4\x97\xb8\x3d\xa8\xda\x47\x77\x8b\x38\xcc\x27\xe3\x1e\x4e\x17\x87\x47\xf1\x4c\x08\xbd\x55\xf4\xcd\x9c\x7e\xae\x8a\x7e\x1d\xa7\x6f\xa9\xda\x4f\xdf\xe0\xf6\xbc\x5c\x45\x7f\x9d\xd3\x4f\x55\xd9\xff\x03\x4e\x7f\xa4\x8a\xde\xca\xe5\x07\xab\xe6\xf1\xbb\x9c\xff\xf0\x22\x79\xa0\x60\xe1\xe1\xe8\x1b\xec\x58\xb6\xf0\x50\x72\x8a\xd1\x03\x0b\xe8\x31\x46\x5f\x28\xe7\x92\x7b\xfd\x0a\x74\x00\x38\x17\x9c\x8f\x2f\x87\x87\xb7\x03\xd8\x80\xf9\xfd\xdf\xf4\xe1\x74\x16\xac\x0b\xce\xe7\x5f\x5d\x37\x1f\x3f\x5a\x85\x7f\xb5\x0a\xff\xbb\x2a\xfc\xd7\x55\x78\x77\xfd\x7c\xfc\x5d\x55\xf8\x57\xaa\xf0\x1f\x55\xe1\x83\xa1\xf9\x78\xa4\xca\xbf\x1e\x69\x3e\x8e\x78\xca\x9a\xae\xcc\x98\x89\xe9\xe9\x92\x75\x14\x71\xdb\x3a\x6e\x23\x5e\xb2\x72\x89\xa4\x9d\x2d\xe4\x07\xc4\xa9\x86\xb3\x15\x2b\xd3\x6c\x87\xf1\xe1\xec\xa7\x67\x02\x2f\x25\xf2\x33\x1e\x56\xb6\x4b\x02\x9c\x4d\x24\xb3\xf9\x74\x81\x89\xe6\x24\x86\xbb\xa7\x31\x5f\x47\x2e\x9b\x9f\x87\xa7\x4b\x89\x59\x4b\x08\xca\x15\x92\x88\x97\xed\x92\x9d\x98\x46\xbc\x7c\x62\x96\xda\xd9\x44\xb1\x3c\xe0\x3b\xe6\xed\xd9\xba\x75\xad\x79\x93\xd3\xac\xe7\xed\x3a\xde\x0e\x0a\xfa\x46\xde\x6e\xe0\xfd\xbc\x1d\xe4\xed\x30\xef\xe6\xed\x3a\xde\x0e\x0a\xfc\x46\xde\x3f\xc4\xe9\xbc\x5d\xcf\x1b\xde\xae\xe3\xed\xe0\x3a\x4e\x1f\xe4\xf4\x41\xc4\x4b\x05\x3a\x9a\x91\x2f\x83\xf1\xc1\x05\x89\xfb\xdf\x7c\xbe\xec\xac\x2a\x0b\x9e\xe2\x3a\xa7\x7d\xb4\xaa\xfe\xaa\xcb\x71\x09\xa7\x55\x1f\xef\xb7\xd4\xd0\x57\x55\xfe\xec\xb7\xbb\x57\x1b\x5f\xfd\x1e\x50\x7d\xac\x6f\xa3\x1a\x5b\x44\xcf\x38\x8f\x50\xcc\xc7\xa7\xf8\xc6\x37\xf1\xf6\x2f\xb9\xfe\xea\x18\x9c\xf6\xfd\x3e\xff\x6a\xf6\xbf\x53\x72\xc6\xaf\xab\xa2\x3f\xc5\xeb\xec\xa7\x55\x74\xa9\xaa\x7d\x77\x0d\xfd\x1f\x0c\x2d\xae\xaf\x3a\xfe\x07\x39\x6d\x6d\x15\xfd\xc3\x7c\xfc\x6a\xfd\xea\xfa\xff\x68\x11\x99\xf4\x3c\xe3\xfc\xf9\x14\x4e\x05\xae\xae\x5f\xaa\x31\x7e\xb3\xf3\xe7\x4c\x78\xf5\x1a\xf9\x63\xd7\x18\xbf\xda\xf9\xb3\x2b\xf6\xa6\x73\xb5\xf1\xc5\x1a\xf3\x5f\xe4\xf3\xff\x02\xc7\x69\xfe\x43\x8b\xcc\xff\xce\x1a\xfa\x4f\xf3\xbf\xe3\x39\x77\x0d\xfb\x13\x35\xc6\xaf\x5d\xbd\x38\x7f\x35\xbe\xb1\xc6\xf8\x41\x3e\xfe\x99\x6b\x8c\xef\xaf\x31\xfe\x15\x3e\xfe\xd8\x35\xec\x7f\xb2\xc6\xf8\xcc\x5b\x3c\xf9\xfe\xa7\xba\xfe\x3e\x56\xab\xfe\x78\x41\x88\x38\x53\xfc\x9b\x17\x89\xff\xe3\xb5\xe2\xcf\xf5\xef\x52\xae\x6e\xff\xa3\x35\xf4\x1f\x5e\x44\x7f\xcb\x22\xfa\xff\x1c\x0b\x8e\x8a\xec\x79\x21\xee\xb4\x45\xd9\xd3\xdb\xe1\x1b\x2f\xf6\xc9\xff\x0c\x00\x00\xff\xff\x37\xfe\xb2\x3c\x80\x39\x00\x00")
+var _tcptracerSockEbpfO = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x0b\x7c\x9c\x45\xb9\xf7\x7f\x66\xdf\xcd\x5e\xb2\xdb\xe6\x7e\x6b\x93\x6c\x2f\x49\x73\x6b\xda\xa4\x4d\x49\x0b\x4d\x13\xda\xd2\x16\x0a\xbd\xa5\x37\xe5\x24\x4d\x93\x4d\xb3\x36\x4d\xd2\xec\x96\xd2\x56\x8f\xcb\xd5\x15\x51\x17\x51\x4f\x44\xb1\x5b\x44\x5d\x01\x61\x3d\x22\x06\xa8\x76\x55\xd4\x28\xa8\x91\xe3\x25\x08\x1e\x56\x45\x5d\x11\x3d\xf1\x1e\x15\xc8\xf7\x7b\x66\xe6\xdd\x5b\x93\x5e\x00\x3d\xfe\xce\x97\x06\xde\x79\xe6\x99\x79\xe6\x3e\xff\x67\x66\x9e\xd9\xf7\x7d\xfb\xfa\xcd\x57\x70\xc6\xa0\xff\x63\xf8\x0b\xe2\xbe\xf8\x3f\x87\x85\xeb\x24\x9a\xd5\xb3\x02\x0c\x67\xf2\x88\x06\x0a\x18\xe0\xf4\xac\x72\x10\x5d\xd6\x65\xc5\x89\xa2\xa1\x49\x9d\x7f\xa0\xdf\x23\xf8\x03\x1d\x9d\x07\x4f\x14\xf9\x05\xbf\xa3\x73\x2b\x39\x38\x73\x8a\x9e\x80\x89\x01\xfe\xc9\xc9\xc9\x87\x39\x90\x01\xe0\x16\x00\x69\x14\xaf\x47\xc6\xeb\x38\xb0\x99\x1c\x3c\xac\x0a\xd7\xa9\xd2\x39\x73\x8f\x92\xe7\x52\xbe\x20\xa5\xf0\x94\x0e\xb1\xce\x18\x25\xeb\xf3\x31\xf9\x61\x21\xdf\x59\xf4\xb0\x72\x43\xca\x7d\x40\xb9\x41\xe5\xde\xab\xdc\x80\x72\x3f\xa2\xdc\x21\xe5\xbe\x5f\xb8\x1d\x1d\x15\xc9\xe5\x1a\x8c\x97\x6b\x16\x80\xc5\x37\xbe\x85\xbc\x38\x7c\xd8\x4e\x0e\xba\x18\x60\x26\xbf\xdb\x46\x5e\x6c\xae\xa3\x27\xf0\xb0\x41\x96\xf7\x61\x4d\xba\x79\x8c\xe1\x06\xe5\x87\xf2\xfb\x82\x32\x1e\xfd\x6d\x5e\x4b\x4f\x60\x97\xe1\x52\x11\xff\x8c\x5b\xe5\x6b\x00\xb2\x28\xdf\x9b\x1a\x55\xbe\x99\xc9\xf9\x0e\x66\xc8\x7c\xe7\xa9\x7c\x55\x7e\x0f\xf3\x94\x7c\x79\x4a\xbe\xaa\x1c\x9b\x17\x0a\x07\x67\xea\x55\xfe\x5a\xb5\x90\x3f\xe3\x51\xf9\x6b\x40\x5e\x42\xf8\xe2\x9b\x17\xa9\x72\xe4\xa6\x94\x23\x27\xb9\x1c\x86\x0b\x2c\x87\x5e\xff\x05\xaa\x1c\x97\xeb\xed\x50\x74\x56\x3b\x14\x25\x84\x2f\xbe\x29\x4f\x95\xa3\x30\xa5\x1c\x05\x6f\x70\x7b\x58\xce\x6a\x8f\x92\xa4\xf6\x90\x03\xf2\xf0\xe0\x5c\x55\x9e\xe2\xa4\xf2\xe8\xe5\xd0\xcb\x7d\xe6\xde\x78\x3a\xef\x9f\x9c\x9c\x3c\x93\x2d\xd3\xd9\x6d\x00\x26\x27\x81\x56\x26\xdd\x3c\x56\x27\xe4\xa9\xff\x6e\xfd\x31\x60\x84\x1c\x20\x67\x16\x29\x79\x13\x40\x23\x75\xf1\x3b\xb6\x09\xbf\xab\x52\x56\xa0\x63\x9e\xec\x87\xc3\x8e\x34\xd1\x0f\x9d\x39\x36\xc5\xcf\x50\x7e\xb3\xf2\xdb\x94\x5f\x97\x93\xfc\x13\x57\xfa\x26\x25\x1f\x8a\xef\x50\xf3\x41\xce\xa7\x8e\x79\x0b\x95\x3f\xa4\xfc\x05\xca\x7f\xaf\xf2\xcf\x55\x7e\x39\xef\x68\xfc\x57\xa8\xf9\x4e\x25\x7a\xce\x22\x71\x41\xb3\x48\xbe\x11\x57\x27\xe1\x81\xbb\xe8\xb9\x49\xdd\xef\x28\xeb\xb2\x76\x16\x8d\xc5\xf0\xc7\xd3\xe3\x10\xbd\x70\x6c\xc0\xb9\xea\x44\xd1\x68\x8c\xdf\x77\x5d\x47\x2f\xd1\xae\x2e\x87\xf3\x44\xd1\x48\x8c\x3f\xe8\xf4\x1c\x21\x7a\xb0\xcf\xe1\x3a\x51\x14\x9e\x4c\xc5\xa9\xb0\xc2\x29\xea\xb1\xcf\x8b\xf6\x9f\x9c\xd4\xf1\xea\x61\x48\xff\x07\x80\xa4\x76\x39\xa3\xfa\x93\x70\x8e\x5a\x7a\xf1\x0d\x14\x1b\x38\xac\xc6\x4d\x97\x06\xd0\xd0\x6a\xd5\x80\xcb\x12\xfa\x6b\xf6\x06\xe1\xe0\x0b\xef\x58\x14\xc3\xd9\xde\xde\x44\x9c\x8d\x97\xdb\x35\xe0\x70\x11\xed\x76\xf4\x1d\x39\x57\xb9\xa9\x1d\x8d\xf8\x55\xac\xbd\x7a\x8f\x58\x91\xd8\x5e\x8e\xb2\x5e\x51\x7f\xc7\x12\x47\x59\x62\x7b\x25\xb7\xe3\x94\xed\xe5\x3c\x57\xbe\x73\x55\xbf\xc2\x1c\xc7\x77\x23\x9e\x89\x95\x83\xea\xd3\x99\x90\xdf\xc0\x0a\x47\x0f\x85\x75\x0d\xae\x72\xbc\x96\xfe\xa1\x76\x36\xe2\x49\x91\x9e\xab\x92\xab\xf1\x6d\x49\x1e\xc7\x3a\x7e\xcf\xcb\x48\x1a\x8f\x34\xee\xb4\x84\x71\xe7\x2e\x7a\x54\xf0\xdd\x56\xa9\x37\xce\xa8\x71\x97\xc7\xae\x12\x7a\x6a\x17\x7b\x33\x08\x61\xcf\x1c\x8e\x97\x83\x66\xc6\xe2\x1b\x0e\x92\x17\xae\x63\x94\x5a\x3c\xbd\x4f\x58\x7a\xa8\x19\xd0\x69\xf5\x8a\xf4\x4e\xbc\xa9\x27\x56\xbf\xfd\xce\xa9\xfb\xf7\x48\xd7\x80\xe0\xf7\x3a\xfb\x1c\x7a\xbd\x5d\x6e\x0d\xd3\xd5\x9f\xf2\x39\xb3\x31\xae\x07\xd3\x54\x3a\xbd\xce\xbe\x03\xc4\xf3\xf4\xc4\xdb\x35\x3e\x8f\xbe\x17\xf3\x97\xf5\x1e\xb1\x26\xf5\x47\xc7\x31\x31\x5f\xfa\x3b\xba\xda\xcf\xd5\xee\x84\xf0\xa7\xef\x4c\xce\xf7\x42\xd2\xef\xef\xee\x16\xa8\xed\xf4\xc4\xcb\x35\x5d\xbe\x9a\x59\xea\xd5\x69\xf3\xbf\x29\x39\x7f\xdb\x90\xcc\xff\x13\x66\x06\x2f\xd5\xcf\x0c\xe1\xba\x06\xe5\xb8\x70\xa9\x7e\x3b\xf6\x71\xd9\x0f\x79\x8c\x03\x0d\xc0\x19\xa5\x5f\x76\x19\x0c\xc2\xdf\xd1\x23\xfd\x94\x0e\x4d\xd8\x87\xa9\x13\x39\x70\xb0\xea\x11\x21\x77\x50\xad\x27\x74\xbd\x51\x6e\x61\x30\x27\xe8\x0d\xbd\x1d\x3a\x8b\xb6\x8a\x78\x27\x6e\xbb\x5e\xb8\x9d\x55\xfb\x94\x3b\x20\x5c\x23\x24\x60\x9c\x39\x3c\x35\x6e\xb8\x0e\x4b\x1c\xf6\x30\x85\x1b\x4c\xe1\x86\x5a\x77\xcc\xce\x52\xb8\x71\xe3\x22\x4c\x37\xbf\x3a\x07\xce\x3b\xbf\x3c\x46\xa1\x3d\xe3\xe3\x34\x3e\xee\x99\xd0\x6f\xbb\x58\xc7\xe4\x2a\xa5\x57\x34\xa1\x57\xe4\x42\xa6\xa3\x43\xb5\x13\x53\xed\xcd\x64\x7b\x9f\x28\xba\x7e\x72\xaa\x76\x20\x3f\x13\xfe\x7d\x31\x3f\x84\x7f\x20\xc5\xbf\x47\xb5\xcf\xa3\xd3\xd6\xab\xeb\xfc\xf5\x3a\x27\x3e\xe6\x08\x7c\x5c\x2f\xc2\x6d\x39\x32\xbc\xd5\x42\xb8\x0e\x9c\xbe\x43\x78\x31\xb7\x45\xf5\xa7\x19\x48\xec\xef\xda\x3b\x58\xcc\x4f\x7f\xbb\xb8\xf4\x9f\x96\xea\x0e\xa7\x95\x3e\xd7\xd4\xba\xd0\x56\x31\xcd\xb8\x3c\x8c\xa4\x76\xd7\xc7\xa3\xeb\xb0\x1c\x48\xbb\x58\x56\xca\x78\xbc\x31\x69\x3c\x1a\x45\x2a\x53\xcf\x3b\xa9\x1f\x13\xc6\xc1\x21\x89\x2b\x3d\x5d\x83\xab\x5e\x0b\xce\xe6\x0a\x9c\x2d\x15\xe1\x4d\xef\x3f\xac\xf4\xde\xf5\x49\xb8\x76\xe6\x63\x4a\xce\x20\xe7\xe9\x19\x35\x3e\xf4\xf1\xfe\xb0\xd2\x7f\x34\x5f\x69\xc4\x75\x63\x97\xd0\xa3\xd3\xc9\x75\xdc\x13\x97\xd3\x12\xe4\xbe\x86\x36\x5a\xde\xe1\xf0\xa9\xaf\x88\xf0\xc3\xf7\x7c\x59\xba\x1f\xfb\x92\x74\xef\x95\xf8\xb1\x4b\x4b\x03\xa9\xd6\x33\x0d\xaa\x9f\x8c\x1a\xd6\x93\xbf\x4c\xf7\x73\xb4\x92\x3f\x57\xfa\xf3\x8c\x5b\xe0\x50\x72\x5b\x53\xe4\xb6\xa4\xc8\xed\x48\x92\xdb\x88\xd6\x69\xe4\x76\x9e\x33\xbf\x96\x58\x7e\x1b\xcf\x53\xce\x96\xa4\x72\xae\xc2\x3a\x25\xb7\xee\x3c\x72\x9b\x93\xe4\x96\x61\xfd\x34\xe5\x6c\x39\x67\x39\x6b\xc4\x0a\x93\xda\x65\x4b\x4a\xbb\x6c\x3d\xa7\x5c\x19\x36\x29\xb9\xb5\xe7\x69\xcf\x6b\x92\xe4\x4a\x70\x8d\x92\x6b\x4d\x91\xdb\x7e\xce\x76\xc9\x57\xe5\x2c\x12\xed\xb9\xcb\x50\x28\xda\x3b\x8f\x33\xe1\x1a\x91\x9f\x84\xff\xc7\xee\x95\xe3\xb7\x23\x20\x71\x6f\x17\xcb\x13\xed\x12\x9f\x6f\x39\x62\xe0\x09\xfc\xd7\x68\xfc\x67\xa9\x71\xa7\xc6\x17\x0b\x4e\xd2\x4e\xe3\xf0\xa9\x2f\x29\xff\x27\x26\x0d\x09\xf2\xd3\xe5\xf3\x09\xb3\x05\xcf\x19\x25\xae\x90\x6b\x84\xe9\x9c\xf1\x8d\xf8\xcd\xe4\xb9\xc2\x75\x5c\x8a\xe3\xab\x9c\x37\x0f\xbe\x9f\x63\x2a\x9c\xd5\xf7\xa7\x3a\xce\xd2\xbe\x82\x4a\xb0\x8b\x25\xe3\x9a\x8e\x4f\xba\xbe\xd3\x71\xe9\x60\x91\xd4\x83\x1d\xa7\x06\x94\x3e\xdb\x3a\x99\xb8\xaf\xd2\xf1\xfd\xf4\x0d\x12\xdf\x63\xfa\xc0\x22\xcb\x97\x6b\xcc\x27\x07\x1d\xdb\x6c\x4a\xcf\xcc\x46\xea\x3e\x7f\x78\x8a\x7d\xfe\x99\xdd\x71\x7d\x4f\x41\x67\x8e\x20\xa9\x7e\xb9\x50\xeb\x3f\xe5\xcf\x63\xb7\xbd\x4a\x9c\x3c\x66\x00\x4b\xc8\xaf\x55\xb5\xd3\x2e\x76\xeb\xab\xc4\xe8\xd8\xa6\x21\x11\xc7\xf2\x98\x3b\x96\x2e\x41\xf7\xe1\x7b\xe4\xba\x90\xc6\x91\xa6\xf8\x3e\x5a\x27\x56\x3d\xa1\xce\x07\xe4\x78\xd0\xf5\x44\xe7\x6d\x12\x87\x8f\xdd\x23\xf7\x4b\x87\xe7\x99\xc4\x7e\xc9\x5d\xf4\x94\xf2\xa7\x29\xff\x93\xca\x6f\x54\xfe\x6f\x2a\xbf\xa6\xfc\xdf\x50\x7e\x83\xf2\x8f\x28\x3f\x57\xfe\xaf\x2b\x3f\x53\xfe\xaf\x29\xbf\xae\x0f\xbe\xaa\xfc\xb3\x94\xff\xbf\x94\x7f\xb6\xf2\x7f\x4f\xf9\x6d\xca\xff\x5d\xe5\xb7\x2b\xff\xd3\xca\x6f\x55\xfe\xef\x28\x7f\xba\xf2\x8f\x2a\xbf\x59\xf9\xbf\xa5\xfc\x16\xe5\xff\xf6\x59\xe7\x37\xe1\x73\x9c\xdf\xe4\xaa\x53\xa9\xc3\x66\xbc\xa6\xf6\xef\xb8\x57\xee\x2b\xdd\x57\xca\x76\xeb\xf8\x98\xdc\x77\xba\x57\xc9\x76\xe8\xb8\x47\xee\x4b\xdd\x55\xdf\x55\xe3\xf7\x81\xc9\xc4\x72\x9f\x21\x70\x04\x70\xd4\x08\x90\x2a\x77\xbf\xe9\xa9\xb3\xf8\x19\x82\x2f\xfb\xed\xa8\x26\xd7\xff\xee\x2b\x65\xbf\x9d\x59\xae\xe2\x69\x4a\xfe\xca\x91\xb3\xf8\x42\xfe\x4a\xd9\x6f\x47\x0d\x4a\x7e\x95\xec\x37\x7d\x1d\x4a\x7c\x11\x6f\x95\xec\xaf\x87\x4d\x6a\x5c\x1d\xff\xde\x59\xf1\x44\x3e\xab\x24\xff\x28\x57\xe9\x55\xc9\x7e\x3b\xa3\xd6\x87\x47\xd5\x3e\xc1\x5d\xf5\x9d\xb3\xf8\x42\xbe\x4a\xf6\xe3\x51\xb5\x9f\x79\xcd\xfd\xa6\xca\xa9\xcf\x7b\x9a\x67\x93\x09\xb8\x95\xc7\x0a\xc4\x7c\x6c\x35\x49\x7e\x9e\x29\x4f\xf8\xf3\x4c\x46\xd1\xf3\xad\x66\xc5\x37\xeb\xf3\x55\x4b\x99\xaf\x19\x6a\xbe\x9a\xc5\x7c\xcd\x63\x15\x49\xeb\xb3\x8e\x53\xde\xa4\xf6\xca\x63\x85\x60\x49\xfe\x7c\xd0\x3a\xd6\x75\x4a\xe2\xd9\x73\x4c\xb6\xcb\xc1\xa2\xf0\x45\xd5\x57\x4f\x4f\xcf\x37\x75\xdc\xba\x4e\x3d\xf2\xba\xd2\x4f\x6d\x4f\xbd\xfd\x52\xdb\x35\x8f\xfd\xf6\x95\xe4\xf6\x7c\xe9\x95\x73\xb7\xa7\x39\xa5\x3d\x7f\xf1\x8a\x6c\x4f\xbd\x7d\x96\xa8\x73\xd8\x78\x79\x08\x99\xce\xa8\x7a\x5d\xe8\x39\xec\x4e\xcc\x51\xfd\xb1\x47\xcd\xaf\x88\xda\x07\x8d\x29\x57\x8e\xb7\x13\xc7\x53\xf7\xa5\x2f\xa8\xf8\x3f\x53\xee\x4f\x95\xfb\x13\xe5\xfe\x42\xb9\x3f\x57\xee\x2f\x27\xf5\xf3\x5e\xca\xf7\xc4\x14\xed\xec\x9f\x62\x7d\x99\x5a\xee\xcf\xab\x73\x40\x2a\x3f\x4f\x68\xef\x8e\x53\xfb\xd4\xb8\xfd\x92\x68\x27\x23\xd2\x63\xe7\xcb\x94\xc4\xb3\x19\x32\x89\x67\x07\xd4\xf9\x98\xb7\x40\xe9\x1f\x87\xd0\x3f\x1d\xf7\xec\x49\xd2\x8b\xee\x0c\x07\x30\x65\xfa\x9f\x53\xfd\x20\x13\x2c\x67\x5c\x94\xe7\xd8\x36\x99\x5e\x2e\xab\x3b\x4b\xff\x51\xbf\x9c\xb0\xfa\xa6\xac\xef\x74\xfd\xa2\x9f\x53\xea\xfd\xb9\xd3\x9c\x27\xfa\xf3\x58\x8b\x2c\xd7\xe7\x95\xde\x3f\x51\x35\x9a\xa4\xbf\x4e\x54\xc9\x7e\x8a\xb5\x6f\x50\xe5\x67\xbe\xb0\xf6\x3d\x73\xe3\xd4\xed\x3c\x5d\xbc\xe9\xca\x9b\x6b\xce\x50\xe3\x6a\x40\xb5\xdb\x1e\x72\x70\xf8\x86\x1c\xb5\x5f\xda\x15\xd3\x1b\x24\xef\x2e\x90\xfc\x8e\x1b\x33\x62\x7c\xfa\xeb\x2c\x90\xfe\xd3\x95\x6a\x9f\x66\x49\x5e\x3f\x3d\x58\xc9\x92\xe2\xc7\xe7\x8b\x57\xf4\xd3\xb1\x53\x72\x3d\x73\x3a\x5f\xe1\x8e\xbe\x5f\xd9\x5f\xa1\xd6\x47\x2f\xa9\x75\x51\x54\xb8\xa7\xd5\xb9\x84\xc7\x2c\xf1\x56\xef\x3f\xf7\x6d\x2f\xcb\x70\xb5\xdf\xf4\x28\xbc\x70\x17\xfd\xfd\x2c\xbe\x59\xf0\xff\x26\xf8\xee\xbb\xfe\x3a\x99\x78\x6e\x10\xc7\xed\x09\xc1\x77\x1f\xff\x8b\x70\x0f\x5a\x7f\x9b\xb4\x9f\xa7\x78\x4b\x89\x5f\xf4\xc7\xb3\xf8\x0e\xc1\xff\xbd\xe0\x1f\x85\xc2\x2d\xeb\x78\xca\xfc\xfc\xb3\x9a\x77\xf1\x73\x44\xe7\x2a\x87\x58\x8d\xf7\xf6\x1e\xb1\x26\x9e\x23\x1e\x1a\xe8\x75\x12\xed\x70\xbb\x8e\x27\xee\x37\x3d\x83\x1d\x9d\x44\x3b\x1d\xee\x8e\x73\xed\x37\x0b\x13\xce\xf7\xf5\xf3\x9d\x03\x26\x88\xfd\x12\xa5\x43\xfb\x46\x7d\xdc\x6c\xc9\x3f\xfb\xfc\x3c\x9a\xb0\x8f\x2c\x50\xe3\x5a\xff\xd3\xd3\x7d\xd8\x48\x58\x2b\xd3\xa7\xfc\xf2\xb0\xee\x15\xbd\xbe\xb4\xe2\x39\x98\x50\x1f\x57\xaf\xb3\x8b\xc2\x56\x39\xca\xba\xa6\xae\x4f\xf7\x39\xeb\x43\xaa\xf8\xb4\x21\xb9\x3e\x46\xac\x10\xf9\x75\x9c\xda\xaa\xf6\x0f\xf7\x09\xb7\xa3\x25\x43\x9d\xab\x98\x63\xfb\x65\x53\xc2\x7c\xd0\xc3\xcf\xa8\x7a\xad\x51\xfa\x7c\x89\x72\x4b\x1d\xd2\xdd\x89\x6c\x35\xbf\x75\x1c\xf9\xa8\x48\xff\xd8\x8d\x66\x91\xfe\xb1\x8f\x2b\xfc\xd8\x21\xf1\xa3\x54\xad\x2b\x8e\xa9\x79\x38\x5b\xf7\x5f\x2e\x13\x3c\xb1\x47\xca\x2d\x5e\xc0\x92\xec\x20\x9f\x57\xf5\xfa\x74\x96\xe2\x2b\xbb\xc5\x19\x75\xee\x5d\xaa\xd6\x3f\x27\xd4\x39\xe5\xea\xac\x77\xc7\xc6\x15\x49\x7c\x45\xcd\x47\x23\x72\x44\x7b\xcc\xd8\x1b\x93\xed\x8d\xba\xbd\xcc\xbd\xea\xb1\xc9\xa4\x72\x9e\x65\x7f\x74\x4d\x6d\x7f\xf4\x28\xfb\xa3\x7e\x9e\xa5\x70\x78\xc6\xee\x38\x63\x77\x9c\xb1\x3b\xce\xd8\x1d\x67\xec\x8e\xff\xaa\x76\xc7\x2b\x95\xdd\xf1\x4d\xd3\xd8\x1d\x5d\xd3\xd8\x1d\xbb\x67\xec\x8e\xff\x97\xed\x8e\xa6\x14\xbb\xa3\x29\xd5\xee\xb8\x27\xd9\xee\x78\x5c\xb7\x37\x3a\xde\x20\x7b\x63\x39\xa6\x9b\x57\x17\x66\x6f\x2c\x3c\x8f\xbd\x71\x7f\x8a\xbd\x71\xe9\x45\xda\x1b\xf7\x24\xb5\x53\x9c\x3f\x90\x12\x6f\x9f\x6a\x97\xe1\x7f\xb0\x9d\x71\xc3\x8c\x9d\xf1\xa2\xec\x8c\xf3\x66\xec\x8c\x33\x76\xc6\x19\x3b\xe3\xff\x19\x3b\xe3\xbe\xd7\x67\x67\xfc\xa4\x6e\x67\x54\xe7\xa9\x8b\x92\xed\x0c\x31\xfc\x37\xbf\x4e\xfb\xe2\x9e\x14\xfb\xe2\x2d\xe7\xb3\x2f\xbe\xfb\x3c\xf6\xc5\x77\xce\xd8\x17\x67\xec\x8b\xff\xfa\xf6\x45\x8b\xb2\x2f\xde\xf5\x2f\x6e\x5f\xb4\x24\xcf\xfb\xb3\xed\x8b\x73\xc4\x7c\x14\xf7\xb8\xa8\x3f\x2d\x85\xc2\x9f\x67\xb9\x50\xfb\x62\xf6\x85\xd9\x17\x2d\x29\xf6\x45\xcb\x1b\x6c\x5f\xb4\x20\x29\xdf\xd4\x71\xfb\x86\xd9\x17\x55\x3e\x7a\xfb\xa5\xb6\x6b\x1e\xfb\xdd\x2b\xc9\xed\xf9\x3f\xaf\x9c\xbb\x3d\x53\xed\x8b\xbf\x4a\xb1\x2f\x2e\x06\x12\xf4\x96\x6e\x07\x39\xa3\xca\x71\xa1\xe7\xae\x3b\xd5\x7d\x45\xdd\x7e\x16\xb3\x2f\xde\xa5\xec\x8b\x77\x29\xfb\xe2\x6d\xff\xda\xf6\xc5\x3c\xd3\x57\x95\x5d\xd1\x3a\xb5\x5d\xd1\xab\xce\xbf\xfc\xa9\x76\xc5\x7d\xe7\xb4\x2b\xe6\x99\x1e\x4b\xb1\x27\x1a\x92\xec\x89\x31\x3d\xc9\xea\xdf\x58\xbb\xa2\xea\xc7\x9d\x96\xfc\xd7\x66\x57\xfc\x94\xca\xcf\x72\x61\xed\x7a\xe6\x7d\x53\xb7\xef\x74\xf1\xa6\x2b\x6f\xac\x3d\x2c\xa9\xf6\xc5\xbd\xd2\xbe\x78\x87\x6e\x5f\xdc\x9d\x6c\x5f\x2c\x54\xf6\xc5\xf7\xa5\xd8\x17\x0b\x95\x7d\x51\xed\xc7\x34\x73\xf2\xba\xe9\xc1\x0a\x96\x14\x3f\x3e\x5f\x6e\x7a\x6d\xf6\xc5\x77\x2a\x3b\xa1\x25\xc5\xbe\x78\xd7\xcb\x49\xf3\xfa\x2c\xfb\xe2\x0d\xd3\xd8\x17\x6f\xbb\x30\xfb\x62\xac\x1d\x8a\xfe\xfc\x0f\xb2\x37\xfe\x5f\xb5\x33\x5e\xf1\x4f\xb6\x33\x36\x2a\x3b\xe3\x1e\xb5\x7f\xf8\xd4\x3f\xd8\xce\x78\xb7\x48\xff\xd8\xfb\xce\x63\x67\x7c\xdf\x34\x76\xc6\xbd\xaf\xd3\xce\xb8\x49\xb7\x33\xde\x9e\x34\x4e\xbf\xa2\xe6\xa5\x11\x79\xe7\xb1\x33\x86\xa6\xb1\x33\x06\xa7\xb5\x33\x06\x2f\xca\xce\x18\x3c\xcb\xce\x18\xbc\x28\x3b\xe3\xa8\xb2\x33\x3e\xa5\xec\x8c\x23\xca\xce\x98\xbc\x5e\xed\x2c\x3a\x3d\x79\x21\x76\x49\xdd\xce\x18\x2b\xd7\x59\x76\xc5\x99\xdf\x35\xce\xfc\xae\xf1\x8d\xf9\x5d\xe3\x03\xff\x92\xf6\xc5\xc0\x34\xf6\xc5\x27\x52\xec\x8b\x23\x29\xf6\xc5\xd3\x29\xf6\xc5\xf0\x6b\xb4\x2f\xfe\x31\xc5\xbe\x38\x3e\x8d\x7d\x31\x3a\x8d\x7d\x31\x32\x8d\x7d\x71\xec\x2c\xfb\xe2\xd8\x45\xd9\x17\x03\xff\x60\xfb\x62\x64\x1a\xfb\xe2\xf4\xe5\x9e\xda\xbe\x38\x3e\x8d\x7d\x31\x3a\x4d\x3b\x4e\xd9\x5e\xce\x73\xe5\x7b\x61\xf6\xc5\xe8\x34\xf6\xc5\x8b\xef\x9f\x0b\xb3\x2f\x3e\x30\x99\x34\xce\x8b\x4e\x4f\x63\x5f\xfc\xae\xb2\x2f\x8e\xbe\xc1\xf6\xc5\x21\x65\x5f\xf4\x4e\x63\x5f\x8c\x4c\x63\x5f\x1c\x9b\xd6\xbe\x38\x76\x51\xf6\xc5\x48\x8a\x7d\xf1\xa5\x14\xfb\x62\x74\x1a\x3b\xdf\xf4\xed\x7e\x6e\xfb\xe2\xf4\xe9\x27\xdb\x17\x23\xe7\xcc\x77\x2a\xfb\xe2\xd8\x1b\x62\x5f\x4c\xb6\xef\x5c\xb8\x7d\xf1\xdb\xca\xbe\xf8\xd4\x05\xda\x17\x7b\x94\x7d\xd1\xaf\xec\x8b\xbe\x7f\xb0\x7d\x31\x3a\x8d\x7d\x71\xca\x79\x95\x60\x5f\x1c\x7a\x83\xec\x8b\xfe\x14\xfb\x62\xcf\x34\xf6\x45\x5f\x8a\x7d\x71\x60\x1a\xfb\x62\x74\x1a\xfb\xe2\x94\xf5\x39\x27\x1e\xfe\x6b\xd9\x17\x87\xde\x20\xfb\x62\x7c\x9e\x49\x7d\x18\x9d\xc6\xbe\x78\xf1\xb8\x3a\xb5\x7d\xd1\xaf\xec\x8b\xca\x1e\x9d\x70\xee\x30\x96\xb0\xbf\xeb\xac\xf2\x5d\xb0\x7d\x31\x51\xae\xe3\x1e\xdf\x79\xec\x8b\xcf\x2a\xfb\xe2\x8f\x94\x7d\xf1\x19\x65\x5f\x1c\x9b\xb1\x2f\xce\xd8\x17\xcf\x61\x5f\xf4\x2a\xfb\xa2\xba\xff\x1a\x18\xba\x48\xfb\xe2\x58\x8a\x7d\xf1\x99\x69\xec\x8b\x53\xe7\x33\xbd\x7d\x71\xea\xf8\x71\xfb\xe2\xd4\xe1\x3a\x2e\xe9\xfb\x75\x1d\x4f\xcf\xb6\x2f\xfa\x26\x13\xf7\xa3\x3a\xce\x9e\xdf\xbe\xf8\x54\x8a\x7d\xf1\xdb\xca\xbe\xa8\xee\x17\x56\xf5\x4c\x63\x5f\x8c\xe3\x3f\x13\xf8\x2f\xed\x8d\xb9\xc6\xdc\xf3\xda\x19\x47\xdf\x10\x3b\xe3\xed\xe7\xb1\x33\xfa\x52\xec\x8c\xde\x69\xec\x8c\xdf\x9d\xc6\xce\xf8\x9c\xb2\x33\x8e\xa5\xd8\x19\x5f\x52\x76\xc6\x40\x8a\x9d\xf1\x85\x14\x3b\xe3\xcf\x52\xec\x8c\x3f\x4d\xb1\x33\xfe\x24\xc5\xce\x18\x49\xb1\x33\x3e\x9f\x62\x67\xfc\x6f\xe5\xd7\xf5\xc1\x8f\x53\xec\x8c\xbf\x4e\xb1\x33\xbe\x94\x62\x67\xfc\x55\x8a\x9d\xf1\xc5\x14\x3b\xe3\x2f\x53\xec\x8c\xd1\x14\x3b\xe3\xcf\x53\xec\x8c\xbf\x38\xcb\xce\x38\xf6\x9a\xec\x8c\x17\xd6\xfe\x1d\xea\x5e\x89\xfb\xca\x9f\x28\x3b\xe3\x69\x65\x67\xfc\xb1\xb2\x33\x8e\x28\x3b\xe3\xaf\x94\x9d\xf1\x09\x65\x67\xfc\xf9\x34\x76\xc6\x17\xa6\xb1\x33\xfe\x2c\xc5\xce\xf8\xd3\x69\xec\x8c\x91\x69\xec\x8c\xcf\xa7\xd8\x19\xff\x7b\x1a\x3b\xe3\xaf\x53\xec\x8c\x2f\x4d\x63\x67\x7c\x29\xc5\xce\xf8\xe2\x34\x76\xc6\x5f\x4e\x63\x67\x8c\xa6\xd8\x19\x5f\x63\xbf\x59\x92\xe7\x7f\xdc\xce\xa8\xcf\xab\xa2\x14\x3b\x63\xc1\x45\xda\x19\xb3\xce\x63\x67\x1c\xba\x40\x3b\xe3\x53\x29\x76\xc6\xb1\x8b\xaa\xaf\x9e\x9e\x9e\x6f\xea\xb8\x75\x9d\xfa\xf6\xeb\x4a\x3f\xb5\x3d\xf5\xf6\x4b\x6d\xd7\x3c\x36\x9e\x62\x67\xfc\xed\x45\xda\x19\xa3\xaf\xd9\xce\x18\xbc\x20\x3b\xe3\x80\xb2\x33\x4e\x28\x3b\xe3\xb8\xb2\x33\x46\x95\x9d\x31\x75\x1f\xfa\xb2\x8a\xff\xf7\x49\x24\xda\x55\x8a\xfe\xaa\xdc\x57\x95\xfb\x8a\x72\x27\x27\x13\xed\x7f\x27\xa6\x68\xe7\xe0\x14\xeb\xcb\xf3\xdb\x19\xd5\x78\x35\x3d\xf1\x1a\xed\x8c\x03\xd3\xd8\x19\xf5\x74\x1f\x7d\x4d\xbf\x5b\x3c\x63\xbe\xb8\x7e\x48\xb5\xd7\xed\xb4\xe4\x4e\x63\x5f\x4c\xee\x8f\x58\x3b\x26\xd8\x15\x2f\xa4\x1d\xdf\x28\xbb\x62\xae\x25\x53\x8d\x1f\x9f\xc2\x8d\x9f\xa4\xd8\x13\x9f\x8f\xe9\x87\x0b\xb2\x27\x2a\xbc\xd4\xf4\x73\x66\x25\xd7\xb9\x4a\xae\x47\x1e\x5c\xc0\x92\xe4\xe2\xf3\xe3\x66\x65\x57\x94\xeb\x98\xd3\x85\x0a\x67\x0e\x54\x24\xed\x7b\xf5\xdf\x1f\xa5\xf6\x93\x9e\x9e\xbb\x68\x7c\x1a\x7b\x5c\x74\x1a\x7b\x5c\x64\x0a\xfb\x95\xbb\x23\xd6\x2f\x53\xec\xcf\xa8\x68\x67\x52\xce\x5f\x4e\xdc\x25\xd7\x1f\xe5\x96\x2c\x78\xa7\xe8\x07\xea\xff\x0f\x24\xd8\x87\xa8\xfc\x37\x25\x8d\x97\x39\x49\xf5\xe8\x9c\x62\x7e\x8d\x9d\x63\xfc\xe5\xe2\xda\x57\xa6\x9a\x37\x46\xec\x15\xfc\x72\xcb\x55\x62\x80\x4c\x55\xae\x5c\x7e\x76\xb9\x76\x62\xe7\xeb\x2c\xcf\x95\x6a\x3e\xff\x26\x19\x77\xd4\x38\x71\x17\xaa\x75\x51\xe1\x2c\x35\x8f\x95\x3d\x59\xcd\x63\x3a\x7f\x29\x48\x18\x6f\x89\xfc\x0c\xc1\x97\x07\xf4\xee\x4a\x39\x7e\x3d\xba\x3e\xae\x94\x0a\xd7\xfd\xce\x34\x35\xae\xe5\x78\x71\x17\x6a\x31\x79\xb3\x90\x37\x0a\xf9\xd3\x87\x53\xf3\x33\x9c\xc5\x97\xf9\xa9\x75\xe0\x31\xc5\x37\xa9\x74\x8e\x29\x3b\x9f\xd2\x17\xa4\xd7\xc9\x22\xe4\x2e\xb4\x9f\xc5\x5f\x2a\xf8\xb6\xb3\xf8\x15\x82\x9f\x7e\x16\xdf\x21\xf8\xd6\xb3\xf8\xb2\x5d\x2c\x67\xf1\x65\x39\xcd\xa2\x9c\x47\xcd\xaa\x7c\xef\x54\xfb\x9d\x4f\xca\xf1\xf9\x71\x93\x3c\x17\xf9\xbc\x49\x9e\x8b\xe4\x9a\x84\x9a\x8f\x8d\x67\xc2\x1b\x42\x2e\xfd\x5c\x40\xdf\x9f\x9c\x56\xb8\xa1\x9f\x0b\xec\xc2\x5f\xd4\xf8\xfa\x03\x62\xe3\x4b\x9b\x66\x7c\x69\x53\x8d\xaf\x23\xaf\x73\x7c\xbd\xfa\xb2\x1c\x5f\xa7\x26\x13\xf1\x6c\x66\x1c\xfd\xf3\xc6\x11\xe1\x09\x8d\x23\xf0\x78\x3f\x4e\x37\xbf\x4d\x98\x7a\x5c\x9d\x31\x4c\x37\xbe\xee\x57\xe3\xeb\x93\x31\xbb\x4c\xe2\x1f\xe1\xd6\xef\x8c\x53\x8d\xab\xee\xd7\x39\xae\x3e\xab\xc6\xd5\x2e\xb5\x6f\xe9\x51\xe7\x0d\xf3\xde\xe0\xfb\x08\x59\x29\xf7\x11\x72\x93\xee\x23\x9c\xb9\xe9\x02\xef\x21\xbc\xf3\x8d\xba\x87\x60\x9c\xe6\x1e\xc2\x1d\x2f\xcf\xcc\xaf\xff\xa5\xf9\xa5\xa9\xf9\xa5\xfd\x23\xe6\xd7\x1a\xd5\xbf\x97\x62\xa6\x7f\xff\x77\xfa\x97\xce\x23\x09\x3f\x9f\x33\xfe\x23\xfa\x57\x9d\x63\x1d\x93\xe9\x1c\x8d\xb5\x93\xc2\xa7\x3b\xe2\x38\xaa\xcb\xdd\x2c\xc6\x43\xf6\xcb\x33\xf7\x8e\xce\xbe\x77\xa4\xdf\x9b\x71\xaf\x7a\x7a\x32\xa9\x9c\x67\xdd\x43\x9a\x79\xbf\xc1\xcc\xfb\x0d\x5e\xdf\xfb\x0d\x66\xee\x1f\xcd\xdc\x3f\x9a\xb9\x7f\x34\x73\xff\x68\xe6\xfe\xd1\xcc\xfd\xa3\x99\xfb\x47\x33\xf7\x8f\x66\xee\x1f\xcd\xdc\x3f\x9a\xb9\x7f\x34\x73\xff\x68\xe6\xfe\xd1\xcc\xfd\xa3\x99\xfb\x47\x33\xf7\x8f\x66\xee\x1f\xcd\xdc\x3f\x9a\xb9\x7f\x34\x73\xff\xe8\xff\xe6\xfd\xa3\x9f\xa5\xdc\x3f\xfa\xc9\xcc\xfd\xa3\xff\x13\xf7\x8f\x36\x5f\xe4\xfd\xa3\x5d\xaf\xb3\x3c\x17\x76\xff\x28\x3e\xae\x66\xee\x21\xfd\xef\xde\x43\x92\x1f\x76\xba\xf8\x7b\x48\x7f\x53\xe3\xec\xcf\xea\x1e\xd2\xe6\x8b\xbc\x87\x74\xdd\xeb\x1c\x67\xaf\xa8\xfb\x22\x81\xc9\x44\x5c\x9b\x19\x57\xff\x7b\xe3\xea\x42\xee\x25\x5d\xbc\x3d\xfd\xd3\x6a\x9c\x7d\x2a\x66\xa7\xb9\xb0\xfb\x48\x07\x5e\xe7\x7d\xa4\xcf\xa8\xf1\xd5\x9a\x72\x1f\xa9\xf4\x1f\x7c\x1f\x29\xfb\x7f\xf9\x3e\x92\x61\x9a\xfb\x48\xef\x7d\x79\x66\x9e\xfd\x8b\xcc\xb3\x0b\xb8\x9f\x74\xf1\xf3\xac\x59\xcd\xb3\xcb\x30\xd3\xcf\xff\x1a\xfd\x7c\x21\xf7\x94\xfe\x79\xf7\x93\x66\x8b\xf9\xaf\x25\xa1\xe5\xcc\x3f\xfd\xdf\x9c\x14\xff\xcc\x3f\xf9\x8f\xab\x31\x63\x56\x7a\x75\xa6\x5d\x92\xdb\xa5\x62\xa6\x5d\x92\xda\x85\xd4\x4e\x8d\x6a\x97\x99\xbf\xf8\x1f\xad\xe2\x0b\xf4\xb1\xa2\xce\x7e\xfe\x3f\xff\x13\x7f\x4c\xcd\x25\xbd\x5d\x66\xfe\xc9\x7f\x2c\xe1\x7f\xb0\x19\x7c\x49\xc5\x97\x82\x24\xee\xcc\xbf\x0d\x5b\x37\x8b\x7b\xe5\xf4\x2e\x4b\xba\x27\x46\x77\x81\xe8\xde\x0a\xdd\x59\xa1\xfb\x9c\x74\x97\x93\xee\x29\xd2\x1d\x45\xba\xf7\x22\x2e\x45\xd2\x85\xc8\xde\xde\xe4\xc8\x74\xb9\xb0\x6b\x30\x89\x97\x20\xd8\x7b\x84\x2e\x3f\xf6\x1e\x89\x85\xd1\xdd\xbb\x58\x7c\xf2\xd0\x25\xbc\xfd\x2a\x0f\xba\xa0\x46\x97\xd3\xe8\x62\x1d\x5d\xaa\xa3\x8b\x6d\x31\x26\xdd\x6a\x13\x25\x4d\x48\xcd\xd3\x99\x90\x9a\xe2\xb9\x3a\x0f\x25\x30\xe9\xac\x97\xce\x79\xe9\x2c\x98\xce\x81\x29\x27\x3a\x27\x16\x2f\x51\xa5\x0f\x35\xd2\xc7\x1c\xa5\xfc\x4b\x35\xb4\xec\x35\xa3\x8d\x01\xd7\x1b\x81\x5b\x8d\xc0\x90\x11\xb8\xcf\x08\x3c\x66\x04\x9e\xa7\x93\x9e\x34\x60\x73\x1a\x70\x34\x0d\x38\x9d\x06\xfc\x3c\x0d\x08\x99\x80\xdf\x98\x80\x32\x33\xd0\x65\x06\xee\x33\x03\x4f\x9a\x81\x06\x0b\xb0\xc3\x02\x74\x5b\x80\xdb\x2d\xc0\xfd\x16\xe0\x9b\x16\xe0\x6f\x16\x80\x76\x0f\x6b\xac\xc0\x26\x2b\x70\x8b\x15\x78\xaf\x15\x08\x58\x81\xcf\x58\x81\x2f\x5b\x81\xa7\xac\xc0\x0b\x56\xe0\xb7\x56\x60\xc2\x0a\xcc\x4a\x07\x0a\xd2\x81\xf9\xe9\xc0\xb2\x74\x60\x5d\x3a\xd0\x96\x0e\xf4\xa4\x03\x83\xe9\xc0\x5b\xd3\x81\x5b\xd3\x81\xa1\x74\xe0\x9e\x74\xe0\x81\x74\xe0\x91\x74\x20\x9c\x0e\x7c\x33\x1d\xf8\x5e\x3a\x10\x49\x07\x5e\x4c\x07\xfe\x48\xba\xc5\x06\x58\x6d\x00\xbd\x1d\xb1\xc4\x06\x54\xd8\x80\x7a\x1b\xb0\xd6\x06\x5c\x6d\x03\xba\x6c\xc0\x51\x1b\x70\x9b\x0d\xb8\xdb\x06\x7c\xce\x06\x3c\x67\x03\x7e\x61\x03\x5e\xb6\x01\x69\x76\x20\xc3\x0e\x54\xd8\x81\x15\x76\x60\xa3\x1d\x78\x93\x1d\x78\x8b\x1d\x78\xaf\x1d\x08\xd9\x81\x17\xec\x80\x69\x16\x70\xd9\x2c\xe0\xdf\x66\x01\x15\xb3\x01\xe7\x6c\xe0\xee\xd9\xc0\x73\xb3\x81\xf9\x19\xc0\xda\x0c\xe0\xd3\x19\xc0\x93\x19\xc0\x8f\x33\x00\x43\x26\x30\x3f\x13\x68\xc9\x04\x0e\x67\x02\xfe\x4c\xe0\x91\x4c\x20\x9c\x09\xbc\x9c\x09\xa4\x65\x01\xf9\x59\x40\x45\x16\xb0\x32\x0b\x58\x9b\x05\xbc\x39\x0b\x70\x66\x01\xfd\x59\xc0\x2d\x59\xc0\x7b\xb3\x80\x0f\x65\x01\xf7\x65\x01\x8f\x67\x01\xdf\xcf\x02\x9e\xcf\x02\x7e\x95\x05\xfc\x21\x0b\x78\x25\x0b\x98\x9d\x0d\xd0\x4e\x75\x41\x36\xb0\x38\x1b\xb8\x24\x1b\x68\xc9\x06\xae\xca\x06\x76\x67\x03\x1d\xd9\xc0\xc1\x6c\xe0\x68\x36\x70\x43\x36\xf0\xae\x6c\xe0\x03\xd9\xc0\xc9\x6c\xe0\x53\xd9\xc0\x63\xd9\xc0\x13\xd9\xc0\x33\xd9\xc0\x4b\xd9\xc0\x64\x36\x90\x95\x03\x54\xe5\x00\xdb\x72\x80\x37\xe7\x00\x87\x73\x80\x13\x39\xc0\x2d\x39\xc0\xdd\x39\xc0\xfd\x39\xc0\x17\x72\x80\xef\xe4\x00\xcf\xd3\x2d\x98\x5c\xa0\x3c\x17\xe8\xc8\x05\x6e\xcf\x05\xbe\x91\x0b\xfc\x3e\x17\x88\xe4\x01\xc5\xf9\xc0\xce\x7c\xe0\xfd\xf9\xc0\x8f\xf2\x81\xbf\xe7\x03\xce\x02\xe0\xa6\x02\xe0\x83\x05\xf2\x5a\xdc\xb3\x05\xc0\xdf\x0a\x80\xaa\x42\x60\x53\x21\xd0\x5f\x08\x1c\x2b\x04\x3e\x57\x08\x9c\x29\x04\x46\x0b\x81\x9f\x14\x02\xbf\x2f\x04\x5e\x2e\x04\x72\x8a\x80\x92\x22\xa0\xa2\x08\x68\x2a\x02\x36\x16\x01\xdb\x8b\xe8\xa8\x0c\xf0\x14\x01\xef\x29\x02\x3e\x5c\x04\x7c\xbc\x08\x78\xa8\x08\x78\xb4\x08\xf8\x4a\x11\xf0\xfd\x22\xe0\xf9\x22\xe0\x57\x45\xc0\x1f\x8a\x80\x57\x8a\x00\xd3\x1c\x20\x73\x0e\x50\x3c\x07\x58\x34\x07\xa8\x9b\x03\xac\x9e\x03\x6c\x98\x03\x6c\x9b\x03\xbc\x79\x0e\xe0\x9c\x03\xf4\xcf\x01\xbc\x73\x80\xdb\xe6\x00\xa7\xe6\x00\x0f\xcf\x01\xbe\x3e\x07\x18\x9b\x03\x8c\xcf\x01\xf2\xe7\x02\x55\x73\x81\xd5\x73\x81\xb7\xcc\x05\x4e\xcc\x05\x82\x25\x40\xb4\x04\xf8\x6b\x09\x90\x5d\x0a\x54\x95\x02\x97\x96\x02\x5b\x4a\x81\xbd\xa5\xc0\x5b\x4a\x81\x9b\x4b\x81\x0f\x97\x02\x0f\x94\x02\xe1\x52\xe0\x9b\xa5\xc0\xb3\xa5\xc0\x4b\xa5\x40\x9a\x03\x70\x38\x80\x4b\x1c\xc0\x46\x07\x70\xad\x03\xe8\x76\x00\x6f\x73\x00\xef\x71\x00\xf7\x38\x80\x07\x1c\xc0\x13\x0e\xe0\x69\x07\xf0\x82\x03\xf8\xb3\x03\x48\x9b\x07\x54\xcf\x03\xae\x9e\x07\xf8\xe6\x01\x9f\x9d\x07\x8c\xcf\x03\x0a\xe7\x03\xa6\x05\xc0\xba\x05\xc0\x5b\x17\x00\x8f\x2f\x00\xd8\x42\x60\xfe\x42\xe0\xf6\x85\xc0\x7d\x0b\x81\x2f\x2c\x04\x7e\xb6\x10\xe0\x65\xc0\xbc\x32\x60\x5b\x19\x30\x50\x06\x7c\xb0\x0c\x08\x94\x01\xcf\x95\x01\xbf\x28\x03\xfe\x52\x06\x98\xcb\x81\xc2\x72\x60\x41\x39\x70\x69\x39\xb0\xbe\x1c\xd8\x52\x0e\x38\xcb\x81\xfe\x72\xe0\x58\x39\x70\x5b\x39\xf0\xe1\x72\xe0\x73\xe5\xc0\x97\xcb\x81\xa7\xca\x81\x1f\x94\x03\x91\x72\xe0\xc5\x72\xe0\xe5\x72\x20\x6d\x11\x90\xb1\x08\x28\x5a\x04\x2c\x5c\x04\xd4\x2e\x02\x1a\x17\x01\xeb\x16\x01\xd7\x2c\x02\xf6\x2c\x02\xba\x16\x01\x7d\x8b\x80\xeb\x17\x01\x37\x2e\x02\x6e\x5f\x04\x7c\x70\x11\xf0\xc9\x45\xc0\x67\x16\x01\x23\x8b\x80\x67\x17\x01\xbf\x5d\x04\x4c\x2e\x02\xf2\x2b\x80\xa6\x0a\x60\x5b\x05\xd0\x55\x01\xdc\x56\x01\x7c\xb8\x02\x18\xa9\x02\xcc\xd5\x40\x61\x35\xb0\xac\x1a\xb8\xb2\x1a\x78\x73\x35\xd0\x5f\x0d\x1c\xab\x06\x6e\xab\x06\xee\xad\x06\x1e\xaf\x06\x9e\xaa\x06\x22\xd5\xc0\x8b\xd5\xc0\xdf\xab\x01\x5b\x0d\x50\x5e\x03\x34\xd7\x00\xbb\x6b\x80\x9e\x1a\xe0\xad\x35\xc0\xad\x35\xc0\xdd\x35\xc0\x83\x35\xc0\x97\x6b\x80\xa7\x6a\x00\x66\xd5\x60\x66\xdb\x34\xf3\x78\x23\xdb\x05\x66\xd5\x0a\xfc\x56\xbe\xf4\xa4\x16\xb0\x8e\x37\xf2\xa5\x27\xa7\xe5\xb0\x5d\x82\x21\x65\x7a\xfc\x56\xb6\x47\x0b\x58\x83\xb3\xd9\x1e\x2d\x92\x15\xcd\x61\x7b\x34\x6f\xae\x2f\x8f\x98\xf9\xfe\x02\xb6\x87\x22\x0d\xf8\xad\x7c\xe9\x49\x2d\x60\x1d\xad\x23\xd7\x5b\xef\xaf\x67\x7b\xb5\xc8\x8a\x58\xaa\x03\x7e\xab\x21\x93\x51\x8c\x50\x96\x20\x42\x59\xa3\x59\x6c\xbb\x36\x9a\x15\xc9\xe2\xc7\x33\x44\xc2\x82\xef\xcd\x0d\x39\x04\x11\x72\x0c\x3b\xd8\x36\x6d\xd8\x31\xe2\xa0\x08\xa3\x8e\xf1\x46\x7e\x3c\x23\x96\x16\x28\xad\x21\x87\x21\x13\x27\xb5\x21\x47\xd0\xc1\xb6\x6b\x41\x11\x15\x2a\x2a\x92\xa3\x52\xb6\x98\x26\x5b\x9c\xd4\xbc\xb9\x63\xa5\x22\xc2\x58\x29\x25\xe2\x50\x89\x38\x54\x22\x54\xa9\x80\x75\xac\x94\xdc\xb1\xd2\x48\x29\xdb\xa6\x45\x44\x44\xb3\x8a\x68\x4e\x8a\x18\xce\xa4\x88\xe1\xcc\x89\x4c\xfb\xf5\x08\x3e\xe4\xf5\x22\xf8\xb0\xd7\x8b\x93\x9a\x2f\x2b\x92\x95\xc2\x8a\x64\x85\x72\x28\x76\x28\x67\x38\xc7\x7e\x38\x29\x68\x38\x27\x9a\x33\xfb\x78\xc5\xfb\xcd\x89\x4c\x6f\xee\x58\x1e\xc5\x1f\xcb\xf3\xe5\xa7\x24\x15\xc8\xf7\x17\x50\x90\xbf\x60\xa2\x38\x25\xc8\x57\x32\x5c\x92\xc2\x8a\x96\x8c\x38\x52\x58\x81\x7a\x7f\x03\xdb\x2d\xaa\x32\x61\xa6\x94\x26\xcc\x5e\x0b\xdb\xab\x79\x2d\x3e\x55\xb3\xa8\x9d\xdc\xa8\x3d\x34\x8b\xfa\x97\x46\xc3\x5e\x2d\x92\x3b\x9e\xcb\xf6\xca\xd1\xb0\x37\xa9\x1d\x46\x66\x53\xec\x91\xd9\x91\x2c\x15\x9b\xbc\xde\xdc\x90\xa8\x40\x28\x6f\x44\x1f\x44\xf1\x41\x42\x01\x01\xab\x57\xb4\x9f\x37\x33\x94\xa9\xc6\x9c\xf0\xe6\x86\x85\x5c\x38\x2f\x90\xcf\xb6\xeb\x72\x9a\xbf\x60\xac\x80\x6d\x17\xd9\x86\x4d\x22\xdc\xe4\xb5\x18\x32\x2a\x4e\x26\x94\x3a\x22\x4a\x1d\xb1\x47\xed\xac\x55\x1b\xb7\x87\x66\xb3\x56\x91\x2c\x6b\xd5\xbc\xb9\xd1\x5c\xd6\x2a\x52\x63\xad\x49\x85\x88\xe4\x0a\xa1\xdc\x89\x5c\xb6\x2d\xa9\x94\x21\xce\x76\x6b\x21\x1e\x36\xb0\xed\xda\x44\x5a\xd8\xc4\xb6\x6b\x61\x93\xd7\xca\x76\xd3\xac\xb1\x92\x2f\x3d\x64\x63\xdb\xb5\x11\x5b\xc0\x4e\xc3\xd2\x1e\xb1\xb3\xdd\xda\xb8\x7d\x78\x16\xdb\xad\x0d\xcf\x8a\x64\x19\x8e\x9a\x4f\x6a\x91\x2c\x7f\x0e\xdb\xad\x45\x72\x23\x79\xe4\xe4\x05\xf2\x0d\x47\x73\xa8\xef\x46\x1c\x44\xc8\x9c\x4c\x6c\xb7\xe6\xb5\xa8\xb4\x23\xe4\x84\xd3\x47\x6c\xb2\x8b\xae\xf7\x32\x2a\xa0\x97\x85\xb8\xe1\x78\x18\xc4\x0a\x31\xbf\x95\xed\xa4\x01\x68\x63\x3b\xb5\x11\x5b\x68\x36\xdb\x29\x2b\xba\x93\x46\x78\x01\xdb\x29\x62\xf1\x20\x15\x3d\x68\x08\x69\x6c\x87\x16\xd2\x86\x8d\xac\x55\x1b\x36\x86\xd3\xd8\x0e\x0a\x1e\xa5\xe0\x6d\xda\x08\x05\x6f\xd3\x46\x29\x78\x9b\x36\x46\xc1\xdb\x74\x69\x31\x53\x48\x5e\xce\x54\x6d\xd8\x68\xc8\xe4\x27\x45\x1a\x86\x4c\xc3\x49\x2d\x9c\x16\x4d\x33\x64\x6a\xa2\x53\x23\xdc\x4f\xc9\x8d\x19\xfc\x94\x5c\x44\x1b\xa2\xe4\xa2\xc6\x80\x4a\xce\x67\xf2\x5a\xd8\x0e\x59\xe4\x1d\xda\x88\xcd\x37\x9b\xed\x90\x45\xde\xa1\x79\x73\x43\x05\xb2\x4c\xc3\x14\x4b\x20\x0d\xf5\x9e\x7d\x00\xc1\x47\x69\x46\x7c\x51\xcd\xa0\x68\x4e\x0a\x8b\x2a\x9b\xcc\x22\xd9\x30\xa5\x22\x86\x8a\xea\xa8\x20\x8d\x08\xc1\x18\x26\xc6\x1e\xc9\x10\xf9\x8c\x11\xa3\x55\x32\xc4\xb8\x08\xcc\x0a\xcf\x92\x60\x37\x3c\x2b\x3c\x6b\xf6\x31\x04\xbf\x42\x45\xa0\xd9\xd3\x38\x57\xd4\x34\x3c\xcb\x97\x25\xbb\x66\x3c\x2b\x98\x2d\xe3\xfa\xb3\x83\xd9\xf6\xc3\x09\x71\x45\x4c\x5f\x0e\xf5\x3d\x51\xfe\x9c\xa1\x1c\x19\x33\x92\x1b\xc8\x67\xbb\x45\xff\xcb\xa0\xa1\x82\x48\x01\xdb\xa5\xf9\x4b\x02\x25\x12\x89\x87\x0a\x46\x0a\x68\x58\x15\x44\x0a\x08\xa1\x28\x40\x21\xd4\xa8\x63\x68\x9e\xec\xdb\xc0\xbc\xf0\x3c\xb6\x95\xa8\x61\xa2\x64\x13\xcf\x0f\xcf\x97\x33\x64\x68\x7e\x78\x3e\x3f\xde\xa8\x85\xe7\x8f\x2c\x94\x9c\xe8\xc2\x89\x85\x32\xbe\xb7\x2c\x52\x21\x4b\x32\x54\x36\x5a\xa6\x9a\xa9\x22\x54\x21\x43\x43\x15\x63\x15\x6c\x77\x32\x15\xad\x0c\x55\x19\x8e\x8f\x8b\x91\xe7\xaf\x0a\x55\xa9\x7c\xab\x7c\xd5\x32\x9d\x91\x2a\x6f\xb5\x1e\x3e\x5e\xe5\xad\x96\xe1\xbe\xea\x60\x35\xdb\x9d\x4c\x4d\x54\x07\x6b\x15\xaf\x26\x5a\x2b\xa5\x43\x35\xe3\x8b\xd9\x56\xcd\x5f\x1b\xad\x95\x82\xd1\xda\xa1\x25\xb2\x92\xd1\xda\x89\x3a\xed\x78\x98\xba\x7e\xc5\x78\xa3\xa0\x74\x36\x35\x8c\x50\x3b\xb2\x61\x24\x0b\x8a\x25\x4a\x1a\x58\x42\xac\x46\xc5\x6a\x24\xd6\xd8\x92\xa1\xa5\x6c\x8f\xe6\xab\x9b\xa8\x23\xbc\x59\x31\xde\xa8\x3a\xaf\x2e\x5c\x27\x46\x78\xb8\x6e\xa2\x4e\x8c\xf9\xc8\x8a\x91\x46\xc1\x19\x6b\x1c\x6f\x24\x0e\x45\x8b\xa3\x66\xb0\x7e\xac\x3e\x19\xc3\x89\xeb\x6b\xd0\xc3\xa3\x0d\x63\x2b\x54\x6b\x37\x06\x1a\x69\xd8\x37\x8e\x34\x52\x97\x36\x8e\x35\x12\xfa\x94\x29\x7d\x1d\x6d\xa4\x1e\x2f\xd3\x0a\x86\x04\x16\x05\xad\x51\xa9\x47\x25\xa7\x4e\x71\xea\x74\x0e\xdb\x25\x18\x52\xa6\x67\x48\x0c\x64\x6b\x48\xea\xeb\x08\xe9\xeb\x89\x1c\x2f\x41\xed\x50\xbe\x4f\xe8\xeb\x32\x6d\x40\x4f\x38\x2c\xf4\xf5\x78\x9d\xb7\x9e\xed\xd1\xc6\x56\xc4\xf2\x19\x18\x92\xfa\x3a\x68\x1d\x96\xfa\x7a\x38\x2b\x92\x45\x38\x95\x15\xc9\x11\xfe\x89\x9c\xa0\xd4\xd3\x41\xc7\x84\xe8\x3b\x25\x43\xc0\x60\xf5\x4b\xbd\xec\x77\x04\x48\x2f\x07\x1c\x51\xd9\xf8\x89\x51\x28\x59\xa4\x24\x8b\x93\xda\x44\xce\xa8\xd4\xc3\xa3\xa5\x24\x54\xa0\x84\xa8\x90\x41\xeb\xa8\xd0\xbf\xa3\xa5\x63\xa4\x7f\xc7\x44\x04\x73\x52\x84\x11\xa1\x37\x46\x32\xbd\xa9\x4a\xd6\x7f\x91\x7a\x37\x92\x33\xfb\xb8\x23\x59\xef\x4e\xe4\x8c\x0a\xf5\x33\x9a\xe7\x4d\xd5\xbb\xd4\xae\x14\xe4\x2b\x18\x4f\xd5\xbb\xde\x92\x50\xaa\xde\x8d\x94\x0c\xa7\xea\x5d\x7f\xbd\xaf\x41\xb5\xa1\xd7\x42\x29\x79\x2d\x3e\xd2\xbb\x3e\x8b\x5f\xd5\x6c\x5c\x68\x30\xa1\x43\x48\x93\x46\x48\xef\x8e\x91\xea\xda\x2b\x72\x67\x7b\x93\x1b\x4a\xe8\xdd\x51\x5d\xef\x46\xc4\x02\x63\x22\x27\x28\x2a\x10\xcc\x0b\xeb\x83\x21\xde\xd9\x22\xc0\xea\x13\xed\xe7\xcb\x1c\xce\x54\x63\x87\xbc\x13\x39\xc3\x42\x6e\x38\x6f\x88\xf4\x6e\xbc\xb2\xa3\x42\xef\x96\x69\x03\x23\x42\xef\x8e\x98\x7c\x52\xef\xc6\x4b\xad\xaf\x16\xc6\x49\xef\x4e\xd8\x87\xa5\xde\x8d\xe4\x90\x2f\x27\x42\x7a\x97\x52\x63\xad\x49\x85\x18\x13\x7a\x77\x8c\x16\x15\xdb\x92\x4a\x19\x26\xbd\x1b\xe6\x23\xa4\xbc\xbc\xa6\x11\xd2\xbb\x23\x26\x1f\x29\xc5\xa0\x75\x82\xf4\xee\x48\xfa\xb0\x8d\x16\x77\xb6\x20\xe9\xdd\x10\x41\xf7\x6e\x6d\xc2\x1e\x26\xbd\x1b\x9e\x15\x4c\xd2\xbb\x63\xb9\x63\xa4\x77\xc7\xf2\x86\xa4\xde\xf5\x15\x0c\x29\xbd\x5b\xa6\x0d\x0c\x93\xde\xf5\x59\x54\xda\x51\x72\x46\xd2\x47\x85\xde\x2d\x8b\xeb\xdd\x30\xe7\xc7\x1b\xa9\x64\x21\x36\x44\x6a\x37\x68\x1d\x21\xb5\x3b\x6a\x1b\x96\x6a\x37\x42\x6a\x77\x22\x67\x54\xa8\xdd\x32\x2d\xcc\x43\x54\xf2\x90\x61\x58\x63\x3b\xb4\x61\x2d\x4c\x6a\x37\x6c\x1c\x11\x6a\xb7\x4c\x8b\x50\xf0\x36\x6d\x94\x82\xb7\x69\x63\x14\xbc\x4d\x8b\x50\xf0\x36\x5d\x5a\x4c\x0c\x92\x17\x13\x8f\x52\x10\x6a\x97\xd2\x10\x6a\x77\x24\x6d\x5c\xa9\xdd\x32\x2d\xca\x87\x28\xb9\x88\x61\x88\x92\x8b\x6a\x01\x4a\x6e\xdc\x18\x54\xc9\xf9\x4d\x3e\x52\xbb\xa2\xc8\x3b\xb4\x51\x9b\x5f\xaa\xdd\x08\xa9\xdd\x89\x9c\xa0\x50\xbb\x65\x5a\x98\x62\x09\xc0\xa0\xce\x4b\xd1\xb1\x14\x3b\x85\x45\x95\x4d\x66\x91\x2c\x0d\x0c\x39\x52\x54\x3f\x85\xec\xe3\x42\xed\x96\x69\x61\x62\xec\x91\x0c\x91\x4f\x84\x18\xad\x92\x21\x86\x45\x70\xd6\x88\x50\xbb\x65\x5a\x78\xd6\xc8\x14\x6a\xb7\x4c\x1b\x99\xe5\x17\x6a\xb7\x2c\xa6\x76\xcb\xa6\x54\xbb\x65\x31\xb5\x5b\x16\x53\xbb\x65\xda\x58\xee\x10\xa9\x5d\xea\x7e\x15\x44\xeb\xc9\x5d\x9a\xaf\x64\x48\xa8\x5d\x62\x84\x49\xed\x86\x0b\xc6\x0a\xf8\xf1\x02\x11\xa0\x80\x29\xec\xf0\x09\xb5\x5b\xa6\xf9\xe7\x85\x84\xda\x2d\xd3\x82\x44\x89\x26\x9e\x98\x17\x12\x6a\xb7\x4c\xf3\xcd\x0f\xcd\xe7\xc7\x97\x6a\xa1\xf9\xc3\x42\xed\x96\x69\x63\x0b\xa3\x42\xed\x96\x69\xe3\x0b\x47\x85\xda\x2d\xd3\x7c\x65\x61\xa1\x76\xcb\x34\x7f\x45\x40\xa8\xdd\x32\x2d\x50\x31\x52\xc1\xf6\x26\x53\x63\x95\x81\x2a\xc3\xf1\x28\x88\xf6\x56\x05\xaa\x54\xbe\x55\x13\x55\x32\x9d\xe1\xaa\xf1\x58\x78\xa4\x6a\x5c\x85\x4f\x54\x0d\x55\xb3\xbd\xc9\x54\xb4\xda\x5f\xab\xba\xb7\x7a\xa4\x56\xe5\x53\x13\x21\xb5\x3b\xb1\x78\x44\xa8\xdd\x32\x6d\xa4\xd6\x2b\xd4\x2e\x51\xd1\x3a\xc3\xf1\xc6\x93\x42\x4f\x10\xa1\x33\xa9\x59\xc6\x56\xc4\xf0\x5a\xb2\xa0\x58\xa2\x1c\xbe\x25\xc4\x5a\xaa\x58\x4b\x89\x15\x5e\xe2\x5d\xca\xf6\x6a\xe3\x4b\xa3\x75\x84\x65\x2b\xa2\x8d\xb2\x00\xde\xba\x90\x54\xba\xa1\xba\xa8\x54\xba\x63\x2b\xc2\x52\xe9\x8e\x36\x46\x95\xd2\x2d\x4b\x80\xcc\xa1\xfa\x91\xb3\x94\x6e\x99\xe6\x6d\xd0\xc3\x23\x0d\xa3\x2b\x54\x1b\x5c\x32\x44\x4a\x77\xa8\x31\x4c\x4a\x37\xdc\x38\x2a\x94\x6e\xb3\x52\xba\x13\x1b\xa9\xbf\x9b\x69\x03\xcc\xeb\x68\x17\x32\xb1\x51\xa8\x58\xc9\x59\xaa\x38\x4b\x75\x8e\xd8\x24\xeb\x32\x49\x9b\xe4\xb1\xac\x31\x52\xba\xe3\x39\x13\xb9\x6c\x8f\xe6\xcf\xf7\x0a\xa5\xdb\x1c\xdf\xde\x04\x85\xd2\x8d\xd4\x8d\xd7\xd1\xce\xd6\xbb\xc6\xd7\xa2\x92\x9d\x62\x97\x3c\x46\xea\x91\x52\x14\xfe\xf1\x9c\x80\xd4\xba\x01\x47\xc8\x41\xbb\x09\xc7\xb8\x50\xbe\x4a\x94\xb6\x70\x56\x9f\x54\xbe\x3e\xc7\x10\x29\xdf\x21\x47\xc8\x41\x9d\x31\xec\x98\xd8\x28\x94\x70\xf3\x54\x9b\xe2\x84\x5c\x70\x52\x1b\xcf\x19\x29\x15\x01\x23\xa5\x24\xec\x50\xc2\x0e\x25\xac\xf6\x76\x42\x19\x8f\x94\x8e\x96\xb2\x6d\xda\xa8\x88\x68\x56\x11\xcd\x49\x11\xcf\xb1\x19\x1e\x4b\x55\xca\x63\x59\x41\xa1\xab\x82\x39\xa1\xd4\xcd\x70\x28\x67\xec\xec\xcd\xf0\x78\xce\x88\xd0\x4d\x23\x79\x13\x79\x29\x49\x51\xbb\x53\x90\xb7\x20\x9a\xaa\x94\x27\x8a\x83\xa9\x4a\x79\xac\x24\x94\xaa\x94\xbd\xf5\xd1\x65\x6c\xaf\x36\xb2\x7c\xa4\x81\xed\xd5\x82\x2b\x43\xab\x48\x23\x37\x79\xd7\x90\xd3\xe2\x6f\x61\x7b\xb5\xe8\xba\xf1\x75\x34\x6a\x9b\x2f\x6c\xbf\x4c\xdd\xc8\xf6\x6a\xa3\xb9\x91\x5c\xb6\x57\x0e\x8c\xbd\x49\x03\x43\xdf\x2f\x8f\x65\xa9\xd8\xe4\x1d\xcf\x09\x88\x3a\x06\xf2\x86\xf3\xd4\x78\x8a\x0f\x97\x29\xf6\xcb\x71\x39\x7d\x9f\xed\x27\xbd\x1d\x6f\x0f\xda\x45\x88\x6c\x2f\x6a\xbf\x4c\xc9\xd2\xee\x39\x67\x8c\xf4\x36\xa5\xc6\x5a\x93\x0a\x31\x2a\xf4\xf6\x28\x2d\x4a\xb6\x25\x95\xf2\x75\xee\x97\x03\x52\x6f\x8f\x65\xf9\x68\xbf\x3c\x9a\x3b\x4a\x7a\x7b\x34\xcf\x2f\xf5\xb6\xb7\xc0\xaf\xf4\x76\xf3\x39\xf7\xcb\xcd\x67\xef\x97\x9b\xa7\xde\x2f\x8b\x8a\xee\xa4\x49\x20\x14\x77\xf3\xb9\xf7\xcb\xcd\xe7\xde\x2f\x37\x5f\xe4\x7e\xb9\xf9\xdc\xfb\xe5\xe6\xa9\xf7\xcb\xa2\xc8\x3b\xb4\xf1\x9c\x80\x50\xdc\xcd\xb1\xfd\x72\xf3\x54\xfb\x65\x8a\x9d\xc2\xa2\xca\x26\xb3\xa8\x28\xfa\x7e\xb9\x39\x75\xbf\xdc\x9c\xba\x5f\x6e\x4e\xdd\x2f\x37\xc7\xf6\xcb\xcd\xd3\xec\x97\x9b\x63\xfb\xe5\x66\x2d\x9a\x15\x10\x8a\xbb\x59\xf3\x65\x07\xce\x52\xdc\xcd\x9a\x37\xc7\x27\x14\x77\xb3\x54\xe1\x22\xe6\x68\xae\x9f\x14\x37\xf5\xbf\x0a\x2a\x18\x25\xc5\xed\x2d\xf1\x0b\xc5\x4d\x8c\x61\x52\xdc\xc3\x05\xa3\x05\x04\x62\x14\xa0\x40\x2c\xec\x08\x54\xab\x6d\x63\x73\x4c\x8b\x37\xc7\xb4\x78\x73\x4c\x8b\x37\xc7\xb4\x78\xb3\xd2\xe2\x8d\x31\x2d\xde\x1c\xd3\xe2\xcd\x31\x2d\xde\x1c\xd3\xe2\xcd\x31\x2d\xde\x1c\xd3\xdd\x89\x94\xd4\xe2\xb4\x39\x6e\x8e\x69\xf1\xe6\x98\x16\x6f\x56\x5a\x5c\x86\xeb\x5a\xbc\x39\xa6\xbb\x13\x29\x5d\x8b\x37\xc7\xb4\x78\x73\xaa\x16\x6f\xd6\x46\x6a\x27\x6a\x65\x25\x47\x6a\xc7\xd4\xe6\x99\x14\x4f\xac\x15\x88\x2d\x5a\x89\xb4\x91\x6c\x25\xc9\x82\x62\x89\x92\x78\x97\x8c\xd1\x16\x99\x38\xaa\x98\x4b\xc6\x97\xd0\xd9\xde\xd2\x31\xd2\xe2\x82\x2f\x0a\x10\x5d\x3a\x24\xb5\xf8\x50\xdd\x98\xd4\xe2\xde\x35\x91\x66\xc1\x19\x6f\xf6\xb5\x10\x87\xa2\xf9\xea\x27\x9a\x48\xd9\xfa\x5a\x62\xca\x56\xb0\x96\x2a\xd6\x52\xc5\x9a\x12\x89\x27\x9a\xa6\x42\x62\x92\x67\xbb\x84\xb8\x1a\x06\xf5\xfe\x7a\xc3\xee\x3b\x35\xcd\x5f\x3f\x54\x9f\xb5\xfb\x4e\x4d\xd7\x2b\x74\x26\x73\xf2\x4e\xae\x0d\xd5\x07\xeb\x73\x76\xdf\xa9\x1d\x5f\xfa\x7e\x2d\x39\x24\x58\x1f\xb8\x64\xde\x54\x21\x7b\xee\x34\x2f\x3d\x79\x27\xbb\x93\x1f\xcf\xb8\x53\xd3\x02\x97\x84\x1b\x1d\xe7\x8d\x15\x6e\x0c\xae\x2c\x9d\x22\x56\x52\xa4\xe0\xca\xe8\xa5\x17\x90\x63\xf4\x52\xdf\xea\xf3\xe7\xe8\x5b\xed\x6b\x3a\x6f\x8e\xbe\xa6\x89\xa6\x0b\xc8\xd1\xd7\x32\x72\xf9\x05\x44\x1b\xb9\x3c\xba\xf6\xfc\x05\x8b\xae\x8d\xae\x3b\x6f\xc1\xa2\xeb\x82\x57\x5c\x40\x8e\xc1\x2b\x46\x36\x9c\x3f\xc7\x91\x0d\x13\x1b\xcf\x97\x23\x0d\x16\x1a\x23\x29\x0b\x8f\xa1\xfa\x89\xa6\xd9\x71\x41\xb5\x76\x69\x99\xd8\x98\xca\x24\xf9\xe1\x65\xa1\xe5\x6c\x97\x36\xd2\x10\x58\x29\x47\x1f\x31\xe8\xb8\x69\xa4\x21\x42\xc7\x4d\x5a\xa4\x31\xb0\x52\xe2\x15\x85\x68\x19\x5e\x76\x52\xc4\x16\x14\xb1\xa3\x94\xc2\x5e\xc1\x53\x33\x7d\x99\x77\xb9\x50\x5c\xcb\x43\xcb\x75\x44\x08\x2e\x0f\x2d\x97\x13\x7b\x74\x79\xb8\x81\xce\x7f\x56\x79\x9b\x64\x86\xc4\xa0\x8c\x82\xab\x82\xab\x85\xbb\xda\xdb\x24\x33\xa4\x10\x2d\xc3\xab\x9d\x14\xb1\x05\x45\xec\x89\xe5\xc3\x0d\x6c\xab\x16\x5c\x35\xba\x5a\x26\x49\xe7\x55\x94\xa1\xbf\x21\xdc\xa0\x67\x38\xdc\x10\x6e\x90\xa1\xa1\x95\x81\x55\x62\x7e\x45\xd6\xa9\xf9\xd5\x24\x26\x5c\x74\x5d\x58\x2d\x86\x29\x06\xe5\xec\x6b\xf1\xad\x13\xee\xba\xc8\x3a\x05\xd1\x4d\x13\x4d\xc4\x89\xae\x8b\x6e\x10\xee\x86\xf0\x46\x19\x42\x32\x5a\x86\xd7\x4c\x2d\x1b\x59\x27\x28\x62\x8f\xad\x1c\x5a\xc5\xb6\x6a\xbe\x96\xd0\x3a\x99\x7b\x74\xe5\xf8\x4a\x2a\xdb\xf8\xca\xc0\x2a\xbd\x6c\x43\xab\x02\xab\x54\xc9\x9b\x26\x9a\xb4\x8c\xe7\xd2\x4f\x8a\xd2\x08\x8a\xd8\xa1\xa6\xf1\x26\xb6\x55\x8b\xae\xf3\x6f\x94\xf1\xc2\x4d\x23\x4d\x94\xca\x48\xd3\x44\x93\x9e\xca\x38\x55\x43\x84\x0e\x35\x0f\x37\xd3\x0e\xbd\x39\xd2\xcc\xb6\x6b\x91\xe6\xf1\x66\x5a\xe9\xb4\xa9\x6d\x42\x60\x13\xd5\xb2\x2d\x7e\x12\x17\xd8\x24\x90\x6b\x1a\x0e\xf5\x8d\x55\x97\xb9\x80\xb3\xb9\xb6\xf8\x41\x48\x48\x6c\x13\xa2\x75\x13\x62\x9b\x30\xb4\x26\x20\xb7\x09\x6d\x17\x75\x38\x37\x4c\xdb\x84\xb0\x3a\xa3\x6b\x3b\xc7\x19\xdd\xb0\x83\xb0\x3e\xec\x08\x6c\x12\xdb\x84\xb6\x8b\x3a\xab\x23\x61\x87\x12\x76\x28\xe1\x29\xcf\xec\x28\xa2\x59\x45\x34\x27\x45\x7c\x43\xcf\xee\x2a\xfe\x79\x67\x77\xbe\xfa\x71\x52\x4e\xa3\xcb\x47\x49\x39\x0d\xaf\x0c\xd3\x36\x21\xd0\x34\x44\xdb\x84\x40\x4b\x90\x94\x93\x6f\xbd\x7f\x3d\xcd\xe5\xb6\xd7\x73\xbc\xd7\xf6\x1a\x8f\xf7\xda\x5e\xdf\xf1\x5e\xdb\x1b\x72\xbc\xd7\x76\xbe\xe3\xbd\xb6\x7f\xda\xf1\x5e\xdb\x39\x8f\xf7\xda\x12\x8f\xf7\xe4\x36\xa1\xed\x82\xce\xf7\xda\xce\x7d\xbe\xd7\x76\xee\xf3\xbd\xb6\x8b\x3c\xdf\x6b\x3b\xf7\xf9\x5e\xdb\x05\x9d\xef\xb5\xc5\xce\xf7\xda\x5e\xc7\xf9\x5e\x5b\xec\x7c\xaf\x2d\xf5\x7c\xaf\x2d\xf5\x7c\xaf\x2d\xf5\x7c\xaf\x2d\x76\xbe\xd7\x36\xcd\xf9\x5e\x5b\xec\x7c\xaf\x2d\x76\xbe\xd7\x36\xe5\xf9\x5e\x5b\xec\x7c\xaf\x2d\x76\xbe\xd7\x96\x7a\xbe\xd7\x96\x7a\xbe\xd7\x96\x7c\xbe\xe7\x50\xe7\x7b\x02\xc4\x46\x1c\x41\x7d\x9b\xd0\xa6\x8d\x38\xfc\xe2\xb0\xaf\x4d\x1b\x9a\x37\x2c\xb6\x09\x6d\x5a\x88\xa8\x6d\x14\xea\x9d\x3f\x2c\x0e\xfb\xda\x34\xff\xfc\x61\xb1\x4d\x18\x9e\x1f\x16\xdb\x84\x36\x2d\xb2\x70\x5c\x6c\x13\xda\xb4\x89\x85\x63\x15\xaa\x02\x65\x23\x62\x9b\xd0\xa6\x0d\x55\x04\xc5\x36\xa1\x4d\x0b\x56\x8c\x8a\x6d\x42\x22\x15\xa9\x0c\xaa\x6d\x40\x9b\xe6\xab\x0a\x56\xa9\x7c\xc9\x9a\x26\xd2\x09\x57\x4d\xc4\xc2\xa3\xb4\x79\x10\xe1\xde\xea\x80\xd8\x26\x24\x52\xe3\xd5\x43\xe2\xb0\xaf\x4d\xf3\xd6\x8c\xd6\xaa\x7c\x6a\xa2\xb4\x4d\xf0\xd6\x8e\x8a\xc3\xbe\x36\x6d\x54\x1d\xf6\x11\x15\x51\xdb\x04\x52\x3c\xb1\x56\x20\x36\x41\x3d\x31\x55\x2b\x49\x16\x14\x4b\x94\xc4\xb7\x24\x42\xdb\x04\xe2\xa8\x62\x2e\x99\xa0\x6d\x42\x74\x69\x84\xb6\x09\x82\x2f\x0a\x30\xbe\x34\x20\xb7\x09\x81\xba\x88\xdc\x26\x0c\xad\x99\x90\xdb\x04\x5f\x4b\x40\x6d\x13\xda\x34\x7f\xbd\x7f\x0d\x29\xdb\x40\x4b\x4c\xb5\x4e\xc9\x9a\x12\x89\xfd\x6b\x92\x20\x98\x04\xe9\xec\xae\x45\x57\xca\xb4\xe4\x13\xfb\x83\xa1\xfa\xc0\x94\xfb\x83\x40\x7d\x68\x9a\xfd\x41\xa8\x3e\x34\xed\xfe\xa0\x2e\xbe\xf8\x0c\x5d\x32\xda\xe8\x38\x6f\xac\xd1\xc6\xe1\x69\xf6\x07\x09\x91\x86\x57\xfa\x2e\xbb\x80\x1c\x7d\x97\x05\x57\x3b\xce\x1b\x2b\xb8\x3a\x30\xcd\xfe\x20\x21\x52\xa0\xc9\xbf\xe6\x02\x72\x0c\xb4\x8c\x5f\x7e\x01\xd1\xc6\x2f\xf7\xaf\x3b\x7f\xc1\xfc\xeb\x7c\xeb\xcf\x5b\x30\xdf\xfa\xb1\x69\xf7\x07\x09\xd1\xc6\xae\x98\xd8\xe0\x38\x6f\xac\x89\x0d\x81\x4d\xe7\xcb\x91\x06\x0b\x8d\x91\x94\x15\x47\xa0\xde\xbf\xe6\xac\xfd\x01\x0d\xcb\xb3\xf7\x07\x6d\x5a\x78\xd9\x30\xed\x0f\x46\x1b\x42\x62\x7f\x20\x19\x04\x17\xa3\x0d\x64\xb4\x6e\xd4\xc6\x1b\x43\x62\x7f\x20\x43\xc4\xae\x40\xc4\x56\xfb\x83\x36\x6d\x9c\x52\xd8\x2b\x78\x6a\x8a\x2f\xf7\x89\xfd\x81\x6f\xf9\xb0\xda\x1f\xb4\x69\xa1\xe5\xc3\x62\x7f\xd0\xa6\x8d\xd1\xa6\x79\x97\x36\xbc\x6a\x48\xec\x0f\x24\x83\x32\x1a\x5e\x35\x22\xf6\x07\x23\xab\x87\xc4\xfe\x40\x86\x88\x5d\x81\x88\xad\xf6\x07\x6d\x9a\x57\x2c\xfd\xb5\xe1\x55\x51\xb1\x3f\x68\xd3\xfc\x0d\x43\x0d\x94\xe1\x50\xc3\x88\xda\x1f\xb4\x69\xe1\x86\x11\xb1\x3f\x68\xd3\xc2\x74\x50\x4a\xf3\xcb\xbb\x5e\x66\x48\x03\x88\x36\x0c\xeb\xa3\x1b\x55\x95\x57\x86\x56\x51\xce\x81\x96\x90\xd8\x1f\x84\xd6\x79\xd7\xcb\x12\x50\x54\xe2\xf8\xd6\x0f\x6d\x24\x77\x68\x63\x74\xa3\x6a\x8c\x95\x21\xb5\x3f\xa0\x84\xd5\xfe\xa0\x4d\x8b\xae\x0c\xd2\xfe\x20\xd0\x32\xba\x4e\x21\xec\x4a\xef\x2a\x2a\x9b\x77\x55\x48\xed\x0f\xda\xb4\xe0\xaa\x90\xd8\x1f\xc8\xf4\xc5\xae\x40\x94\x46\xed\x0f\xda\xb4\x91\x26\xdf\x1a\xda\x65\xac\x1f\xde\xa8\x1a\xad\x29\x22\xf6\x07\x91\x26\xff\x1a\x3d\x15\xdf\x1a\xff\x1a\x85\xbe\xcd\xa3\xb4\x3f\x18\x6d\x9e\xa0\xfd\xc1\x44\xb3\xaf\x85\xf6\x07\x99\xac\xac\x2c\xdb\x68\x28\x1b\xcc\xcf\xc8\x9f\x53\x96\xc9\x76\x2e\x70\xe7\x7b\xf2\x6f\x67\xf9\x00\x5f\x08\x43\x79\x53\x7a\x7a\x3a\x60\x58\x0e\x43\xd9\xa6\xec\x35\x85\xab\xd2\x2f\x4d\xe7\x05\x80\x96\xcd\x0c\x65\xe9\x46\xf2\x02\x46\xbb\x08\x25\x4f\x63\x3a\x90\xb6\x10\x86\x32\x29\x67\x62\x6c\x53\x36\x60\x9e\x87\x4d\xd9\x97\x18\x01\x0b\x05\xa5\xa7\x37\xa5\x03\xd6\x84\x24\x8d\x94\x64\xba\x88\x94\x0e\xd8\x96\xc7\x92\x03\xec\xb3\x41\x29\xcc\xca\x65\x9b\xb2\x17\x15\x02\xb3\x8d\xc2\x9f\x41\x77\xf9\x33\xcb\x05\x9d\x45\xbf\x6a\xcc\xce\x85\x8c\x90\x93\xcb\xc8\xc9\xd5\xd8\xa6\xec\xf4\x74\x99\x48\x5e\x05\x0c\x65\x73\x67\x03\xf9\x79\xd8\x94\x6d\x28\x93\xdc\x82\x5a\x26\x48\xe3\xa2\xc2\x4d\xd9\x0e\xfa\xd6\x60\xa1\x11\x92\x43\xc9\x16\x2d\x4f\xf0\xcc\x49\x67\xc0\x5c\x51\x69\x4a\xd3\x08\x14\xc7\x2a\x6d\xa4\x4a\x97\xc4\xc2\xd2\x81\xd2\x78\x83\xa4\xa7\xdb\xd3\x6d\xe9\x14\xc3\x91\xcf\xf4\xf0\x79\x2a\xe7\x74\x95\xef\x7c\x95\x55\x3a\x65\xb5\x40\x0f\x8c\x15\x6b\xa1\x2a\x96\x08\x2e\x53\xc1\xc6\x45\x85\x22\xb0\xbc\x96\x65\xce\xc9\x4a\x6b\x2e\x38\x5e\x68\x28\xd7\x6b\xb3\xa6\x10\x58\x64\x04\x9f\x1f\xaf\x41\x45\x31\xab\xcb\xde\xb9\x60\x4f\xfa\x5e\xe3\xee\x74\xa0\x92\x42\xeb\xb2\x81\xaa\xe5\xe0\x05\x44\x54\x2f\x57\x9c\x9a\x74\xb6\x73\x01\xb0\x98\x04\x28\x69\x5d\xa4\xd6\xa8\x62\x2e\x59\x8e\xb9\x76\x12\x59\x1a\x4b\x33\x9d\x22\xd4\xa5\x8b\xa2\x00\xf5\x46\x50\xf0\xb2\x84\x14\x44\x84\xe5\x46\xcc\x9d\x4d\x21\x0d\x46\x95\x02\x2a\x17\xd0\x85\x7b\x46\x3f\x15\x04\x83\x4d\x51\xea\x07\x3e\xe2\xbf\x2b\x88\x63\x53\xff\x73\x8d\x99\x34\x6e\x60\x26\x33\xe7\xcc\xc8\x38\x63\x46\x33\x07\x33\x71\x83\x81\xde\x1c\xc9\x37\xf0\x53\xd0\x66\x15\x30\xb6\xd5\xa8\x7d\x88\x92\xdc\x06\x63\x9a\x20\xb6\x6b\x46\x93\x20\x76\x98\x8d\x66\x41\xb4\xda\x8c\x16\x41\xec\xcc\x30\x5a\x05\xb1\x2b\xc7\x98\x7e\x37\x11\xbb\x0b\x90\x66\x34\x69\xa6\xfb\xe8\x05\xcb\x0f\x52\x29\x18\xd2\x6c\x69\xcc\x62\x37\x9b\x0c\xb3\x45\x4e\x5b\xf9\x29\x66\xc8\x10\xe4\x1e\x7e\x8a\x1b\x32\x05\x79\x1d\x3f\x65\x30\x64\x09\xf2\x4e\x7e\x4a\x33\x64\x0b\xf2\x63\xfc\x94\xd1\x90\x23\xc8\x07\xf8\xa9\x34\x43\xae\x20\x3f\xcb\x4f\x99\x0c\x79\x82\xfc\x0a\x3f\x65\xb6\xe6\xe7\x31\x80\xef\xe6\xfc\x94\x45\x64\x9c\x4e\x19\x6b\xb0\x15\x54\xd1\xef\xb1\xf3\xec\x4b\x18\x30\xab\x99\x01\xb3\xd7\xd1\x83\xa8\x0c\xa4\x15\x1a\x35\xfb\x26\x06\x64\x92\x88\xad\x68\x17\xc5\x4d\xb7\xbf\x99\xe2\x1e\xa4\x68\xb1\x07\xb2\x6c\x73\xe8\x0d\x14\x6c\x9d\xfd\x58\x2c\xa5\x83\x7a\x4a\x71\x0a\xb6\xb9\x1f\xa6\x68\x39\xf6\x00\x03\xb2\x3f\xce\x80\xb4\x62\x93\xd9\x56\xf2\x19\xe2\xda\xed\x8f\x24\x0b\xc7\x1f\x14\x13\xb6\x52\x21\xbc\xc2\xe6\x88\x90\x5b\x6e\xff\xf9\x14\xd1\x3f\x1e\xa3\x28\x00\xb6\x79\x36\xfa\xd2\xeb\x62\x7b\x26\x4f\x2a\xf5\xc7\xf5\x07\x6c\xf3\x6b\x29\xc6\x52\xfb\x32\xfa\xc4\x75\x3c\x14\xb9\xd4\x6f\x9a\xa1\x20\x6f\x01\xf2\x16\xb2\xbc\x32\x9e\x57\xae\xe5\x2d\x4a\xcb\xab\x30\xe7\x55\xda\xf2\xaa\x32\xf3\xaa\xf3\xf2\x6a\x8a\xf3\x16\xcf\xcb\xab\xad\xcc\x5b\x52\x9b\xb7\x74\x49\x5e\x5d\x7d\x5e\xfd\xb2\xbc\x65\xd7\xe6\x2d\x6f\xcb\x6b\xd8\x9f\xb7\xe2\x40\xde\x25\xbd\x79\x8d\x37\xb3\xbc\x95\x3e\x96\xb7\xea\x9d\x2c\xef\xd2\x49\x96\x77\x99\x97\x23\x6d\xb5\xd1\x9c\xff\x49\x0e\x34\x6b\x39\xf9\x0f\x71\x60\x8d\x96\x9e\xd6\x64\xe2\xf9\x94\x73\x8b\x96\x69\x0f\x73\x20\x7f\x84\x03\x6b\x35\x73\xda\xe5\x66\x96\xff\x3d\x0e\xac\x4f\x9b\x23\x22\xac\x33\x2e\x2d\xb8\x82\x07\x19\xd5\xb0\x70\x03\x0f\xb2\x1e\x0d\x28\xec\xe2\x41\x46\x62\x85\x4e\x1e\x64\xf4\xc3\x92\xa2\x6e\xfe\x35\x76\x37\x11\x7b\xf9\xfd\x8c\x2a\x56\xd4\xcf\xbf\xc2\xe8\xb7\x27\x45\x03\xfc\xcb\x42\xbc\xe8\x30\x0f\x4b\x62\x90\x7f\x51\x12\x6e\xfe\x05\x91\x4e\x91\x87\x9f\x66\x9f\x25\xe2\x08\x7f\x9c\x51\x61\x8b\xae\xe3\x8f\x49\xf1\xa3\xfc\x51\x49\x5c\xcf\x87\x25\x71\x8c\x7f\x5e\x12\xc7\xf9\x83\x8c\x3e\x0d\x51\xb4\x87\x3f\x20\x33\x7d\x1b\xff\x2a\x9b\x4d\x9c\x5b\xf8\x43\xec\xc3\x94\xce\xad\x3a\xf1\x0e\xfe\x9f\x6c\x3f\x05\xbd\x9f\x7f\x96\xe5\x99\x80\xa2\xbb\xf8\xc3\xec\x6a\x22\xee\xe3\x21\x36\xdb\x0c\x14\x3d\xc4\x3f\xc3\x5a\x88\xf8\x3c\xff\x1c\xfb\x9c\x19\x98\x53\xf4\x38\xbf\x8f\x3d\x4b\x73\x97\xc8\xe7\xd9\x2f\x75\xf2\x45\xf6\x07\x9d\xfc\x5e\x3c\xc2\xb7\xd8\xa4\x4e\xfe\x2e\x1e\xf7\x6f\xf1\x08\x7f\x67\xe9\x16\x15\xe1\xe5\x38\xf9\xa7\x38\x69\xe2\x31\xb1\x74\x1e\xe3\x2e\x94\x24\xec\x87\x34\x60\x6e\xa7\xdf\xbc\xd4\x56\xbc\x91\xda\xc0\x5c\x67\x43\xf1\x95\x82\xaa\xb7\x69\xc5\x57\x09\x6a\x99\xcd\x5c\xbc\x59\x50\xcb\x6d\xb6\xe2\xab\x05\xd5\x60\xcb\x28\xbe\x46\x50\x2b\x6c\x39\xc5\x5b\x04\x75\x89\xad\xa0\x78\xab\xa0\x1a\x6d\x73\x8b\xb7\x09\x6a\xa5\xcd\x51\xbc\x5d\x50\xab\x6c\x0b\x8b\x77\x08\xea\x52\x5b\x45\x71\xab\xa0\x2e\xb3\xd5\x14\xef\xf4\x12\xb5\xda\xb6\xb4\x78\x97\xe0\x35\xd9\xd6\x15\xef\x16\xd4\x1a\xdb\xc6\xe2\x3d\x82\x6a\xb6\x6d\x2e\xde\x2b\xa8\x16\xdb\xd6\xe2\x37\x09\xea\x72\x5b\x6b\xf1\x9b\x05\xb5\xde\xb6\xa7\xf8\x5a\x41\x5d\x61\xbb\xb6\xf8\xdf\x04\xb5\xc1\xb6\xaf\xb8\xed\x76\xa2\x36\xda\xba\x8a\xdb\x05\xb5\xc9\xe6\x29\xde\x27\x42\xaf\xb4\xdd\x5c\xdc\x21\xa8\xab\x6c\xbe\xe2\xfd\x82\xba\xc6\x76\x3b\xf2\x69\xe2\x6c\xd2\x66\x99\x88\x23\x90\xc6\x88\x38\xad\x21\x93\x86\x59\x49\x5f\x0d\xff\x77\xa3\xa8\x0a\x7f\x3b\x8c\x07\xfe\x48\x84\x57\x33\xf6\x08\xe2\x86\x1c\xa3\xeb\x24\x07\xf8\x8d\x0b\x8d\x6f\x11\xc4\x4d\xe5\xc6\x83\x34\x2a\xf9\xcd\x15\xc6\x5e\x41\xdc\x52\x69\xec\x12\xc4\xad\x55\xc6\x43\x82\x78\x47\x35\x4c\x44\x88\x3c\x33\x50\xf2\x56\xb3\x69\x81\xf1\x04\x8d\x41\xd3\x42\xc0\x9e\x93\x06\x94\xdc\x5c\xc1\xbb\x8c\xff\x2e\xb2\x3d\x00\xe3\xdb\x05\xf1\x16\xcd\xe8\x15\xc4\x41\xb3\xf1\x06\x41\xf4\xda\x8c\xfd\x82\xe8\xcb\x30\x1e\x12\x44\x7f\x8e\xf1\x46\x9a\x0c\x7c\xb0\xc0\x78\x93\x20\xdc\x0e\xd8\x0f\x50\x92\x77\x56\x58\xae\x2d\xdd\x46\x19\x5b\xda\x98\xa6\xa1\xd4\x27\xe8\x76\xa6\x01\xc6\x77\x3e\x9e\x06\x58\xba\x99\xf1\x36\xaa\x86\xa5\x47\x33\xbe\x4b\x84\xba\xd2\x8c\xb7\x0b\xe2\x2d\x26\xe3\x81\xaf\x53\x9c\x5e\xb3\xb1\x47\x10\x87\x0a\x12\xaa\x61\x40\xc9\xfb\x32\xac\x25\xc6\x77\x7f\x3f\x0d\xb0\x2e\x80\x23\xc3\x5a\x6a\x7c\x0f\x35\x92\xd5\x01\xe3\x7b\xff\x4c\xdc\x79\x30\xfa\xb9\x09\xb0\xce\x07\x60\x3a\xa9\x8b\x9a\x61\x9a\x65\x52\xb4\x86\x7c\xaa\xc6\x1d\x5a\xbe\x7d\x8e\x09\x28\xf9\x90\x39\x3d\xcf\xf8\x01\x11\x35\x1f\xc6\x0f\x0a\xa2\x80\x1b\x37\x0a\xa2\x50\x33\xfe\xc7\x7a\x92\x2c\x4a\x83\x40\xa9\x21\x6d\xae\x7d\x3b\x89\x7d\x2a\xc7\xa6\xc4\x6c\xba\x98\xad\x80\x1b\x3f\x4c\xf9\xd8\x0a\x35\xe3\x47\x04\x51\x64\x2e\xbd\x9b\xc4\x6c\x73\xb9\x66\xb3\x95\x7e\x54\xd0\xc5\x5c\x33\xdb\x4a\x4f\x0a\xba\x84\x33\x93\xad\x34\x20\xe8\x52\xce\xd2\x6c\xa5\xa7\x04\xed\xe0\xcc\x68\x2b\xbd\x47\xd0\xf3\x38\xd3\x6c\xa5\x1f\x13\xf4\x7c\xce\x0c\xb6\xd2\x7b\x05\xbd\x80\x33\x6e\x2b\xfd\xb8\xa0\x17\x72\xc6\x6c\xa5\x9f\x10\x74\x19\x67\xb0\x19\x3f\x29\x8a\xb4\x7c\x96\xac\x80\xad\x21\xc3\x18\x14\x9c\x15\x59\xb0\xe7\x98\x81\x92\x07\x67\xd9\x6f\x33\xde\xdf\x60\x06\xec\xef\x82\xf1\x01\x41\xdc\x9e\x66\xfc\x34\xc5\xb2\xbf\xdb\x06\xd3\x88\xde\x7c\x69\xb0\xd3\xfa\xa0\xe4\x91\x9c\x59\xbb\x4a\x43\xd4\x21\xb3\xf6\xc4\xbb\x77\xd6\x5e\xd1\xbd\x9f\x11\xf4\x3e\x66\xfc\x4f\x4a\x60\x56\x07\x37\x7e\x56\x10\xfb\x35\xe3\xc3\x82\xe8\x4c\x33\x7e\x4e\xc4\xe9\x32\x1b\xaf\x16\x84\xd3\x22\x0b\x37\xab\xdb\x6a\x3c\x40\xed\x35\xeb\x80\xcd\xd8\x23\x88\x9e\x0c\xd8\x1f\xa3\x3c\x1f\xd3\x4c\x0d\x46\x8d\xa2\x9b\x56\xc0\x38\x2c\x88\x4b\x98\xf1\x51\x92\x33\x35\x72\xc4\x95\x78\x46\x02\x9d\x93\x40\xcf\x4d\xa0\x8b\x13\xe8\x5c\xcc\x3b\xcd\xbf\x49\x70\x3c\xbf\x9b\x3f\x49\x63\x02\x0b\xbe\x60\xba\x8a\xa6\xe4\xc2\xe3\xa6\xab\xe8\xfd\x95\x0b\xf7\x9a\xae\xa2\x49\xb3\xf0\x41\xd3\xe6\xeb\x2c\xc0\xfc\x2f\x9a\xb6\x50\xf8\xfc\xfb\x4c\xd7\x10\x22\xcf\x3f\x63\xda\x7a\x82\xf8\x9f\x36\x6d\xa3\xa6\x9f\xff\x15\xd3\x76\x0a\x87\x9d\x70\xdf\x4e\x51\xec\x5e\x0b\x50\xf2\x65\xcd\x54\x63\x0c\x53\x33\x98\x16\xc3\xf8\x25\x41\xd4\x72\x2c\x78\xc2\xd4\x4c\xf1\x17\x7e\xda\xd4\x4c\xf2\x58\xf0\x55\xd3\xa0\x2a\xc0\xa0\x2a\x80\x5b\x14\xe0\x6b\x26\xcf\xd3\x94\xd1\xd7\x4d\xd7\x91\x96\x80\x9d\x9e\x0b\x46\x4c\xb7\xa8\xd8\xb7\xa8\xd8\xb7\x8a\xd8\x8f\x98\xde\x61\xb6\x02\xf3\xbf\x61\x7a\x27\xe9\x8b\xf9\x5f\x34\xdd\x46\xf1\x60\x27\xdf\x82\x6f\x9a\x9e\x54\x52\x4f\x2a\xa9\xa7\x84\xd4\x87\x4c\xdf\x6a\x22\xa9\x27\x4d\xa3\xa4\x80\xe6\x6f\x34\x7d\x87\xe2\xc1\x4e\xbe\x05\x4f\x99\x7e\xaa\xa4\x7e\xaa\xa4\x7e\x26\xa4\x3e\x65\x7a\xc1\x23\xa5\x7e\x79\xb5\x94\xfa\x85\x94\x22\xdf\x82\x6f\x99\x1e\x55\x52\x8f\x2a\xa9\xc7\x84\xd4\xb7\x4d\x8f\xdf\x23\xa5\xbe\x40\x8a\x0c\x76\x7a\x16\x7c\x87\xb7\xea\xaa\xbc\x55\xaa\xf2\x51\xde\x2a\x95\xe9\x77\xf9\x4e\x46\xbf\xd5\x4c\x00\x04\x8e\x82\xa7\x79\x87\x2e\xd0\xa1\x0b\x74\xe8\x02\xfb\x19\xad\xf5\xb0\xe0\xbf\xf8\xaf\x29\xce\xc2\xb7\xf1\x5f\x53\x6f\x2f\xec\xe6\xbf\x6e\x4d\x07\x16\x7e\x4f\xf2\xe7\xcc\xff\x3e\x1f\x7f\x9c\x7a\xef\x20\xff\x1d\x25\x8e\x39\xf3\x7f\xc0\xff\x42\xd4\xfc\x1f\xf2\x89\x18\xe7\x55\xc5\x99\x24\x17\xb0\xdf\x6d\x04\x16\x8c\x71\xd9\x2a\xcf\xf0\x9f\x52\xae\x0b\x7f\xc0\x7f\x4a\xc1\x0b\x7f\x28\x5d\x2c\xf8\x11\xff\x0e\x11\x0b\x7b\x95\xfb\x2c\xff\x8e\xc8\xab\x9b\x8f\x7e\x8c\x7e\x66\xfa\x7d\xfe\x7d\xf2\xa3\xe4\xc7\x05\xfc\x23\xc6\xe7\x28\x11\x7e\x37\x8c\x7b\x1e\x4f\x07\xf8\x47\xb5\x84\xea\xe6\x60\xc1\x7f\xf3\x67\xc8\xbb\xf0\x79\xfe\x0c\x0d\xa1\xf9\xdd\xfc\x47\x34\x6c\xe6\x7f\x9f\x3f\x2b\x12\x29\x8b\x70\x33\x2b\xec\xe6\x66\x46\x15\x2c\xdc\xc8\xcd\x62\xd9\x52\xe8\xe6\x66\xb9\x90\xf9\x09\xb7\x08\x4e\xd1\x4f\xb9\x95\x95\xdb\x48\xa7\x47\xb9\x8d\x55\xd3\xaa\x1f\x76\x72\x4a\x7e\x59\xc1\x6f\x33\xfe\x8c\x22\xf1\x77\xc1\xf8\x82\x20\x6e\x37\x1b\x7f\x2e\x88\x77\x67\x18\x7f\x21\x88\xf7\x14\x18\xdd\x94\x22\x7f\xaf\xc3\x78\xe8\x80\x0d\xe0\xfe\x79\x09\x65\x35\xa1\xe0\x57\x7c\x9e\xe8\x88\xc2\xb7\xf1\x79\x62\xd1\x53\xd8\xcd\xe7\xc9\x72\x0d\xf2\x79\xb2\xd7\x0e\x2b\xa2\xe8\x27\xbc\x4c\x96\xeb\x45\x5e\xce\x9e\xb5\x01\x45\xe3\xbc\x5e\x06\xfd\x8e\x5f\x25\xd2\xa1\x92\x56\xb0\x08\x95\x74\x4e\xd1\xef\xf9\x2a\x11\x1f\xb0\x13\xa7\xe4\x7f\x0a\xf8\xe3\xc6\x5f\x13\x87\x9f\x86\xf1\x25\x41\x7c\xc1\x6c\xfc\x0d\x09\xf2\x2f\x66\x18\x7f\x4b\x25\xe3\x67\x72\x8c\x87\x48\x09\xf1\x70\x2e\xca\xfe\xc0\x6f\x67\x85\x1b\xf8\xed\x72\xd8\x78\xf8\xed\x22\xc1\xc2\x2e\x7e\xbb\x68\xaa\xc2\x7e\x7e\xbb\xc8\xb7\xd0\xc9\x6f\x97\x8b\xc8\x3f\x72\x3f\xeb\xb4\x03\x45\x7f\xe5\xef\x61\x4f\x71\x2a\xc7\xe3\xfc\x43\xec\xa4\x78\x6f\x5c\xc9\x44\x2e\x6f\x52\x5a\x7c\x0d\x8c\x7f\xa2\x7e\xe1\xcd\x9a\xf1\xcf\x94\x2a\x6f\x49\x53\x1a\xfa\xf2\x59\xc6\xbf\x88\xa0\xb5\xb3\x95\x5e\x5d\x97\x99\x80\x49\x85\x28\xfb\x1b\xef\xa3\x72\xf5\xc9\x72\xfd\x91\xf7\xb1\x88\x5d\x14\xb0\x4f\x14\xb0\xe8\xef\xbc\x5f\x34\x0c\x65\xee\x66\x3f\x17\x99\xdb\xa9\x58\xf1\x54\xb2\x50\x6e\x1d\x98\x0b\xb0\x37\x19\x19\x6f\xe6\x14\x7b\x11\x36\xf0\x66\x4e\x49\x56\x3c\x47\xed\xc6\x2f\xe7\xb6\x4a\xc3\xcf\x39\x50\xc9\x7f\x4b\x4f\xf6\x17\x0e\x54\x19\xee\x08\x03\x06\xa0\x5a\xb3\xd0\xd3\x98\x45\xcf\xb4\x39\xf4\x34\x2d\xa4\xa7\x79\x31\x3d\x2d\x2b\xe8\x69\x5d\x43\xcf\xf4\x8d\xf4\xb4\x6d\xa3\xa7\xfd\x4d\xf4\x9c\xd5\x49\xcf\xd9\xbd\xf4\xcc\x38\x42\xcf\xcc\x7f\xa7\x67\xf6\x3b\xe8\x59\xe0\xa7\x67\xd1\x87\xe8\x39\xe7\x1e\x7a\xce\xbb\x9f\x9e\xe5\xf4\x81\xac\x1a\x26\x8a\xf0\x0c\xbd\x4a\xa9\x22\xdf\x02\x70\xfe\x55\xb6\xb0\x3a\xab\x94\x96\x97\x8b\x2b\x2c\x40\xfa\x56\x6a\xbd\x27\x59\x46\x2d\xdb\xd3\x68\x01\x96\xcc\xda\x68\x01\xaa\xd8\xee\xad\x16\xa0\x3a\x67\x0f\x3d\x73\xf7\xd1\x33\xaf\xc7\x02\x2c\x1d\xb2\x00\x06\x53\x97\xb9\x32\x3f\x40\x69\xa0\xe2\x53\x16\xfa\xb6\xc5\x8f\x59\x76\x65\xe1\xa3\x42\x72\xfb\x37\x28\xa0\xce\xb6\x07\xa2\x01\xc6\xf1\x02\xe5\x5e\x67\xf7\xea\xfe\xff\x21\x7f\xc5\x0f\x2d\x80\x91\x3f\xcd\xb2\x2b\x8b\x7f\x49\xc9\xcf\xfd\x0b\x3d\x4b\x26\x49\xb8\x26\x4d\x08\xfe\x8d\x22\xd6\xcd\xba\x5e\x17\x34\xd2\x7b\x68\x16\xdb\xad\xc0\x6c\x7a\x93\x20\x1f\x67\xf6\xca\xd2\x62\x2b\x65\xba\x7b\xb1\x15\xa8\x76\x34\x10\xa2\xd5\x65\x2c\xd5\x25\x32\x48\xa2\xc6\x24\xe8\x39\x82\x36\x0b\xba\x5c\xd0\x16\x41\xd7\x11\xbd\x78\xad\x15\xc8\xa4\xef\x04\xf0\x34\x6e\xaf\x9c\xbf\x43\xa6\xda\x49\xa9\x2e\x38\x28\x52\xcd\x6a\xd4\x53\xdd\x40\x12\x15\x9f\xb4\x02\x56\x9e\xc7\xab\x2a\x17\x85\xac\x40\x65\xc5\xa3\x56\x15\x4e\xbf\x83\xc7\xe2\x31\x2b\x90\xdd\x43\xad\x5b\xc0\xab\x6a\xd9\xae\x9f\x58\x81\x5a\x7e\x87\xe3\x45\x3d\xda\x1f\x44\xb4\x63\x56\x20\x87\x5e\x69\xc5\x17\x70\x7b\x65\xd9\x3b\x29\xc7\x85\x1f\xa2\xa0\xba\xdc\x1e\x3d\xc7\x7f\xa3\x1c\x17\xbf\x62\x05\xf2\xf6\xd1\x14\xac\xe5\xd6\xca\x4a\x63\x3a\x50\xab\xdd\x11\xc6\x49\x7b\x3a\x50\x9f\x4b\x87\x19\xf9\xd4\xea\xd5\x55\x25\xe9\x40\x75\x75\x79\x3a\xb0\x6c\x6f\x3a\x50\x40\x2a\x81\xff\x7e\x56\x2d\xdb\xe6\x22\x21\xb6\xed\x70\x3a\x75\x5e\x5d\xe1\x3e\x1a\x2a\x4b\x96\x52\xf4\x7a\x3a\xef\x59\x76\x3c\x9d\xf6\x70\xb4\xa7\xff\x4b\x4e\x65\xcd\x8d\x74\xe8\xb2\xf8\xb6\x74\x55\x8c\xf7\x51\xbc\xda\xbb\x48\x56\x44\x9c\x13\xa4\x88\x13\x39\x95\x4b\x6f\x4c\x8a\x52\x27\xa3\x50\xd6\x73\xa9\x2f\xf8\x5f\x67\xd5\xb2\x3d\x94\x75\xe5\x32\x95\x73\xb1\x99\x01\xd5\x2b\x2e\xa7\xe8\x2b\xaf\xa4\x9c\xbf\x4e\x07\x52\xf4\xc5\x05\xfe\xaa\xa3\x7a\xf9\x0f\x29\xa0\xe1\x79\x8a\x2c\x42\x4a\xe9\xad\x6f\x7c\xd2\x51\x7d\x89\x08\x69\x14\x21\x8b\x29\x07\x07\xbd\x99\x88\x83\xcd\xaa\x5c\x25\xd3\x46\x45\x34\x1d\x48\xe7\xf5\xdc\x22\x9b\xe7\x37\x94\xf1\xa5\x7f\xa6\x9a\xf3\x3b\xcc\x93\x24\x7f\x99\xd9\x06\x54\xaf\xce\xb4\x01\x75\xf3\xc2\x7a\x33\xcf\x21\x34\x44\x85\xdb\x06\xd8\xf8\x25\x3c\xbb\xb2\xe9\xad\x36\xa0\x72\xcd\x2d\xf4\x6c\x7e\x0f\x3d\x5b\xfe\x83\x04\x2f\x0f\xd0\x73\xed\xa7\xe8\xb9\xee\x3f\x29\x91\xf9\x21\x3d\x91\xaf\x52\x22\x75\x0b\xe8\x9d\xf4\xd5\x9b\x46\xc9\x83\x8a\x6c\x3b\x60\xe7\x97\x72\x63\x2d\xdb\x55\x60\x07\x2a\xd7\xcf\xb3\x03\xcb\x51\x65\x07\x1a\x70\x99\x5d\x88\x0e\xb1\xf5\x76\xa0\xfa\x8a\x6b\xec\x40\xcd\x2c\xd1\x9a\x7b\x08\x8e\x2a\xee\xb7\x03\xb3\xf9\x87\x99\xa5\x96\xed\xa2\xf7\x13\xd4\x6a\x77\x0c\xb1\x93\x8f\x52\x3a\x1b\xbe\x4c\x22\x1b\x9f\xb4\x03\x75\x0b\x63\xc3\xe5\xfb\x76\x75\x0c\x55\x5e\xd6\x23\x70\x2b\x8d\xf1\x2b\x24\x6e\x5d\xb9\x81\x5f\x11\xc7\xad\x0c\xbe\x91\xdb\x2a\xaf\x16\xb8\xb5\x59\xe0\xd6\x55\x02\xb7\xf8\x1d\x8d\x02\xb6\xae\x11\xb0\xb5\x45\xc0\xd6\x56\x01\x5b\xdb\x04\x6c\x6d\x17\xb0\xb5\x43\xc0\x56\xab\x80\xad\x9d\x02\xb6\x76\x09\xd8\xda\x2d\x60\x6b\x8f\x80\xad\xbd\x02\xb6\xde\x24\x60\xeb\xcd\x02\xb6\xfe\x4d\xc0\xd6\x7e\x01\x5b\x5d\x02\xb6\x9c\x02\xb6\x0e\x0a\xd8\x1a\x10\xb0\x95\x59\xc5\xef\x68\x8c\xa3\x56\x96\x40\xad\x6b\xe3\xa8\x55\xbe\xf1\x5c\xa8\xd5\x26\x50\xab\x5d\xa0\xd6\xbe\x18\x6a\x65\x13\x6a\x75\x24\xa0\x56\x8e\x40\xad\xce\x24\xd4\x5a\xa4\x50\x2b\xaa\x50\xab\x42\xa1\x56\x34\x01\xb5\x72\x05\x6a\x1d\x10\xa8\xd5\x2d\x50\xab\x47\xa2\x56\x9e\x88\x28\x51\xab\x52\xa1\x56\x34\x01\xb5\xaa\x62\xa8\xe5\x4a\x44\xad\xb7\x48\xd4\xaa\x56\xa8\x15\x55\xa8\x95\x2f\x68\x89\x5a\x05\x82\x96\xa8\x55\x28\xe8\x38\x6a\xd5\xc4\x50\xab\x37\x11\xb5\x0e\x49\xd4\x5a\xac\x50\x2b\x9a\x80\x5a\x45\x02\xb5\x0e\x0b\xd4\x1a\x54\xa8\x15\x4d\x40\xad\xda\xb3\x51\xab\x40\xa1\x56\x34\x01\xb5\x96\xc4\x50\xab\x5f\xa0\x56\x9f\x44\xad\xa5\x6a\x18\x46\x13\x50\xab\x2e\x86\x5a\x6e\x81\x5a\x86\x3b\x1a\x13\x40\xab\x9e\x1a\xbd\xda\x53\x42\x13\xf3\x48\x0c\xb4\x96\x4d\x07\x5a\xcb\x05\x68\x1d\x13\xa0\xf5\xd6\x18\x68\x35\xe8\xa0\x75\xdd\x8d\x34\xdd\x8f\x2a\xd0\x8a\x4a\x44\xba\x3e\x0e\x5a\x2b\x74\xd0\x3a\x7e\x63\x52\x94\x13\x71\xd0\xba\x24\x05\xb4\xde\xa6\x72\x6e\x14\xa0\xe5\x65\x02\xb5\x6e\x62\x31\xd8\x5a\xa9\xc3\xd6\xbf\x0b\x70\x7a\x7b\x1c\xb6\x56\xe9\xb0\x75\x03\x13\x41\x37\xb2\x38\x70\x5d\x1a\x03\xae\x9b\x59\x22\x72\xcd\x11\xc8\x45\x4d\x24\x80\xeb\x16\x96\x84\x5c\xb7\x32\x01\x5d\xef\x60\x02\xbb\x2e\x53\xd8\x15\x4d\xc4\xae\xb9\x02\xbb\x7c\x4c\x80\xd7\x3b\x99\x40\xaf\xdb\x98\x80\xaf\x77\x31\x81\x5f\xb7\x33\x01\x60\xef\x66\x02\xc1\xde\xc3\x04\x84\xad\x1e\x96\x10\x16\x55\x10\xd6\x24\x20\xec\x4e\x96\x80\x61\xc5\x89\x18\xf6\x5e\x26\x40\x8c\x25\x81\x98\x5f\x82\x98\x9f\x09\x14\x2b\x11\xc9\xc5\x51\xac\x34\x19\xc5\xfc\x0a\xc5\xee\x60\x02\xc6\xde\xc7\x04\x8e\xad\x89\x0d\xa0\x18\x8e\x95\xbd\xcc\x8f\xd1\x2a\xee\x98\xbe\x8a\x3b\xa6\xaf\xe2\x8e\x89\x55\x5c\xe1\x2b\xfc\x18\x1b\xce\x07\x8a\x5e\xe5\xc7\x45\xd0\x9c\xa2\x6e\xfe\x56\xb1\xe0\x2c\x9a\xe4\x6f\x63\x5f\x10\x1f\xfb\xc9\xa4\xb8\x76\x7a\x94\x37\x5f\xbf\x90\x80\xd1\xc4\xf8\x66\x09\x8c\xef\x67\x1b\xf8\xe6\x38\x32\x3a\xf8\x35\xdc\x56\xf9\x1f\x4c\x40\xe3\x07\x99\xc0\xc6\x0f\xa4\x2c\xea\x86\x98\x80\xc7\x0f\x31\x81\x8f\x77\x31\x01\x90\x1f\x66\x02\x21\x3f\xc2\x04\x44\xde\xcd\x04\x46\x7e\x94\x09\x90\x3c\xc9\x04\x4a\x06\x98\x80\xc9\x53\x4c\xe0\xe4\x3d\x4c\x00\xe5\xc7\x98\x40\xca\x7b\x99\x80\xca\x8f\xb3\x7f\x27\xe7\x93\x4c\x80\xe5\x03\x4c\xa0\xe5\x83\x4c\xc0\xe5\x43\x4c\xe0\xe5\xe7\x98\x00\xcc\xc7\x98\x40\xcc\x79\x29\x0b\xbd\xf9\x02\x32\x3f\xc1\xe2\x98\xd9\x72\xce\x95\x5e\x90\x09\xd0\xfc\x14\x13\xa8\x79\x1f\x8b\xc1\xe6\x02\x82\xcd\xfb\x59\x02\x6e\x2e\x14\xb8\xf9\x69\x96\x04\x9c\x97\xa7\x2c\xf7\xd6\x4e\xb1\xdc\x2b\x13\xc0\xf9\x19\x26\x90\x33\xc4\x04\x74\xfe\x27\x93\xd8\x59\x9e\xb0\xe2\x5b\x37\xc5\x8a\x6f\x7d\x0c\x3b\x3f\xcb\x12\xc1\xf3\x61\x26\xd1\xf3\x8a\x94\x35\xdf\xa2\x84\x35\x5f\x45\xc2\x9a\xaf\x32\x65\xcd\xb7\x21\x86\x9e\x8f\xb0\x44\xf8\xfc\x3c\x93\xf8\xb9\x71\x8a\x55\x5f\x95\xc0\xcf\xc7\x99\x00\xd0\xd3\x6c\x8a\x75\xdf\xa6\x0b\x5b\xf7\x5d\x19\x43\xd0\x47\x99\x80\xd0\x61\x26\x31\xf4\xaa\x29\x56\x7e\x9b\x7b\x74\x0c\xfd\x22\x23\x10\xad\xfc\x02\x8b\x43\xe8\xd5\xd4\xfc\xd5\x67\x98\xc0\xd0\x30\x8b\x81\xe8\x35\xd3\x81\xe8\x16\x01\xa2\x4f\x30\x81\xa2\x5f\x67\x31\x18\xdd\xaa\xc3\xe8\x97\x18\xc1\x63\xe5\x97\x59\xf2\xea\xef\x2b\x2c\x8e\xa4\xdb\x74\x24\xfd\x2a\x4b\x5e\xff\x7d\x4d\x45\x22\x94\xdb\x9e\x82\xa5\x23\x0a\xeb\xea\x76\x08\x30\x7d\x52\x82\xe9\xb7\xe3\x60\xda\xaa\x83\xe9\x37\x24\x64\x7e\x93\xc5\xe1\x74\xa7\x0e\xa7\x4f\xc9\xb0\x6f\x25\xc0\xe9\xae\x18\x9c\x7e\x27\x09\x4e\xab\x53\x16\x82\xa3\xc9\x78\xfa\x5d\x89\xa7\x4f\x4b\x3c\xdd\x3d\xd5\x5a\xb0\x46\xe0\xe9\x7f\x49\x3c\xfd\x9e\xc4\xd3\xef\x4b\x3c\xfd\x81\xc4\xd3\x1f\x4a\x3c\x1d\x93\x78\xfa\x8c\xc4\xd3\x3d\x41\x3d\x2d\x89\xa7\x7b\x69\x4a\x54\x4f\x24\xe2\xe9\x62\xc2\xd3\xca\x1f\x33\xb1\x26\x7c\x4e\xe0\x69\xe5\xb3\x02\x50\x2b\x7f\xc4\x2e\x23\x4c\xfc\x6f\x89\xa7\xcf\x4b\x3c\xad\x4d\x58\x15\x1e\xce\x07\x96\xf0\xbb\x99\xa5\x32\xc2\xae\xcb\x07\x2a\x7f\xc2\x7c\xe4\xfc\x94\xdd\x91\x0f\x54\xff\x8c\xdd\x95\x0f\xd4\xbd\x89\xc6\x6f\xf5\x0b\xec\x5e\xe2\xfd\x9c\x7d\x5a\xe0\xa0\x90\x5d\xca\x4f\x32\x4b\xe5\x2f\xa4\xec\x2f\xa5\x6c\x54\xca\xfe\x4a\xca\xbe\x59\xc8\xbe\x28\x65\x7f\x9d\x28\x5b\xc7\x4f\x31\x4b\xe5\x4b\x52\xf6\xb7\x52\x76\x5c\xca\xfe\x4e\xca\x5e\x2b\x64\x7f\x2f\x65\xff\x90\x28\x5b\xcf\x3f\xc6\x2c\x95\xbf\x91\xb2\xff\x23\x65\xff\x28\x65\xff\x24\x65\xff\x4d\xc8\xfe\x59\xca\xfe\x45\xc9\xd2\xab\xfb\xdb\x02\x02\xb4\xcd\x8c\x6f\x97\xa0\xfd\x57\xb6\x81\x6f\x8f\x83\xf6\x32\xde\xca\x6d\x95\x2f\x4b\xd0\xfe\xbb\x04\xed\xbf\xa5\x80\xf6\x2b\x12\xb4\x5f\x95\xa0\x3d\x29\x41\xdb\xcb\x05\x68\xdf\xc0\x05\x68\xdf\xc8\x05\x68\xdf\xc4\x05\x68\xdf\xcc\x05\x68\xdf\xc2\xb7\x91\x73\x2b\x17\xa0\xfd\x0e\x2e\x40\xdb\xc7\x05\x68\xbf\x93\x0b\xd0\xbe\x8d\x0b\xd0\xbe\x9d\x0b\xd0\xbe\x83\x0b\xd0\xbe\x93\x0b\xd0\x7e\x3f\x17\xa0\x7d\x17\x17\xa0\x7d\x92\x0b\xd0\x5e\x9e\x02\xda\x0d\x02\xb4\xdf\xc5\xe3\xa0\xdd\x7e\xce\x85\xee\xbb\xb9\x00\xed\xf7\x70\x01\xda\xef\xe5\x31\xd0\x5e\x41\xa0\xed\xe7\x09\xa0\x7d\x89\x00\xed\xf7\xf1\x24\xd0\xde\x97\x02\xda\x1d\x53\x80\x76\xa3\x00\xed\x0f\x72\x01\xda\x1f\xe0\x02\xb4\xff\x83\x4b\xd0\x5e\x99\x00\xda\xfb\xa7\x00\xed\xce\x18\x68\x0f\xf1\x44\xd0\xfe\x10\x97\xa0\xdd\x95\x02\xda\xab\x12\x40\xfb\xd2\x04\xd0\xbe\x2c\x05\xb4\x9d\x31\xd0\xfe\x30\x4f\x04\xed\x8f\x70\x09\xda\xdd\x53\x80\xf6\x6a\x01\xda\x01\x2e\x40\xfb\x14\x9f\x02\xb4\x0f\x5c\x18\x68\xf7\xc4\x40\xfb\xa3\x5c\x80\xf6\xdd\x5c\x82\xb6\x6b\x0a\xd0\x7e\x4b\x0c\xb4\x3f\xc6\x05\x68\xdf\xc3\xe3\xa0\x7d\x90\x9a\xbf\xfa\x5e\x2e\x40\xfb\xe3\x3c\x06\xda\xbd\xd3\x81\xf6\x21\x01\xda\x9f\xe2\x02\xb4\x1f\xe0\x31\xd0\xee\xd3\x41\xfb\x13\x9c\x80\xb8\xf2\x93\x3c\x19\xb4\x83\x3c\x0e\xda\xfd\x3a\x68\xdf\xc7\x93\x41\xfb\x7e\x15\x89\x40\x7b\x20\x05\xb4\x3f\xcd\x55\x09\x0e\x0b\xd0\x0e\x71\x01\xda\x9f\xe5\x31\xd0\x1e\xd4\x41\xfb\x41\x2e\x80\xf9\x21\x1e\x07\x6d\xb7\x0e\xda\x9f\x91\x61\xff\xc9\xe3\xa0\xed\x89\x81\xf6\xc3\x2a\x0b\xb9\x06\x6e\x4a\x01\xed\xcf\xf1\x24\xd0\x7e\x84\x0b\xd0\xfe\x3c\x17\xa0\x7d\x64\x2a\xd0\x5e\x23\x40\x7b\x98\x0b\xd0\x7e\x94\x0b\xd0\x7e\x8c\x0b\xd0\x7e\x9c\x0b\xd0\x3e\xcd\x05\x68\x7f\x81\x0b\xd0\xfe\x22\x17\xa0\x7d\x5d\x0a\x68\x1f\xa5\x29\x51\xfd\x63\x9e\x00\xda\xcd\x02\xb4\xbf\xcc\x05\x68\x7f\x89\x0b\xd0\x0e\x73\x01\xda\x67\xb8\x00\xed\xaf\x70\x01\xda\x4f\x70\x01\xda\x2d\x29\xa0\x7d\xb9\x00\xed\xaf\x72\x01\x80\x5f\xe3\x02\x00\xbf\xce\x05\x00\x8e\x70\x01\x80\xd7\xd3\xf8\xad\xfe\x06\x17\x00\xf8\x4d\x9e\x00\x9e\x6b\x05\x68\x3f\x29\x65\x9f\x92\xb2\xdf\x92\xb2\xdf\x96\xb2\xc7\x84\xec\x77\xa4\xec\x68\xa2\xec\x3a\x01\xda\xdf\x95\xb2\xff\x25\x65\xbf\x2f\x65\x7f\x20\x65\x8f\x0b\xd9\x1f\x4a\xd9\xb1\x44\xd9\xf5\x02\xb4\x9f\x96\xb2\xdf\x93\xb2\xcf\x48\xd9\x1f\x49\xd9\x13\x42\xf6\x59\x29\xfb\x9c\x92\xa5\xff\xe6\x18\xe9\xb2\x8c\x59\x7c\x7c\x75\x2e\x03\x96\x32\x60\x25\x03\xd6\x33\xe0\x5a\x06\xb8\x18\x70\x1d\x03\x6e\x62\x80\x9f\x01\x77\x33\xe0\x3e\x26\x3f\x14\xf4\x0d\x06\xfc\x80\x01\x51\x06\xfc\x89\xc6\x2d\x07\xf2\x38\xed\x23\x80\x6a\x0e\x5c\xce\x81\x2d\x1c\xb8\x96\x03\x2e\x0e\x5c\xc7\x81\x9b\x38\xe0\xe7\xc0\xdd\x1c\xa0\x0b\x10\xa7\x39\xf0\x6d\x0e\x3c\xcb\x81\x28\x07\x5e\xe6\x80\xd1\x00\x64\x1a\x80\x0a\xfa\xa6\xb3\x01\xb8\xdc\x00\x6c\x31\x00\xd7\x1a\x00\x97\x01\xb8\xce\x00\xdc\x64\x00\x3e\x60\x00\x08\xb7\x09\xad\xbf\x64\x00\xbe\x6d\x00\x9e\x35\x00\x51\x03\xf0\x27\x03\x60\xd1\x80\x2c\x0d\x28\xd1\x80\x06\x0d\xb8\x5c\x03\xb6\x68\xc0\xb5\x1a\xe0\xd2\x80\xeb\x34\xe0\x26\x0d\xf0\x6b\xc0\x3d\x1a\xf0\x90\x06\x7c\x49\x03\xbe\xad\x01\xcf\x6a\x40\x54\x03\xfe\xa4\x01\xdc\x08\x7a\x53\x4d\xc1\x46\x6d\xab\x8f\x69\x7e\xe6\xb7\xd2\x0f\x15\x1a\x75\xde\x3e\x22\xae\x17\x21\xc3\x8c\xe8\x08\xf7\x19\xb4\x31\x83\x4f\xd3\x22\x9a\xdf\xa8\x45\x8d\x43\x69\xc4\x0e\x9a\xc2\x26\x6d\xd4\x34\x66\x12\x1e\xeb\xb8\x55\x0b\xd9\x87\xed\xe4\xf1\xa6\x07\xd3\xb5\x50\x7a\x38\x9d\x3c\x91\x59\xfe\xd9\xda\xd0\xec\xf0\x6c\xf2\x84\x67\xfb\x32\x34\x7f\x46\x50\xbc\xee\x2d\x98\x31\x91\xa1\xf9\x33\x83\x99\xe4\x09\x15\x44\x0a\x34\x7f\xc9\x50\x09\x79\x26\xaa\xfd\x35\xda\x50\xcd\xc4\x62\x6d\xa8\x76\xac\x56\x7f\xdf\x8f\x78\x81\x0f\x79\x02\xf5\xd1\xe5\xda\xf8\xf2\x50\x83\x36\xdc\x10\x59\x41\x1c\xff\x72\xc1\xf1\x35\x90\xc7\xd7\x10\x0b\x29\x8b\xd7\x72\xc8\xaa\x85\xac\xd1\x46\x9d\xb7\x8f\x88\x78\x2d\xe9\xbd\x1a\x7e\x83\x16\x31\xf8\x35\x2d\xaa\x0d\x19\xb5\x71\x63\x20\x8d\xd8\x21\xd3\x88\x49\x1b\x33\x45\x4c\xc2\x63\x9d\xb0\x6a\xc3\xf6\xb0\x9d\x3c\xbe\xf4\x50\xba\x36\x9c\x3e\x92\x4e\x9e\xe8\xac\xa1\xd9\x5a\x60\xf6\xc8\x6c\xf2\x8c\xcc\xf6\x67\x68\x43\x19\xa1\x0c\xf2\x84\x32\xbc\x99\xda\x50\x66\x28\x93\x3c\xc1\x82\x31\x7a\xd1\x84\xbf\x84\x3c\xd1\x6a\x6f\x8d\xe6\xab\x89\x2e\xd6\xbc\xb5\xe1\x5a\xfd\x05\x0b\xe2\x55\x0a\xe4\xf1\xd7\x47\x96\x6b\xd1\xe5\xc1\x06\x2d\xd4\x30\x26\xea\xe2\x5b\x2e\x38\xde\x06\xf2\x78\x1b\x62\x21\xcd\x29\x7d\x39\xb1\x51\xe7\xed\x23\x22\x5e\xcb\xe6\xa9\xfb\xb2\x39\xb1\x2f\x9b\x13\xfb\xb2\x39\xb1\x2f\x9b\x13\xfb\xb2\x39\xb1\x2f\x9b\x13\xfb\xb2\x59\x0b\x14\x8c\x16\x68\xde\x12\x5f\x09\x85\xa4\xd6\x52\xfd\x50\x95\x7e\x51\x4a\x1e\x6f\xbd\x6f\x99\xe6\x5f\x36\xd1\x24\x7e\xd8\x49\x9c\x90\xe4\x04\x97\xc5\x7e\x4b\x37\xda\x10\x58\x19\xfb\x9d\x5b\x70\x95\xb7\x29\xf6\xc3\x32\xfa\x4d\x59\xec\xf7\x61\xf4\xd3\x30\xb0\xb6\x94\x2e\x0f\x6c\xd2\x79\xfb\x88\x88\x37\x46\xdb\xd4\x5d\xde\x96\xd8\xe5\x6d\x89\x5d\xde\x96\xd8\xe5\x6d\x89\x5d\xde\x96\xd8\xe5\x6d\x89\x5d\xde\x96\xd8\xe5\x74\x57\xdf\x57\xa3\xf9\x6b\xc6\x17\x6b\xbe\xda\x91\x5a\xfd\x92\xbd\xb8\x61\x4f\x1e\x5f\xbd\x7f\x99\x36\xb4\xcc\xbf\x46\x0b\xb5\xc8\x72\x0f\x4b\x4e\x68\x59\xec\xe2\xf0\x58\x43\x68\x65\xec\x52\xef\xc8\xaa\xa1\xa6\xd8\x2d\xda\x50\x8b\x77\x7d\xec\x32\xec\xd0\xfa\xe8\x46\x18\xac\xe3\x8d\x86\xb2\x68\xa3\xa1\x79\x62\xa3\xa1\x2d\xb0\x09\x15\x64\xec\x57\x50\x39\x17\xc0\x5a\xf1\xcd\x29\x60\x1f\x00\x27\x80\x41\x00\x27\x00\xdc\x0a\x80\x3e\xa9\xf3\x11\x00\xf7\x02\x20\xc3\xd4\x67\x01\x7c\x1d\xc0\x7f\x01\xf8\x29\x80\xff\x01\xf0\x67\x00\xf4\x01\x0c\x0b\x03\xc8\x5e\xbb\x28\x01\x72\xaf\x64\xc0\x35\x0c\xd8\xcf\x80\x23\x0c\xb8\x95\x01\x1f\x62\xc0\xa7\x19\xf0\x25\x06\xfc\x90\x01\xbf\x66\xc0\xab\x0c\xb0\x28\xa8\x5d\xc0\x69\xab\x00\x5c\xc6\x01\x5a\x8c\xb6\x72\x60\x1f\x07\x7a\xb9\xfc\x66\xe8\xcd\x1c\xb8\x83\x03\x1f\xe5\xc0\x7d\x1c\x78\x84\x03\x5f\xe2\xc0\xb7\x38\x40\x6b\xf2\x17\x39\xf0\x67\x0e\x18\x0c\x40\x86\x01\x70\x18\x00\x5a\x65\xaf\x34\x00\xeb\x0d\xc0\x95\x06\x60\xaf\x01\x68\x37\x00\xdd\x06\x80\x16\xd4\xc7\x0d\xc0\xdb\x0d\xc0\x6d\x06\xe0\x4e\x03\x70\xca\x00\x7c\xdc\x00\x7c\xca\x00\x3c\x64\x00\x86\x0d\xc0\x17\x0c\xc0\x93\x06\xe0\x7b\x06\xe0\x27\x06\xe0\x45\x05\xc9\xaf\x1a\x80\x59\x1a\x90\xa7\x01\xa5\x1a\x30\x5f\x03\x16\x69\x40\xbd\x06\x5c\xa2\xd1\xbd\x38\x60\xa3\x82\xe9\x37\x69\x40\x97\x06\xf4\x6b\xc0\x31\x0d\xb8\x45\x41\xf5\x5d\x1a\x70\xaf\x06\x3c\xa8\x01\x8f\x68\xc0\x63\x1a\x10\xd6\x80\xaf\x69\xc0\x53\x1a\xf0\xb4\x06\x8c\x69\xc0\xcf\x34\xe0\x97\x1a\x30\xae\x01\x2f\x6b\x80\xd9\x08\x64\x18\x81\xb9\x46\xa0\xdc\x28\xae\x94\x62\xa5\x11\xd8\x68\x04\x76\x1b\x01\x5a\x0c\x1e\x37\x02\x6f\x33\x02\x37\x18\x01\xba\xf6\x74\xa7\x11\xb8\x5b\xbd\xac\xf9\xf3\x46\x60\xc4\x08\xfc\xc0\x08\xbc\x60\x04\x7e\x6f\x04\xfe\x6e\x04\x0c\x69\x80\x29\x0d\x20\xb3\x6f\x01\x99\xdc\xd3\xc8\x46\x06\xac\x48\x03\x2e\x4b\xa3\xeb\x7b\xc0\x96\x34\x60\x6f\x1a\xb0\x2f\x0d\x70\xa5\x01\x87\xd3\x80\x23\x69\xc0\x5b\xd3\x80\x1b\xd2\x80\x77\xa4\x01\xef\x4d\x03\x3e\x98\x06\xdc\x95\x06\x7c\x34\x0d\xf8\x44\x1a\x70\x3f\xbd\x08\x3a\x0d\x78\x38\x0d\x18\x56\x2f\x87\x0e\xa7\x01\x4f\xa4\x01\x23\x69\xc0\x53\x69\xc0\x68\x1a\xf0\xc3\x34\xe0\xf9\x34\xe0\x17\x69\xc0\x8b\x69\xc0\xef\xd2\x80\xbf\x91\xf1\xd7\x04\x58\xe8\x6e\x8e\x09\xc8\x30\x01\x39\x26\x60\xae\x09\x28\x35\x01\x15\x26\xb2\xbe\x01\xf5\x26\xe0\x12\x13\x70\x99\x09\xb8\xdc\x04\x6c\x33\x01\x7b\x4d\x40\x97\x09\x38\x6c\x02\x8e\x99\x80\xb7\x99\x00\x9f\x09\xb8\xc7\x04\xdc\x6f\x02\x3e\x6d\x02\x1e\x37\x01\x23\x26\xe0\x3b\x26\xe0\x7b\x26\x20\x62\x02\x5e\x30\x01\xbf\x33\x01\x7f\xa0\x0f\xca\x98\x81\x5c\x33\x50\x6c\x06\x6a\xcd\x00\xdd\xb9\x59\x69\x06\xe8\x5a\xc6\x16\x33\xb0\xd7\x0c\xec\x53\x2f\xb7\xee\x31\xcb\x6f\x82\xbd\xdd\x0c\xbc\xcb\x0c\xf8\xcd\xc0\x5d\x66\xe0\x63\x66\xe0\x21\xb3\xfc\x7e\x6b\xd8\x0c\x3c\x61\x06\xbe\x69\x06\x9e\x36\x03\x63\x66\xe0\x17\x66\x80\xee\xfe\xbd\x6a\x06\x0c\x16\x20\xd3\x02\x90\x4d\x62\x9e\x05\xa8\xb4\x00\x75\x16\xa0\xc9\x02\x6c\xb6\x00\xdb\x2c\x00\xed\xc8\xda\x2d\xc0\x5b\x2c\x80\xdb\x02\xd0\x7d\x96\x9b\xd5\x8b\xb4\x3f\x6c\x01\x3e\x66\x01\x1e\xb2\x00\x9f\xb5\x00\x5f\xb6\x00\x5f\xb7\x00\xdf\xb2\x00\x74\x1d\xe5\xe7\x16\xe0\xcf\x16\x20\xcd\x2a\x5f\xb4\x5d\x63\x05\x96\x5b\x81\x4b\xad\xc0\x55\x56\x60\x9d\x73\xbf\xab\xa3\xcf\xd1\xd9\xdb\xd1\x77\xc0\x71\x9d\x73\xd0\xed\xea\xef\x73\xd4\x2d\xaf\x5d\x5a\xbb\x02\x4b\x3c\x87\x06\x96\xec\x1f\xe8\xde\x7f\xc4\xd5\xdb\x45\xc4\x12\x4f\xe7\x80\x78\x57\xf8\xe0\x62\x77\x7f\xe7\xc1\xc5\xfb\x07\xba\x6b\x3b\xcf\x8e\x86\xf5\xbb\xd6\x5f\xd3\xba\xa3\xfd\xea\x96\xad\xa0\x77\x9f\xe3\x48\x9f\xdb\x75\xa0\xcf\xd9\xe5\x70\xf5\x79\x70\xd0\x79\xac\x9d\x5e\x3e\x8e\xeb\x3a\x7a\x8f\x38\x25\x79\xa8\xe3\xfa\x76\x67\x9f\x67\xd0\xe5\x74\xe3\x50\xc7\x40\x7b\x77\x6f\xc7\x01\x37\x06\x5c\x7d\x7d\xae\xbe\x03\xe8\xeb\x38\xe4\x74\x0f\x74\x74\x3a\xd1\xd9\xd3\x31\x88\xf6\xf6\x96\xed\xdb\x5b\xf6\xb6\xef\xd8\xf4\xa6\xf5\xed\xad\x7b\xb7\xae\x6f\x6f\xc7\xfe\x81\xee\x76\x12\xec\x72\xc6\x72\xdf\xbe\xe9\x9a\x0d\x97\xef\xbc\x02\xca\x6d\xdf\xbc\x65\x47\x2b\xd6\x6e\xb9\xe6\x8a\x4d\x1b\x70\xc5\xe6\x2d\xbb\x77\xe0\x8a\x4d\x9b\x5b\xd7\x6f\x6f\x5f\xbb\x69\xdd\xf6\x98\x67\xeb\x96\xed\xad\x71\xcf\xf6\x2d\xad\x5b\xd6\x6e\xd9\xbc\x03\x3b\x5a\xae\xde\xba\x79\xd3\x35\x1b\xd0\xde\xeb\xea\x74\xf6\xb9\x9d\x22\x47\xd1\x16\xed\x03\x83\xae\x3e\xcf\x41\x50\xdd\xf4\x62\xf4\xf6\xf7\x1f\x3c\x32\xd0\xee\xec\x75\x1e\x12\x11\xdd\x07\xf7\xb7\x8b\x17\xb7\xef\x3f\xe6\x71\xba\x05\xeb\xa0\xc7\x75\xc8\xd9\x7e\xc0\xe9\x69\xef\x73\xc7\x1b\xa8\xb7\xbf\xef\x80\x78\xc4\x92\x3a\x32\xd0\xd5\xe1\x71\xc6\x93\x22\x89\x81\xc1\x8e\xbe\xae\xfe\x43\xed\x47\x96\xd5\x8b\x78\x03\xce\xc1\xee\x76\xe7\x75\xce\x3e\x4f\x7b\xff\x11\xcf\xc0\x11\x59\x90\x41\x57\xdf\x81\xfd\x47\xba\xdb\x07\x9d\x6e\xe7\xe0\x75\xce\x24\x9e\xfb\xc8\xfe\x43\x2e\x0f\x36\x6d\x15\x55\x6c\xdf\xb4\x35\x4e\xae\xbd\x3a\xc1\xb3\x21\xd1\xb3\x35\x21\x5a\xeb\xda\x38\xbd\x7e\x43\x9c\xde\xba\x33\x4e\xef\x5c\x17\xa7\x37\x25\xd0\xad\x71\x72\xdd\xda\x84\x74\x36\x6d\xdd\xb5\x22\xe6\xd9\xbe\x63\x57\x3c\x64\xc3\xf6\xf5\x31\x7a\xfd\x8e\x38\xbf\x65\x63\x8c\xbc\x3a\x21\xd5\xcb\xd7\xaf\x6f\xdd\x1a\x0f\x5a\x7f\xcd\xda\x96\x78\xe0\xd6\x4d\x57\xc7\xe8\xb5\x5b\x12\xea\xb7\x63\x6d\x42\x12\x3b\xd7\x6d\xdd\xbc\xa9\x35\x9e\xeb\xd5\x5b\x37\xef\x88\x79\xb6\xb7\xec\x8e\x07\xb4\xec\x81\xe8\xb0\x58\x1f\xba\x7b\xfa\x07\x3d\x68\x6f\x3f\x52\xb7\x02\xed\xed\xfb\x9d\xc2\x39\xb2\x62\x79\xbc\x97\xd5\x30\x3e\xd2\x48\x0f\x57\x9f\x67\xc5\xf2\x76\x0f\x62\x44\x4f\x47\x5f\x57\xaf\xb3\x5d\x7e\x8d\x00\xee\x83\xfb\xd1\xeb\xec\xa3\x98\xcb\xea\x31\x70\xd0\xd3\x2e\xa6\xd4\xa1\x8e\xc1\x83\x38\x7c\xc4\x79\xc4\xd9\x7e\xa8\x63\x60\x80\xa6\xc9\xc0\x60\xbf\xa7\xbf\xb3\xbf\x17\xd7\xf5\x76\xf4\xb5\x0f\x50\xb7\xf7\x79\xa4\xc7\xd3\xe9\xd2\xb9\xfd\x9e\x7e\x0c\x0c\xba\xfa\x07\x5d\x9e\x63\x70\xf5\x1d\x18\x74\xba\xdd\xed\xae\x6e\x57\x5f\x97\xf3\x7a\xe8\xae\xa7\xb3\x5d\x12\x9d\xfb\xd1\xd3\xe1\xee\x81\xa7\xb3\xbd\xb3\xb7\xc3\xed\x76\x75\xa1\xab\xc3\xd3\x21\x1e\xed\xce\xbe\x2e\xf4\x75\x0c\xb8\xda\x5d\x5d\xe8\xee\x38\xe4\xea\x3d\x86\x41\xe7\xa1\x7e\x8f\xb3\xdd\x35\xb0\x1c\xbd\xfd\x9d\x1d\xbd\x82\x8a\x31\x57\xc4\x98\x2b\xf4\x98\x03\xd4\x56\x92\x2b\x48\x91\xf0\x21\xa7\xa7\x03\xed\xed\xee\x83\xed\xfb\x8f\x74\x77\xa3\xcb\x35\xe8\xec\xf4\xb8\xfa\xfb\xa0\x86\x2f\x61\x08\xdc\x1d\x5d\x5d\x83\xe8\x12\x4f\xb7\x94\x15\x4f\x59\x45\xd7\x40\xbb\x42\x33\x0c\x74\x74\xa1\xbb\xb7\xff\x68\xbb\x94\xa2\xaf\x1f\xb4\x0f\x76\x78\x9c\xa0\xfe\x72\x75\xbb\x3a\x3b\xfa\x3c\xe8\xec\xef\xf3\x0c\xf6\xf7\x52\x6d\xdb\xd5\x57\x16\xe0\xe9\xd4\x91\x48\x49\x09\xac\x72\x0d\xb4\x53\x7f\xe8\x9f\x62\x90\xdf\x67\x88\x79\xe5\x97\x19\xe0\x1a\x68\xef\x71\x76\x74\x39\x07\xf5\x70\x4f\x67\x2a\xa7\xaf\x07\x03\xfd\x6e\xf4\x74\x0d\xb6\x77\x1e\x19\x74\xf7\x0f\x52\x19\xba\x5d\x07\xd0\x71\xe0\xc0\xa0\xf3\x00\x15\xb0\xd3\xd5\x35\xd8\xde\xed\xea\xf5\x38\x07\xdd\xa0\xca\xc5\x3d\xaa\xaf\x63\x0c\x51\x41\x4f\xff\x41\x67\x5f\x7b\x9f\xf2\xed\x3f\x32\xe8\x16\xe8\x22\x60\x6a\xb0\x5d\x25\xef\xf4\xf4\xc8\x21\xe4\x1a\xd0\xdd\x15\xd0\xdb\x4a\x08\xf6\xee\xef\x4d\xac\x1e\xfa\x9c\xd7\x7b\x7a\xba\x06\xd1\xd3\x3f\xd0\xde\xeb\x22\xdc\x70\xf5\xad\x68\x3f\x82\x23\x2b\xda\xa9\xf5\x1b\x75\xa2\x6e\x85\x4e\x2d\xab\x17\x23\x7f\x59\xbd\x88\x49\x1c\xb8\x06\xae\x5b\x41\x89\x1c\xe9\x1a\x80\xbb\xff\xc8\x60\xa7\x13\x5d\x4e\xb7\x07\x9d\x3d\xce\xce\x83\xd4\xd9\x47\x0e\x91\x7c\xd7\x00\x45\xf2\x74\x0e\xc0\xed\x3c\x8c\x8e\xce\x83\xed\xe4\x0e\x3a\xdd\x75\xe8\xea\xef\xee\x46\xb7\xab\x0f\xee\x63\x7d\x18\x74\x7b\x30\xe0\xee\xa1\x18\x38\x32\x78\x00\x4e\xd2\x0e\x47\x07\x71\xd4\xd5\xd7\xd5\x7f\x94\x58\xed\x03\x1e\x91\x10\xa5\xe7\xf4\xf4\xa0\xa7\x5d\xe4\xd7\xd3\xae\x72\xef\x51\x93\xc1\xe9\xe9\xa1\x28\xae\x01\xb8\x7a\x7a\xe1\xe9\x77\xc3\xd3\xef\x11\xf5\xa6\x61\x3d\xd8\x71\xa0\x9d\xf2\xf5\x78\x7a\xe1\x12\x65\xa3\x6f\x6a\xa0\xb3\xbf\xcb\x89\xce\x83\xee\x23\x87\x84\x5f\x74\x62\xff\xa1\x43\xfd\x34\x4f\xdb\xdb\xbb\x0f\x79\x40\x38\xad\x5a\x7c\xa0\x63\xd0\xed\x6c\x57\xf9\xf4\x74\x0d\x8a\x61\x74\x5d\x2f\x15\x49\x4c\xca\xd6\xb5\x9b\x74\xd2\xd9\xd7\xd9\x31\xe0\x3e\xd2\xdb\xe1\x71\x76\xa9\xf2\x89\x28\x22\x63\xd9\xeb\xed\x2e\xb7\x88\xab\x92\x75\x0d\x88\x66\x4d\x70\x62\x01\x92\xaf\xfb\x55\xd3\xea\x5e\xd5\x32\x2a\x6e\x72\x1d\x54\x95\xe0\x72\xb7\x7b\x7a\xdd\xed\x04\x48\xee\x9e\x8e\x83\x4e\xa8\xd1\xad\xc2\x7a\x3c\x9e\x01\xc8\x01\xa8\xa3\x95\x98\x3e\xfd\x83\x1e\x37\x3a\xe4\x8c\x75\x0f\x76\xa2\xcb\xed\xc1\xa1\x0e\x4f\x67\x8f\xd3\xad\xc6\xab\x92\x72\x43\x29\x4a\x1a\xe8\x10\xc3\x64\x60\xd0\xd9\xed\xba\x9e\x5a\x9f\x78\x62\xca\xaa\x38\x34\xfe\xc5\x24\x80\x52\x89\x34\x56\xd1\xd7\x7f\x14\x6e\x4f\x87\xc7\x0d\x59\x02\x37\xa4\x8a\xed\x76\xd1\xe0\x77\x3b\xe5\x7c\xe8\xed\x48\xf0\x90\x5c\xbb\x94\x71\xf5\xb9\x3c\xfa\xe4\xd6\xf1\xd6\x23\x66\xde\xa0\xb3\xcb\xe5\x89\x89\xf6\xb9\xe1\x3e\xe8\x1a\x18\x70\x76\xc5\x26\x06\x25\x2d\xe7\x99\x90\x27\x00\x3e\xe8\x74\x0e\xe0\xa8\xd3\x75\xa0\xc7\xa3\x12\x80\xdb\xd9\xd7\xd5\x2e\xe6\x1f\x08\xd4\x08\xdd\x04\x86\x53\x05\xe5\x0a\xa0\xcf\x8d\xce\x0e\x09\x29\x22\x5e\x7b\x2c\x9e\x58\x34\x10\xd2\x90\x76\x57\x7a\x7d\xd0\xe9\xd1\x41\x50\x67\x89\xd1\x24\x94\x3f\x7a\xfb\xdd\x1e\xac\xdb\xdd\xde\xd2\xba\xbe\x5d\x57\x36\xed\x75\x2b\x74\x96\x62\xac\x58\x9e\xc2\x68\x3c\x4b\x66\xc5\xf2\xb3\x58\x62\x2e\x0b\x6d\x01\xa7\x74\x74\xe5\xa1\x83\xb2\x33\xd9\x4b\xb7\x5d\xe8\x90\xee\x1f\xf5\x4f\xff\x7a\xed\xd6\x34\xf9\xf5\xda\x11\xb5\xe5\xf5\xa9\x2f\x61\xd1\xce\x81\xfe\x7c\x74\x92\x0e\x60\x9f\xfa\xaa\x67\x48\xb9\x3d\x36\xe9\x9a\x55\x78\x30\x5d\xa5\xa7\xbe\x22\xde\xa3\xdc\x61\xe5\x22\x53\xc5\x57\xae\x4f\x7d\xcd\x76\x69\x8e\x92\x57\x6e\x44\xb9\xcd\xb9\x2a\x9e\x72\x1b\xf3\x54\xb8\x3a\xc6\x1c\x55\x9f\x4d\x9c\x28\x52\xe9\xab\xbf\x0a\x55\xaf\x3d\xaa\x5e\xa3\xaa\x5e\x7e\x55\xaf\x02\x55\x2f\xef\x34\xf5\xda\x97\x52\xaf\x80\xaa\x57\xb3\xaa\xc7\x1e\xe5\x06\x95\x3b\xae\xdc\x09\xe5\x5e\xaf\xea\xe5\x50\xf5\x18\x52\xee\xa8\x72\x97\xaa\xfa\x5c\xaf\xdc\x0a\x55\xaf\x11\x55\xaf\x11\x55\xaf\xf1\xa2\xd7\xd6\x5f\xd7\xab\x72\xef\x51\xf5\x09\x2a\x77\x8f\xaa\x17\x54\xf8\x90\xaa\x57\xe3\x3f\xb8\x5e\x61\x55\xaf\xf1\x52\x95\x9e\xfa\x4a\xbf\x4f\xd5\x2f\x3a\x57\xd6\xaf\x59\x85\xff\xb3\xfa\x71\x9f\x72\x43\x29\xf5\xd4\xc7\xa9\x57\xd5\xb7\x42\xd5\x2f\xa0\xdc\x31\xe5\x36\xaa\x7a\x7a\x95\xbb\x34\xa5\x1f\xcd\xea\x2b\xcd\x50\xf5\xf4\x2b\x77\x42\xd5\x77\x4f\xac\xbe\x27\x7f\xcd\x62\xdf\x14\xeb\xd5\xe4\xff\x8f\x96\x28\x86\x3c\x61\x17\x9f\x61\x2b\x02\x34\xbf\xfa\x9e\x9f\x41\x05\xce\x56\xb4\x43\x7d\x97\x8c\x68\x32\xee\x14\x2b\x9a\xce\xac\xaa\x15\xed\x05\x5d\x70\x94\x74\x00\x40\x8b\xa2\xc9\x56\x73\xa5\xa2\x23\xea\xac\x8b\xe2\x50\xa6\x6d\x8a\x76\x30\xa0\x4f\xd1\x64\xe7\xbd\x4e\xd1\xfb\x18\xf0\x76\xf1\xab\x2f\xc0\xcb\x80\x1b\x15\xdf\xc1\x81\x77\x28\xba\x99\xcb\x73\x32\x11\x9f\x03\xff\xa1\x68\x2f\x97\xe5\x20\x7e\x80\x03\x41\xc5\xa7\x6b\xe4\x0f\x29\x3a\xc2\x81\xc7\x14\x4d\x8f\x27\xd4\x77\x6b\xe9\xcc\xea\x3b\x8a\x0e\x18\x80\x67\x54\x1c\x87\x06\xfc\x5c\xd1\x74\xae\x34\xae\xe8\x7d\x1a\xd4\x57\xe7\x61\xd6\x54\xdd\xe8\xa9\xa9\xb6\x53\xff\xf4\x6c\xe8\xc7\x2d\xa2\x4e\xf6\x0b\x88\xa7\x06\x24\x25\x69\xa7\xc9\x36\x4f\x7d\xf7\xb8\x2c\x59\x96\x55\x4a\xbe\xcd\x3c\x4d\xfc\x4f\x14\x0a\xd7\x96\x3e\x4d\x78\x6e\x91\x70\x6d\xf6\x69\xc2\x9f\x9e\x2b\xc3\x69\x4c\xec\x2d\x16\x6d\xa3\x11\xca\x3b\x8b\xe3\x65\x7c\x8b\xa2\xa9\xce\x6e\x45\xd3\x78\xf9\x77\x45\xd3\x78\xb9\x4d\xd1\x34\x5e\xde\xaf\x68\xea\xa7\x8f\x14\x03\xd9\x6a\xbc\x7c\x82\x06\x18\xc0\x28\x5f\xb3\xde\x9a\xaa\x5d\xb2\x54\xbb\x10\xf7\x7e\x19\x6f\x56\xa6\x6a\xf2\x47\x52\xfc\x5f\x4d\xf1\x3f\x9d\xe2\x7f\x26\xc5\xff\x7c\x8a\xff\xa5\x14\xff\x5f\x53\xfc\xd6\x92\x64\xff\x54\xe5\xa4\xb6\xc9\x56\xf1\x8a\x55\xbc\xb9\x25\xc2\xa5\xa6\x84\x3e\x15\x29\xde\xc2\x04\xbe\x9a\xe8\x02\x99\x97\x26\xf0\x15\x40\x08\x7e\x73\x02\x5f\x01\x85\xe0\xb7\x26\xf0\x15\x20\x0a\x7e\x77\x02\x5f\x01\x86\xe0\x5f\x9f\xc0\x57\x0a\x42\xf0\xdf\x93\xc0\xd7\x31\x84\xf8\x1f\x4a\xe0\x2b\x60\x12\xf1\xef\x4f\xe0\x2b\x20\x12\xfc\x84\xed\x22\x2d\xa5\xfe\xbf\xda\x27\xd3\x31\x90\xbe\x4e\x13\x47\x6c\x53\x9d\x90\xd1\x39\x02\x85\xa9\xaa\x40\x6e\xe7\xbb\x5d\xce\xc1\x25\x3a\xeb\x02\xcf\xfb\x5c\x7d\x1e\x87\x12\xa9\x70\x7b\x06\x8f\x74\x7a\x1c\xb1\xc6\x77\x54\xb9\x0f\xee\xaf\x84\xc3\xe1\x70\xd0\x71\x93\x3c\x1a\xab\x98\x9f\xfc\x75\xc5\x6b\xfb\xe6\xd7\x38\xdc\x07\xf7\x2f\x6e\xd2\x9b\xa7\xf2\x52\x21\x72\x5d\xbf\xab\xcb\x51\xa5\x33\x1d\xab\x1d\x15\x92\x53\x59\x41\x27\x2c\x95\x49\x22\xa9\x12\xd3\xc7\x96\x31\xc5\xf9\x89\xe3\xa0\xf3\x98\x63\xb5\x63\xa9\x64\xa9\x8f\x24\x4e\x71\x68\x57\x51\x2e\x0f\x0a\x6b\x1c\xe5\x07\x9d\xc7\x54\xe9\x54\x5d\xf5\xa3\x04\x95\xd6\x09\x11\x46\xff\xd5\xaa\x51\xe1\x58\x2d\x2b\xa7\xbc\x35\x53\xb4\xab\xdc\x67\xd5\xf6\xc0\xe2\xea\x76\x54\xf4\xf5\x2c\x6e\x1a\xe8\x77\x3b\xaa\x1d\x6a\x73\xe8\x68\x72\xe8\xd5\xac\x84\x45\x15\x73\xde\xbc\x0a\xb5\x65\x75\xac\x5e\x2d\x5a\xb7\xc7\xd3\xdf\xe7\xae\x58\xdf\xba\xb1\x7d\x6b\x7b\xe3\xd2\xfa\xba\x6d\x95\x8e\xb7\xbe\x15\x16\x91\xe6\xbc\xa4\xbd\xa2\x2e\x59\x29\xbb\x46\x3f\x02\x70\xac\x76\x24\xee\x4d\x2b\xca\xfb\x7a\x6a\x62\x39\xd7\x38\xca\x9d\x9e\x1e\x55\x77\x4a\x32\x2e\x75\x76\xf6\x9b\xb6\x56\x56\x3a\x4e\xc8\xea\xd0\x56\xd4\x51\xed\xa8\x4b\xaa\x85\xde\x48\xed\xed\x34\xba\x3c\xae\xbe\xf6\x43\xce\x43\x9d\x03\xc7\x2a\x0e\x3a\x8f\xd5\x8a\xa3\x9c\x1a\x47\xb9\x6b\x60\xc5\xe2\x26\xe5\xa9\x5b\x51\x79\x69\xac\xee\x94\xe4\xe2\x26\x75\x0e\x71\xe9\xb9\x13\xeb\x4a\x4c\x4c\x79\x44\x62\xba\x94\x6b\xa0\xbd\xd7\xd9\xe7\x58\xfd\xff\xd8\xbb\x1f\x38\x37\xae\xf2\x5e\xf8\xcf\xee\x68\x66\x47\xfe\xab\xb5\xbd\xb6\x6c\xaf\xb3\xc7\xf6\xda\x91\x13\x5b\xd6\xda\x89\xa3\x04\x3b\x91\xe3\xd8\x91\x83\x6b\x2b\xb1\x43\x15\x48\xbb\x4a\xec\x75\xe4\x62\x3b\xaa\xd7\x06\x71\xbd\x6b\x04\x34\x54\xb7\x4d\xa8\xb8\x10\x10\x6f\x43\x11\x69\x42\x75\x69\xa0\x82\xc0\x8b\x4a\xd3\x54\x94\x00\x6a\x09\xf7\xaa\x24\x10\x01\x81\x2a\x9f\x84\xa2\xe4\xc2\x5b\x85\xbf\x02\x02\x79\x3f\x67\xf4\x8c\xf6\x9c\xdf\xae\x71\x72\x81\x5e\xb8\x75\xbc\xb0\xfa\x9e\x39\x73\xe6\xcc\x99\x91\xce\x3c\x8f\x66\x25\x81\x49\x1a\x71\xb1\xb3\x5f\xc7\x4f\xde\x9e\x1c\x0f\x38\xab\x2b\x59\x10\xa5\x01\xf5\xcc\xfe\x05\xdf\x13\xea\x9c\xe6\xee\xa0\xf1\xea\x5c\x5d\x4b\xf1\x05\xc6\x5f\x7b\xeb\x7a\x71\xcd\xee\x1b\x76\xee\x38\xb0\x7b\xdf\xde\xd1\xdd\x7b\xaf\xbd\x61\xe7\xfe\xfd\xeb\x45\x48\x8e\x40\x67\x44\xa7\x0f\xa8\xd7\x3d\x57\xb6\x89\x23\xa9\xe4\x86\x2b\x8f\x24\x8f\x8a\x8b\xc4\x25\x53\xbd\xc4\x9e\xea\x5f\x5a\xea\x74\xee\x48\xea\x17\xef\xd5\x0c\x5f\x5e\xaa\xed\xd4\x7a\xd1\x3d\xfa\x67\xdf\xee\xf4\x2f\x47\xe5\x6d\xbb\xe3\x32\x75\x90\xe5\xf3\x80\x5f\x9c\xcf\x71\x90\xa7\xce\x98\xee\x09\x73\xc9\xba\x73\xac\x33\x75\x62\x74\xcf\x0b\x77\x1d\xb9\x98\x9f\x5a\x6e\xc7\xa6\xce\x7b\x2e\x90\xa7\xbd\x9b\xf4\xbd\xf1\x9a\x58\xf7\x74\x9f\xe1\xd0\xc8\xb5\xe4\x77\xb9\x6e\x15\xa1\x75\x34\xd3\x98\xe8\x5f\xf6\xea\x8c\xc7\xa9\x43\xa9\x0d\x57\x9e\xfd\x3c\x9b\xe1\xeb\x60\x9d\xd5\xf4\xf2\x73\xac\xac\x7c\x6d\xac\xb6\x72\xa7\x5c\x59\x59\x5f\x20\xb6\x39\xc7\x39\xba\x67\xe7\x5e\x71\xf1\xb4\x67\xcd\xd4\x5a\x4a\x96\x54\x6c\x13\xc7\x8e\x1c\x0f\x40\x3b\x17\x77\xb7\xd8\xe9\xee\x7a\xe1\xbc\xaf\xb2\xd3\x79\x2b\x47\xd9\xbc\x1c\xbf\x23\xe3\xa3\x87\x8e\x8f\x07\x9c\x61\xe9\x64\xec\x78\x8c\x64\x16\xcf\x79\xb1\x71\x6b\xcf\xbc\xe9\xc0\xe8\xe8\xa9\x2d\x97\x74\x66\x81\xa3\x63\xc7\xd7\x8b\x6b\xf6\xee\x1f\x9d\x79\x73\xf2\xe8\x1f\x92\xb3\xaa\xd8\x36\xb5\x09\x7d\xf1\xb8\xba\xb8\xd3\x9d\xa9\x0a\x4a\xce\x58\x6c\x83\x5d\x14\x57\x0a\xf9\x8a\x7e\xfb\x61\x77\xaa\xec\xa4\xe1\xe4\x33\x5b\x9e\x00\xdb\x44\x72\xc3\x95\x32\xb1\xd9\x79\xee\x3a\x27\x94\x3b\x8d\x09\x79\x66\xc9\x4a\xea\xb9\xe5\x6e\xf3\x2c\xcf\x32\xe5\x4b\x80\x67\x7a\xf9\x99\x14\x63\x47\xc7\xc7\xce\x76\x56\xef\xde\xf1\x3b\x31\x31\x31\x21\xce\xb2\xe8\x55\x5b\xd4\x51\x7f\x09\x23\x3e\xf3\x68\x9f\xa5\xe7\xa7\x0e\xfd\xc2\x9e\xbf\xdc\xb3\x52\x5c\x2c\xa6\xa5\xdc\xa7\x5a\xe2\x01\xe8\x0c\xee\x4b\x59\x55\xdd\x73\xe5\xec\x3c\x79\x50\x39\x3b\x4f\x1e\x3c\xdb\xd9\x39\xc3\xee\xaa\xdf\xcc\x7c\xb6\xfd\x55\x8e\xd6\xb4\x93\x2a\x24\xd6\xae\x15\x98\x9f\xed\xcc\x24\xfa\x30\x75\x3b\x23\xbb\x3d\xfd\xbd\xc8\xce\x2a\x9d\xaa\xeb\x65\x87\xd6\xbb\xe7\x6b\xf2\xd0\x89\x75\xeb\x9c\x97\x30\x6e\x80\x7b\xee\x9c\xed\x4e\x96\x36\xe0\x54\x5f\x75\xed\xce\x03\x62\x95\xbc\xf4\x98\xbe\x28\xb6\x6f\xff\x01\x67\x51\x77\x9f\xa6\x57\xb9\xf1\xac\x6b\x47\x77\x6e\xbf\xe6\x1c\x6b\x5f\xb3\x73\xcf\xce\xb3\x6d\x7b\xfb\x81\x1d\xe7\x58\x7b\x5f\xec\xc0\xee\xb3\xac\xbd\x63\xdf\xde\xbd\xe7\x58\xfb\xc0\x0d\xdb\x77\x9c\x65\xed\xe8\x81\x03\xb1\x55\x2f\xf3\x48\xca\x6c\xfa\x2f\x3c\x80\x2f\xe3\x99\x27\xb7\x3f\xc3\x8b\x9d\x7b\x04\x0f\x9d\x78\x4d\xe8\xf7\xe4\xd3\xfb\xc0\x9e\xfd\xa3\x3b\xf6\xed\x3d\xb0\x73\xef\x81\xd1\xe8\xf6\xbd\xd7\xec\x8f\x6e\x7f\xe5\x4e\xd9\x9f\xe4\xa1\x13\xaf\x19\x71\xaa\x84\xd2\xa1\xcd\xaf\x78\xb9\xdb\x97\xed\xce\xfc\xec\x3f\xc7\x6b\x25\x5f\x99\xcb\x9f\xee\xbb\x6f\xf2\xca\x5e\x6e\x21\x2c\x2e\x5a\x77\xf2\x60\x6a\xdd\x6b\x0e\xec\x88\x8d\xee\xda\xb3\xfd\xda\xfd\xa3\xfb\x76\xed\xda\xbf\xf3\xc0\xef\x4d\xad\xc4\x6f\xe3\xc9\x55\xa6\xd6\x5f\x2b\x02\xee\x2a\xa3\xfb\x6f\xda\x2b\x26\x44\x97\xbb\x76\x6b\xbc\x61\xff\x81\x75\xeb\xc4\xca\x6e\x84\x80\x2f\xff\xca\xf3\x7d\xe6\xe9\xa3\xfb\x1a\x30\xb5\x58\x79\x97\x51\x6c\xd3\xf6\x7f\x62\xc2\xed\xef\xd4\xc5\x46\xe7\x2d\x23\x39\xf0\x7b\x6f\xdc\xb3\xc7\x3d\xf4\x53\x4b\x36\x5c\x89\x6f\xfd\xa9\xa7\x47\x67\x94\x3a\x6f\xbd\x88\x6d\x33\x47\x36\x78\x6f\x43\x27\xc6\xe1\x76\x95\x23\xe5\xb4\xe5\x5e\x14\x71\x93\x2b\x3b\xdd\x12\x57\x75\x37\x72\x85\x3b\x54\xb2\x8b\xe3\x27\x0e\xca\x9e\xf3\x16\x76\xc6\x77\xec\xb9\xf1\x9a\x9d\xf2\xe9\x75\x68\xfc\xe4\xf4\x05\x33\xed\x9c\xfa\x8e\xe7\xb4\x1d\x73\x9a\x17\xbc\x33\xb2\xa6\xbc\x14\xdc\x70\xe5\xd4\x5b\xbd\xeb\x65\x4c\xc6\x17\x84\xca\x9e\x04\xbb\x6f\x2a\x89\x6d\x22\x2c\x2e\x16\x81\xa9\x55\x64\xb7\x2e\x11\x57\x89\xcd\x9b\xc4\x15\x62\x64\x53\x78\xdd\xfa\xee\x05\xa1\x9c\x74\x9d\xe7\x89\x98\xaa\xae\x45\x82\xee\x3b\x54\x5a\x24\x38\xed\xd2\x73\x6d\xb7\xad\x91\xdf\x5b\x2f\x20\x1e\x79\x19\x07\xcc\xb9\x4d\x45\x0b\x48\xf9\xe9\x7c\xee\x63\xd3\xdd\x92\x73\x1c\x5e\xc2\x08\x1e\x52\x46\x50\x3b\xf7\x94\xf7\xa0\xcf\x72\x78\xba\xef\x00\x8a\xab\xdc\x0d\x49\x76\x36\xe4\x3c\x8f\xd6\x4d\x75\xcc\xb9\x49\x82\x87\x6f\x2a\xf4\x72\x2a\xbd\xec\xf1\x71\xee\xdc\xd1\xc6\x47\xf6\x7c\xa5\xf6\xfe\x24\xef\x48\xa7\x56\x37\x06\x11\x2b\xf5\x0b\x1d\xf9\x02\x78\x96\x45\xaf\xda\xa2\xcd\xa7\xfc\x6c\x75\x87\x7e\xed\x5a\xe1\x8e\x54\xf7\x4d\x7c\x75\x98\x94\x37\x2e\x03\x9d\x1e\x4c\xbd\x64\x76\x5f\xae\xb8\xf3\xce\xe5\xab\x38\x7e\xfb\xeb\x79\xb7\xd5\xfb\x85\x02\xeb\xb4\xf3\x70\xea\x0d\x4d\x71\x91\xf3\xbe\xe6\x59\x47\x4a\xde\xf6\xe4\x1c\x62\x6e\x40\x8e\x10\xaf\xa1\xbf\xe0\xcc\xdc\xba\x7c\xbb\x54\x4b\x79\xb8\x5b\xe1\x1d\xc3\xad\xc8\x90\xfe\xf8\x91\x93\xeb\xc5\xd5\xb1\x5d\xa3\x7b\xf7\xed\x8c\xef\xde\x7f\x80\xb7\xec\x6e\x7d\xa5\xf2\xf2\xe8\x6e\x7c\x74\x74\xfc\x0d\xc7\x0f\x8e\x1e\x1e\x3b\x79\x30\x39\x7a\xcb\xf1\x43\xa3\xb7\x1c\x3a\x14\x58\xeb\x74\x61\xc3\x95\xfc\x36\xef\x7a\x31\xb2\xee\x15\xe7\xae\xed\xbc\x19\xbc\x5e\x4c\xc5\x55\x5c\xae\xbe\x21\x2c\xb6\xc9\x61\xd6\x16\x4f\xcd\x1d\x13\xdb\xa6\x8e\xcc\x2b\xf0\xb8\xf3\xab\xb4\x7c\x85\xe3\xa2\x0d\x57\x2a\x37\x98\x88\xad\xdb\xc4\x88\x7a\x52\x68\x37\x69\xc8\x57\x9e\x50\xf7\x6c\xd2\x06\x9b\xdf\x50\xee\x1c\xcd\xb1\xb3\x1d\x4d\xf7\x9e\xb4\x99\x0e\xe8\xd8\x4c\x07\x54\xfe\x0b\x76\xdf\xd9\x16\xdb\xf4\x7e\xb9\xb7\x8b\xac\x9f\xf9\x04\x70\xfb\xf4\xd2\xce\x01\xad\x6f\xe7\x38\x0d\xfe\xb7\x76\x52\x9d\x47\xd7\xae\x15\x2b\x9d\x46\xa6\x12\x36\xe3\x63\x63\xc7\xd5\x5d\x9f\x61\xb1\xd8\x26\x46\xba\x4f\xb5\xcd\x9b\x44\xe7\x8d\x7b\x99\xbc\xeb\xd4\xe5\x37\xfc\x65\x74\x3f\x75\x6e\x28\xe5\xdd\x8b\x04\x67\xcc\x95\xa3\xee\x1c\x57\xde\xb6\x7b\x53\x97\xe0\x37\xf3\xe5\x85\x89\x5b\xd6\xbd\x5e\x72\x9b\x77\x46\x5b\xbf\x09\x40\x74\x1f\xa8\x43\x1e\x74\x6f\x1d\x98\xf1\xb5\x61\xfd\xb4\xf4\xab\xd3\x26\x5f\xb7\x39\x97\xf7\x32\x05\xc0\x39\x00\xe5\x6a\x4e\x39\x26\xf2\xc7\xdd\x29\xb9\x82\xd8\xba\x55\x6c\xde\xb4\x4e\x4c\x38\xc7\x70\xd7\xe8\x8e\x1b\x6f\xb8\x41\x5e\x34\xee\x88\xdd\xd8\xd9\x9c\x4c\x0a\x9f\x70\x82\xb2\x19\x6f\x48\xec\x5c\xd8\xae\x9d\xba\x31\x94\xd7\x3a\x2c\x02\x72\xad\x95\xca\x90\xcd\xdc\xf9\xc3\xb7\x1c\x39\x3a\x76\xa8\x1b\x2d\x9d\xe8\xa6\x2b\x94\x4b\x6b\xbe\x98\x72\x1b\xd2\x97\x4c\x7b\x72\x5e\xd9\x79\x6e\xce\x70\x4f\x65\x60\x9d\x58\x23\x66\x5a\x45\xed\xe6\x0c\xad\x6b\x4f\x6f\xb7\xde\xd4\x6b\x79\xe7\x99\x37\x75\x82\x4d\x3d\x13\x2f\x16\x01\xf9\x42\xbf\xc1\x5d\xc2\xf7\x9c\x28\xc7\x43\x9e\x63\xbc\xfe\x95\x33\x3f\x6d\xd5\xed\xe9\xcd\xa8\xaf\x6f\xd0\xd6\x56\x71\xce\xbe\x4f\xeb\xec\x36\xde\x91\xa9\x06\xcf\xfe\x0a\x3c\xf5\x74\xe9\xbc\x5e\x8f\x4d\x7b\xef\xa1\x7b\x2f\xc9\x49\x31\x76\xce\xb7\x14\x5e\x4a\xf6\x74\xa7\x92\x3c\x3d\x72\x5c\xbf\x25\x65\xfa\x7b\x1e\xdd\x45\xca\x9b\x1a\x6e\xd9\x2f\xdb\x93\x6e\x1e\xd7\x9d\xa9\x94\x53\x86\xcf\xaa\xce\x53\x6b\x9b\x96\x06\xe3\xb1\x9f\xf9\xe5\xe0\x22\xe7\x46\x1f\x7e\x96\xc1\x0d\xbe\x81\xb5\xfa\x9d\xcf\xdd\x48\xfe\x22\x77\xf5\x75\xe2\x62\xa7\xac\x93\x5a\x76\xcf\x05\x6e\x72\xfa\x7c\x31\xc3\x5b\x26\x53\x67\xf3\x45\xf2\x66\xa3\xb3\xbd\x6a\xab\xf7\x5c\x6b\x97\x64\xee\x36\x9d\x95\x57\xea\x9b\x3c\xfb\xf0\x60\xea\x8e\x57\x70\x3a\xbe\xe1\x4a\xbe\x7d\x6a\xaa\x93\xdd\x41\xea\xee\x38\xbf\xc2\x2a\xad\x76\x02\x57\x65\x3b\x9d\xd1\x52\x86\x45\xed\x03\x47\xe9\x67\x4b\x9f\x84\xd6\x8b\xce\x26\x2f\x16\x23\xfa\x2b\xaa\x36\x19\x9c\x7d\x07\xa7\xc5\xeb\xe2\x34\x8d\x69\x27\xe3\xf4\xe7\x4c\x77\xc9\xd4\x73\xe7\x57\x75\xe6\xba\xcf\x21\x79\xe2\xba\xc7\x57\xde\x59\x2f\xf3\x71\xbf\xfc\xfd\xfb\x53\x93\xc0\x7f\xd0\xad\xfa\xee\xef\x63\xb7\xa4\xc6\x37\x72\xb3\xce\x63\xa5\x27\xaa\xdd\xee\x38\x65\xdc\xbe\x13\x7d\x69\x25\x9d\xcd\x6b\x25\xdd\x3e\x74\x4a\xe5\xd5\x76\x67\x15\x6d\xc7\x9c\x92\x6e\x2f\xf9\xfe\x19\xf7\xde\x8c\x6b\xf8\x7f\x9e\x5d\x44\xd1\x5d\x7c\xc3\x87\xfc\x43\x6d\xbe\x47\xc2\xbd\x01\xea\x43\x4b\xa7\x2c\x6f\x8c\x5a\xb3\x6c\xca\xf3\x88\xe8\xa9\xc1\x29\xfb\xf8\x7f\x23\x3d\x44\x2f\x72\x99\xfc\xe8\x74\xf9\x31\x9f\x44\x5e\xe7\xde\x1d\xe9\xb7\xf5\x10\x99\x45\x8b\xa2\x6c\xf7\xbf\x18\x2c\x4f\xf0\xf2\x4f\xf5\x10\xad\x7b\xc0\xa2\x24\x2f\xff\x5e\x0f\xd1\x9a\x92\x45\x69\xf6\x0a\xf9\xe9\xb4\xeb\x0d\xca\x42\x7b\x39\x6e\x4f\xfe\x0d\xd8\x9c\x90\x41\x65\xf6\x68\x2f\xd1\x80\xbf\x8f\xe4\x1f\x84\x4b\x9f\xee\x25\x5a\x3a\xd8\x47\x3e\xb6\x5c\xbe\xdc\xdf\xe7\xbc\xdf\x7f\x4f\x2f\xd1\x27\x7a\x89\xe6\xdd\xdc\x43\x01\xc5\xd6\xcd\x3d\xce\xdf\xa6\xdd\xd3\xfd\xdc\xfc\xce\x3d\x3e\xd2\x5f\xed\x25\x5a\x64\xf7\x50\x4a\x71\xd0\xee\xa1\x2c\x7b\x96\x41\xd4\x97\xeb\xa1\x22\xac\x5f\x87\xf5\x9b\xb0\xbe\xdd\xab\xaf\x1f\xea\xd5\xd7\x4f\xf4\xea\xeb\xa7\x14\xcb\xf5\x73\xb0\x7e\x19\xd6\x6f\xc2\xfa\x6d\xc5\x72\x7d\xbf\xa1\xaf\x1f\x31\xf4\xf5\x93\x86\x3e\xfe\x69\xf6\x3a\xf9\xf7\x73\xeb\xfb\x28\xc7\x7e\x8d\xfc\xf3\xe3\x6b\xfa\xa8\xc8\xeb\xbf\xd5\x20\x9a\xdd\xea\xa1\xb2\x62\xab\xd5\x43\x55\xae\x2f\xef\x5b\xf2\xe6\xfb\xa8\xc6\xcb\xbf\x6c\x10\xcd\xb7\x7b\xa9\x0e\xcb\xfd\x9e\x8e\xbf\x23\x7d\x4f\x1f\xa5\xd8\xab\x3d\x44\xab\xdf\xd9\xe7\xdc\x01\x28\x97\xcb\xbf\x9b\xf3\x5e\xd2\xe7\xdc\x8c\xa5\xf6\xd7\xc7\xfe\x4b\xf9\x77\x8d\xb6\x97\x02\x66\x67\x7b\xf2\xef\xe5\x66\x85\x7a\x29\xac\xd8\x0a\xf5\x52\x84\xfd\xbc\x87\xa8\x7f\x6b\x2f\x45\x15\x0f\x6c\xed\xa5\x38\x5b\x9e\x2f\xf3\xa2\xbd\x94\x54\x6c\x45\x7b\x29\xc3\xdb\x9b\x65\x12\xcd\x8d\xf5\x51\x11\xfa\x53\x82\xe5\x55\x5e\x7e\x85\x49\xe4\x2d\xf6\x39\x77\x38\x4a\xbf\x59\x2e\x9f\xe8\x73\xfe\x16\x4e\xf6\xf7\xef\x4c\xf9\xf1\xcb\xbd\xce\xdf\xc4\xc9\xe5\x5f\x94\xf5\x0f\xf5\x39\x7f\x13\x27\x6d\xc9\x0f\xd4\x4e\xf6\x51\xd3\xd2\xb7\xd7\x62\x5f\x21\xff\x5e\xef\x63\xf2\x53\x95\x3b\xde\x2f\xeb\x3f\xd4\xe7\xdc\xe9\x2a\xdb\x3f\x65\xc9\x0f\x3f\x37\x48\x28\xb6\xa2\x06\x85\xd9\x77\x4a\x1f\x32\x28\xc1\xeb\xcb\xbf\xd9\xf3\x36\xfb\x28\xd7\xa7\x6f\x2f\x0f\xcb\x2b\xec\x47\xa5\xbf\xdd\x47\xb6\xad\xd7\xf7\xd9\xfa\xf2\x00\x5b\xfe\x0d\x9f\xb7\xd5\x47\x69\xf6\x2e\xf9\x71\xc3\x8d\x3e\xca\xc2\xfa\x39\xa8\x5f\x64\x4f\xf4\x11\x2d\xf9\x7e\x1f\x55\xd8\x9f\xe9\x23\x5a\xdb\xee\xa3\xaa\xe2\xa5\xed\x3e\xaa\x2b\x9e\xdb\xee\xa3\x16\x5b\xfe\x3d\xdf\xf2\x17\xfa\xc8\xf6\x76\x7c\x89\x4d\xb4\xd0\xb6\xc9\xc7\xde\x2f\xed\xb1\x49\xb0\x4f\xda\x44\x6b\xe7\xd8\xce\x1d\xb5\xdd\xf1\xbc\xcf\xa0\x94\x62\xeb\x3e\x83\xd2\xec\x8f\xda\x44\xb3\x4b\x06\x65\x14\xfb\x4a\x06\xe5\xd8\x9f\xb5\x89\xfa\x3f\x66\x50\x41\xb1\xf5\x31\xc3\xb9\x53\x57\xf6\xa7\x69\x13\xcd\x1d\xb4\xa9\xc5\x5e\xe5\x25\xf2\x67\x6d\x6a\x2b\x0e\x64\x6d\xe7\x4e\xde\x6e\x7f\x5e\xe8\x25\x9f\x62\xeb\x85\x5e\xf2\xcb\xbf\xe3\x93\x1f\x7b\x20\xff\x8e\xf0\x3e\x9b\xb2\xec\x7b\xbd\x44\x73\x1f\xe9\xa3\xf2\x2c\x7d\xbc\x1b\xec\x27\xbd\x44\xab\xb7\xda\xd4\x64\xaf\x9d\x45\x34\x37\x62\x53\x0b\x1c\x9a\x3d\x55\x7f\xcb\x56\x9b\x22\x6c\xe7\xf8\xec\xb1\x29\xce\x96\x1f\x6d\xba\x34\x66\x53\x42\xf1\xdc\x98\x4d\x19\xb6\x73\x3c\x0e\xd8\x54\x64\xbf\x5d\x2e\xcf\xd9\xe4\x9b\xc3\xe7\xcf\x2c\xa2\x0b\xe3\x36\x85\xe6\xe8\xfd\x0d\xb3\xe5\x87\x9a\x78\xe3\x26\xc5\xc1\x49\xa8\x9f\x52\x97\xdf\x69\x52\x0e\x9c\x67\x8f\xca\x2f\xd9\xb9\xc7\xa4\x96\xe2\x0b\xef\x31\x29\x30\x97\x5f\xbf\x64\xfd\x82\x49\x09\xc5\xc3\x05\x93\xf2\xec\x6f\xc8\xe5\xf7\x99\x54\x53\x3c\x7c\x9f\x49\xf6\xbc\x8e\x07\xe7\xc8\xd7\x03\x93\x22\x8a\x87\x8b\x26\xa5\xd9\xaf\x96\xcb\x1f\x30\xa9\xa4\x78\xf8\x01\x93\x9a\xec\x77\xcd\x21\x5a\x94\xb0\xa9\xc5\xfe\x57\xf9\x69\xd0\x87\x6c\xf2\xcd\xef\x58\x7e\x4c\xc8\x9c\xa4\x49\x7e\xb6\xfc\xf0\xed\x45\x72\xfc\xd8\xa3\xf2\x43\xeb\x6e\xb6\xa9\xc4\x2e\xcc\x25\x5a\x3b\x61\x53\x8d\xfd\xcf\x73\x89\x06\x8e\xda\xd4\x60\xbf\x38\x97\xe8\x82\x8c\x4d\x4d\xc5\x7b\x33\x36\xb5\xd9\x37\xcc\x23\x5a\x96\xb2\x9d\x8b\x09\xd7\x0b\x53\x36\xd9\xec\x71\xb9\xfc\xa4\x4d\x3e\xc5\x0b\x4f\xda\xe4\x67\xff\xb7\x79\x44\xab\xff\xc8\xa6\x08\x5b\x9e\xef\x66\xd6\x76\xee\xb8\x97\xae\xcc\x23\xf2\x96\x0c\x8a\xb3\xbf\x3a\x8f\xa8\xff\x21\x83\x12\x8a\xbd\x0f\x19\x94\x64\xff\x7c\x1e\x91\xbf\x62\x38\x77\x7c\x4b\x5f\x37\x9f\x68\xf1\x23\x06\x95\x14\x5f\xf8\x88\x41\x65\xf6\xe4\x7c\xa2\xa1\x3b\x0d\x6a\xb0\x3f\x31\x9f\xa8\xbf\x6e\x50\x53\xb1\x57\x4e\x5e\xfd\x1d\x3f\x31\x9f\x68\xcd\x93\x06\xd9\x6c\xf9\x99\xba\xfe\x6b\x0c\xf2\xb3\x5f\xe9\x23\xea\xdf\x63\x90\x60\x1f\xf1\x11\x2d\x8f\x18\x14\x52\x3c\x10\x31\x28\xc2\xbe\xc3\x47\x64\xc6\x0c\x8a\x2e\xd0\xcf\xd7\x18\xfb\x41\x1f\xd1\xa2\x03\x06\x25\xd9\xf2\xce\xfc\x39\x71\x83\x52\x8a\x57\xc4\x0d\xca\xb0\x97\xf6\x13\xad\x69\x18\x94\x5d\xa0\xf7\x2f\xbf\x40\xef\x5f\x61\x81\xde\xbf\xe2\x02\xbd\x3f\xd9\x85\x7a\x7f\x73\x0b\xf5\xe5\xb4\x48\xef\xaf\xbd\x48\xef\xaf\x58\xa4\xf7\x37\xa0\x58\xf6\x37\xc4\x96\xe3\x3f\xf7\x4e\x83\xa2\x8a\xe5\xf1\xc8\xb1\x5f\xd5\x4f\xd4\xff\x7d\x83\xf2\x8a\xbd\xdf\x37\xa8\xc8\x7e\x5d\x3f\xd1\xa2\xb6\x41\x0d\xf6\x47\xfb\x89\x66\x27\x0d\x6a\x41\xff\xda\xec\xc7\x64\xfd\xa3\x06\x89\x01\xa5\x7f\x29\x83\x02\x8a\x57\xa4\x0c\x8a\x0c\xe8\xed\xc5\x06\xf4\xf6\xe2\x03\x7a\x7b\x29\x68\x2f\x0d\xed\x65\x07\xf4\xfd\xcd\x2b\x96\xfb\x5b\x62\xfb\x16\x10\x79\xcb\x36\x35\xd8\xc7\x17\x10\xad\x7e\xc4\xa6\xa6\x62\xef\x23\x36\xb5\xd8\xef\x5d\x40\x24\xaa\x36\xb5\xd9\x5f\x58\x40\xd4\x3f\xec\x21\xdf\x62\x7d\xb9\x9f\xfd\xbc\xac\x1f\xf0\x50\x88\xbd\x69\x21\x91\x77\xbd\x87\xc2\xec\x57\x2e\x24\x5a\x15\xf2\x50\x8d\x7d\x5c\x2e\x8f\x79\xa8\xcd\x7e\x60\x21\xd1\xdc\x47\x6d\xf2\x2f\xe9\xb8\xba\x90\xc8\xbc\xd9\x43\x82\xfd\x43\xe9\x84\x87\x02\xec\xe1\x45\xf2\x9b\x7d\x3c\x14\x62\xef\x58\x44\xb4\x38\xe9\xa1\xf8\x12\xbd\xbd\x24\xfb\xf0\x22\xa2\xe1\xac\x87\x52\x8a\x2f\xcd\x7a\x28\xad\x38\x92\xf5\x50\x46\xb1\x37\xeb\xa1\x1c\xdb\xd9\xff\x77\x7a\xa8\xc4\x7e\x68\x11\xd1\xea\xbc\x87\xea\x6c\x63\x40\x5e\x6f\x7a\xa8\xc1\x1e\x1c\x20\x5a\x75\x9f\x87\x5a\xec\x3d\x03\x44\x6b\x0a\x1e\xf2\xfb\xf5\xe5\x21\xf6\x09\xb9\x7e\xc9\x43\x71\xf6\x83\x03\x72\x3e\xf7\x50\x8e\x2d\x8f\xd3\xc0\xa3\x1e\x2a\x28\x16\x8f\x7a\xa8\xa8\xd8\xfb\xa8\x87\xca\xec\x25\x8b\x89\x56\xd4\x3c\x54\x61\xcb\xe3\xb0\x7c\xbd\x49\x35\xf6\xe8\x62\xa2\x45\x21\x93\xea\xca\xf2\xd5\xeb\xe5\x07\xdc\x77\xfc\xfa\xc5\x44\xde\x86\x4d\xbe\xa5\xfa\xf9\x29\xd8\xef\x58\x4c\x74\x61\xd8\xa2\x00\xdb\x89\x9f\xae\xb1\x28\xc4\x7e\x78\x31\xd1\xda\x88\x45\x31\xf6\x37\x17\x13\x0d\xec\xb1\x28\x0e\xcb\x7d\xcb\x3a\x9e\xbb\x84\xc8\x3c\x64\x51\x72\x99\xbe\xbd\x14\x2c\xcf\xf2\xf2\x6b\xe5\xa7\xa5\x56\x4c\x2a\x28\x5e\x59\x31\xa9\x04\xeb\x97\xd9\x13\x4b\x88\xe6\x3f\x64\x52\x93\xfd\xd1\x25\x44\xde\xaa\x49\x6d\xf6\x67\xa5\x1f\x35\x29\xb1\xbc\xe3\x1f\x2c\x21\xf2\x3d\xee\xa1\x14\x7b\xbe\x9f\xc8\xff\xa4\x87\xd2\x8a\x03\x4f\x7a\x28\xa3\xf8\xe2\x27\x3d\x94\x53\xfc\xca\x27\x3d\x94\x57\xbc\xfd\x49\x0f\x95\x15\xfb\x9e\xf4\x50\x85\x7d\x4c\xb6\xff\x8c\x87\xaa\xdc\xbe\xb4\xef\x19\x0f\xd5\xd8\x77\xfb\x89\x2e\x6f\x7a\xa8\xa1\x38\xd4\xf4\x50\x4b\xf1\x50\xd3\x43\x6d\xc5\xeb\xe4\xe7\x33\x0d\xf2\xfc\xe2\x27\xba\xe0\xdb\x1e\xb2\xd9\xe6\x52\x22\xbf\xcf\x24\x9f\xb2\x7c\xee\xb7\x3d\x14\x60\xaf\x5e\x2a\xaf\x6f\x3d\x14\x65\x5f\xbf\x94\x68\xd9\x1c\x93\x62\x83\xfa\xf8\x26\xd8\x27\x97\x12\x79\x07\x4d\xfa\xd0\x52\x3d\xee\xff\x07\x79\xc3\xbe\xff\x37\x3f\xee\xf7\x43\xdc\xaf\xc6\xf9\x32\xee\x0f\x2b\x96\x71\x7f\x04\xe2\xfe\x64\x8f\x1e\x37\xa7\x15\xcb\xb8\x39\xd7\xa3\xc7\xcd\x25\x58\xbf\x01\xeb\xb7\x14\xcb\xf5\x7d\xbd\xfa\xfa\xe1\x5e\x7d\xfd\x64\xaf\xbe\x7e\x5a\xb1\x5c\x3f\x0f\xeb\x57\x60\xfd\x16\xac\x4f\x86\xbe\xbe\x30\xf4\xf5\xa3\x86\xbe\x7e\x8a\xe3\x70\xf7\x5f\x06\xe2\xfe\x3c\xc4\xfd\x25\x88\xfb\x2b\x8a\x65\xdc\x5f\x83\xb8\xbf\x0e\x71\x7f\x03\x96\x0b\x88\xfb\xd3\x10\xf7\xe7\x20\xee\xb7\x21\xee\xf7\xb3\x9f\x5a\x4a\x64\x0a\x2f\x85\x20\xee\x8f\x40\xdc\xaf\xc6\xf9\x32\xee\x8f\x29\x96\x71\x7f\x02\xe2\xfe\x94\x62\x19\xf7\x67\x21\xae\x2f\x41\x7f\xca\xb0\xbc\x06\x71\x7f\x1c\xe2\xfe\x32\xc4\xfd\x15\x88\xfb\x6b\x10\xf7\xb7\x2c\x7d\x7b\x6d\x88\xfb\x6d\x88\xfb\xfd\x10\xf7\x07\x14\xcb\xb8\x3f\x02\x71\x7f\x12\xe2\xfa\x7c\x9f\xbe\xbd\x02\x2c\xaf\xf6\xe9\x71\xbd\xcf\xd6\xeb\xfb\x6d\x7d\x79\x08\xe2\xf8\x0c\xc4\xfd\x39\x58\x3f\x0f\xf5\x4b\x10\xf7\xab\x71\xbe\x8c\xfb\x6b\x8a\x65\xdc\xdf\x50\x2c\xe3\xfe\x36\xc4\xfd\x3e\x88\xfb\xfd\x10\xf7\x07\xfe\x2f\x8b\xfb\x33\x10\xf7\x97\x20\xee\xaf\x43\xdc\xdf\x80\x38\x1f\xf3\x00\x01\x88\xfb\xc3\x10\xf7\xc7\x20\xee\x57\xf3\x00\x32\xee\x4f\x43\xdc\x5f\x80\xb8\xdf\x86\xb8\x3f\x30\x47\xef\x6f\x08\xe2\xfc\x18\x38\x01\xf5\x93\x10\xe7\x67\xcf\x92\x07\x70\xe3\xfe\xa6\x62\x19\xf7\x0b\x8e\xe3\xdd\xb8\x3f\xae\x58\xc6\xfd\x39\x88\xfb\xab\x8a\x87\xef\x93\xc9\x3d\x3d\xee\x0f\x2b\x96\x71\x7f\x0a\xe2\xfe\xa2\x62\x19\xf7\x37\x20\xee\x6f\x42\xdc\x6f\x43\xdc\xef\x83\xb8\x3f\x00\x71\x7f\x11\xe2\xfe\x2a\xc4\xfd\x75\x88\xfb\xd5\x3c\x80\x8c\xfb\x5b\x10\xf7\xab\x79\x80\x85\x4a\x1e\xc0\x8d\xfb\xd5\x3c\xc0\x42\x25\x0f\xe0\xc6\xfd\x21\x88\xfb\x23\x10\xf7\x47\x21\xee\x8f\x41\xdc\x1f\x87\xb8\x3f\x0f\x71\x7f\x41\xf1\x85\x8f\x4c\xe5\x05\xdc\xb8\xab\x06\x71\x7f\x5d\xb1\x8c\xfb\x5b\x3e\x3d\xee\x6f\xfb\xf4\xb8\xda\xee\xd7\xe3\x6a\x5f\xbf\x1e\x57\xab\x79\x80\x01\x25\x0f\xe0\xc6\xd1\xe1\x05\xfa\xf9\x1a\x59\xa0\xc7\xd1\xf1\x05\x7a\x1c\x9d\x50\xbc\x42\xc9\x03\xb8\x71\x7f\x1a\xe2\xfe\xec\x02\xbd\x7f\xb9\x05\x7a\xff\xf2\x0b\xf4\xfe\xa4\x21\xee\xcf\x40\xdc\xdf\x5a\xa8\xf7\xb7\xbd\x50\xef\xaf\x6f\x91\xde\x5f\xbf\xe2\x15\xf1\xa9\xbc\x80\x1b\x07\x87\x15\xcb\xe3\x91\x81\xb8\x3f\xab\xd8\xab\xe4\x01\xdc\xb8\xbf\x06\x71\x7f\x63\x91\xde\xbf\xe6\x22\x3d\x4e\xf7\x41\x9c\xee\x57\x2c\xe3\xf4\xd0\x80\xde\x5e\x64\x40\x6f\x2f\x3a\xa0\xb7\x97\x80\xf6\x92\x8a\x57\x28\x79\x00\x77\x7f\xd5\x3c\x80\xdc\xdf\x02\xc4\xfd\x35\x88\xfb\xeb\x8a\xbd\x8f\x4c\xe5\x05\xdc\xb8\xbe\x09\x71\x3f\x2d\xd6\x97\xdb\x10\xf7\x0b\x88\xfb\x03\x10\xf7\x57\x20\xee\x6f\x2e\xd6\xe3\x74\x82\xb8\xdf\x86\xb8\xdf\x07\x71\xbf\x1f\xe2\xfe\xc8\x12\xbd\xbd\x28\xc4\xfd\x31\xc5\x97\x66\xa7\xf2\x04\x6e\xdc\xaf\xe6\x09\xbc\x4a\x5e\xc0\x8d\xfb\xf3\x10\xf7\x57\x20\xee\xaf\x42\xdc\x5f\x87\xb8\x9f\x20\xee\xf7\x43\xdc\x1f\x81\xb8\x3f\x03\x71\xbf\x9a\x07\x90\x71\x7f\x5e\xb1\x57\xc9\x03\xb8\x71\x7f\x09\xe2\x7e\x37\x0f\xe0\xc6\xfd\x55\x65\xb9\x8c\xfb\x5b\x7e\x3d\xee\xa7\xa5\xfa\xf9\xe9\x83\xb8\xdf\x0f\x71\xbf\x80\xb8\x3e\x02\x71\x7f\x14\x96\xdb\x10\xd7\x27\x96\xe9\xdb\x4b\xc2\xf2\x0c\xc4\xfd\x79\xc5\x32\xee\x2f\xc2\xfa\x25\x88\xfb\x1b\x10\xf7\xb7\x20\xee\x8f\x43\xdc\x9f\x84\xb8\x5f\xcd\x03\x04\x20\x0f\x20\xe3\xfe\xac\xe2\x57\x42\x1e\x40\xc6\xfd\x25\xc5\x3e\x25\x0f\xe0\xc6\xfd\x6a\x1e\xc0\xa7\xe4\x01\xdc\xb8\xbf\xae\x58\xc6\xfd\x4d\xc5\x43\x90\x07\x58\xa7\xe4\x01\xdc\xb8\x9f\x20\xee\xb7\x21\xee\x17\x10\xf7\x47\xd8\x6e\xdc\x1f\x1d\xd4\xc7\x37\x0e\x71\xbf\xbc\x27\x60\x33\x2f\x97\xf5\x22\xcb\xe4\x45\xd5\xf9\xf7\xfb\xcf\xbf\xdf\xff\xdb\xf1\x7e\xff\x6b\x97\x11\x99\xe1\xf3\xef\xf7\x9f\x7f\xbf\xff\xd7\xfb\x7e\x7f\x1c\xde\xef\x4f\xc2\xfb\xfd\x29\x88\xfb\xd5\x3c\x80\x7c\xbf\x3f\x0b\x71\x7f\x5e\xb1\x7c\xbf\xbf\x08\xef\xf7\x37\x21\xee\x57\xf3\x00\xf2\xfd\x7e\x82\xf7\xfb\xd5\x3c\x80\xc5\x79\x00\x35\xee\x4f\x43\xdc\x5f\x9c\xa5\x8f\x77\x0d\xe2\xfe\x3a\xc4\xf9\x98\x07\x10\x10\xf7\x87\x20\xee\x8f\x42\xdc\xaf\xe6\x01\x64\xdc\x9f\x82\xb8\x3f\x0f\x71\x3f\x41\xdc\x2f\xe6\xe8\xfd\x0d\x40\x9c\x1f\x3d\xcb\xfb\xff\xee\xbf\x04\xc4\xf9\x99\xb3\xe4\x01\xdc\xb8\xbf\x01\x71\xbf\x1f\xe2\xfe\x98\x62\x19\xf7\x67\x21\xee\xaf\x28\x96\x71\x7f\x7b\xae\x1e\xf7\x87\x20\xee\x4f\x42\xdc\x5f\x50\x2c\xe3\xfe\x3a\xc4\xfd\x0d\x88\xfb\x09\xe2\x7e\x1b\xe2\x7e\x01\x71\x7f\x01\xe2\xfe\x0a\xc4\xfd\x35\x88\xfb\xd5\x3c\xc0\x5e\xe5\xfd\x7f\x37\xee\x57\xf3\x00\x0b\x95\x3c\x80\x1b\xf7\xab\x79\x80\x85\x4a\x1e\xc0\x8d\xfb\x03\x10\xf7\x87\xcf\xc7\xfd\xe7\xe3\xfe\xf3\x71\xff\xf9\xb8\xff\x2c\x71\x7f\x42\xb1\x57\xb9\x3f\xc0\x8d\xfb\x73\x10\xf7\x97\x21\xee\xaf\x2c\xd1\xe3\xfa\x1a\xc4\xfd\x2d\x58\x6e\x43\xdc\x1f\x82\xb8\x3f\x09\x71\x7f\x5a\xb1\x78\x54\xcf\x0b\x78\x95\xbc\x80\x1b\xf7\xe7\x21\xee\x77\xf3\x02\x6e\xdc\xaf\xe6\x05\x64\xdc\xdf\x80\xb8\xbf\xe5\xd7\xcf\x4f\x82\x38\xdf\x86\x3c\x80\x0f\xe2\xfc\xd0\x52\x3d\x6e\xcf\xb2\xdd\x7f\x39\x58\x5e\x64\xcb\xf7\xf1\x7d\x29\x8b\xca\xec\x2f\x2c\x23\x1a\x6e\x9a\xd4\x64\x6f\x5e\x2e\xaf\xff\x4c\x6a\xb3\xf7\x2c\x27\x9a\xdf\x32\xc9\x5e\xa6\xb7\xef\xde\x4f\x70\x70\xb9\xbc\xbe\x33\x29\xc0\xfe\xcb\xe5\xf2\xfa\xcd\xa4\x30\xd4\x8f\xb2\xff\x61\x39\x91\x2f\x6d\x51\x6c\x99\xbe\xfd\xf4\x32\x7d\xfb\xd9\x65\xfa\xf6\xf3\xd0\x5e\x01\xb6\x5f\x86\xed\xd7\xd8\x32\x5e\x5f\xb4\xc8\xea\xe6\x25\x64\x3c\x3e\x6f\x8e\xd5\x7d\x7f\x5d\xc6\xe3\x0b\x7d\x56\xf7\xfd\xf5\xeb\x06\x89\x96\xfa\x2d\x4a\x2a\xde\xe6\xb7\x28\xad\x78\xd0\x6f\x51\x5e\xb1\xd7\x6f\x51\x91\x5d\x18\x24\xf2\x65\x2c\x2a\x0d\xea\xfb\x57\x1f\xd4\xf7\xaf\x39\xa8\xef\x5f\x7b\x50\xdf\x3f\x5a\xa1\xef\x9f\x7f\x85\xbe\x7f\x01\xb6\xfb\x2f\xbc\x42\xdf\xbf\xfc\x05\xfa\xfe\x95\x2e\xd0\xc7\xa3\x72\x81\x5e\xbf\x7a\x81\xbe\xbf\x35\xc5\x72\x7f\xdb\x8a\xe5\xfe\xda\x43\xfa\xfe\x85\x86\xf4\xfd\x8b\x0c\xe9\xfb\x17\x1b\xd2\xfb\x1b\x1f\xd2\xf7\x2f\x35\xa4\xef\x5f\x66\x48\xcf\x13\xe5\x86\xf4\x3c\x51\x5e\x71\xe0\x49\x0f\x15\x14\xcb\x3c\x51\x49\xb1\xcc\x13\x95\x15\xcb\x3c\x51\x5d\xb1\xcc\x13\x35\x86\xf4\x3c\x51\x53\xb1\xef\x19\x0f\xb5\x86\xf4\x3c\x51\x5b\x71\xa8\xe9\x21\x5b\x4c\x59\xe6\x89\x7c\x8a\x65\x9e\xc8\x2f\xf4\x3c\x91\x10\x7a\x9e\x28\xa0\x2c\x97\x79\xa2\x88\xd0\xf3\x44\x09\xb6\x9b\x27\x4a\x0a\x7d\x3c\xd3\x42\xcf\x13\x65\x61\x79\x5e\xe8\xc7\xbb\xbd\x52\x3f\x3f\x7c\xab\xf4\xf3\x43\xac\xd2\xeb\x07\x56\xe9\xe7\x47\x48\xb1\x3c\x3f\xe2\x8a\xe5\xf9\x91\x5c\xa5\x6f\x3f\x0d\xed\xd5\x57\xeb\xdb\x6f\xad\xd6\xb7\x4f\xc3\x7a\x7d\x7b\x58\xdf\xbe\x4f\xb1\xdc\x7e\x58\xb1\xdc\x7e\x64\x58\xdf\xfe\x53\x83\x44\x1b\xd8\xb2\xdd\x9f\xca\x0f\x9a\x8c\x9e\xbf\x5f\xe6\xfc\xfd\x32\xbf\x1d\xf7\xcb\xac\x59\x21\xaf\xe3\xcf\xdf\x2f\x73\xfe\x7e\x99\xf3\xf7\xcb\x9c\xbf\x5f\xe6\xfc\xfd\x32\xff\xb7\xde\x2f\xa3\xe6\xc9\xfa\x95\x3c\x99\x9b\x37\x4b\x40\xde\x4c\xcd\x93\x2d\x56\xf2\x64\x6e\xde\xac\xe4\xd3\xf3\x16\x6a\x9e\xac\xbf\xae\xff\xdd\x8c\xb7\x3e\x95\x27\x73\xf3\x66\xd4\xaf\xe7\xa5\x7c\xfd\x7a\x5e\xca\xdf\xaf\xe7\xa5\x02\x8a\x65\x1e\x2a\xdc\xaf\xe7\xa1\x22\x0b\xf4\xf3\x35\xba\x40\xcf\x43\xa9\x79\xb2\x39\x71\xfd\xef\x66\x56\xc4\xa7\xf2\x64\x6e\xde\x2c\xb3\x40\xef\x5f\x6e\x81\xde\xbf\xfc\x02\xbd\x7f\x85\x05\x7a\x7f\x32\x0b\xf5\xfe\x66\x17\xea\xcb\xdb\x0b\xf5\xfe\xd2\x22\xbd\xbf\x6a\x9e\x6c\x8e\x92\x27\x73\xfb\x1b\x58\xa4\xe7\x91\x22\x8a\x87\xee\xd4\xf3\x64\xfd\xdf\xd7\xff\x6e\x46\xe6\xcd\x0a\x90\x37\xab\x43\xde\xcc\xcd\x93\xb9\xff\xb5\x16\xe9\x79\x2e\x35\x4f\x36\x27\xa5\xff\xdd\x8c\xcc\x73\x85\x07\xf4\xf6\xa2\x03\x7a\x7b\xb1\x01\xbd\x3d\x35\x4f\x36\x27\xa5\xff\xdd\x8c\x6c\x2f\x33\xa0\xef\x6f\x4e\xb1\xdc\xdf\x22\xe4\xcd\xd4\x3c\xd9\x6a\x25\x4f\xe6\xe6\xcd\x9a\x03\x7a\x5e\xac\x05\x79\x33\x7b\xb1\xbe\xdc\x07\x79\xb3\x00\xe4\xcd\xdc\xbf\x9b\x71\xf3\x66\x55\xc8\x9b\xb5\x16\xeb\x79\x2e\x1b\xf2\x66\x3e\xc8\x9b\xf9\x21\x6f\x26\x20\x6f\x16\x5d\xa2\xb7\xa7\xe6\xc9\x86\x21\x4f\x76\x29\xe4\xc9\xfe\x23\xee\x97\x69\xc3\x72\x1f\xe4\xcd\xc2\x90\x37\x4b\x41\xde\x4c\xcd\x93\xc9\xbc\x59\x56\xb1\x57\xb9\x7f\xc6\xcd\x9b\x15\x20\x6f\xe6\xe6\xc9\xdc\xbc\x59\x19\xf2\x66\x4d\xc8\x9b\xb5\xfd\xfa\xf9\x69\x43\xde\xec\x6c\xf7\xcf\xb8\x79\xb3\x30\xe4\xc5\x72\x4b\xf5\xf6\xf2\xb0\xbc\x04\x79\xb3\xca\x52\x3d\xef\xd1\x5a\xaa\xe7\x3d\x68\x99\x9e\xf7\xf0\x2d\xd3\xdb\xf7\x2f\xd3\xf3\x1e\xa1\x65\x7a\xde\x23\x02\xf5\x63\x90\x37\x8b\x2f\xd3\xb7\x9f\x59\xa6\x6f\x3f\x07\xdb\x2f\x40\x7b\x45\xd8\x7e\x05\xb6\x5f\x5f\xa6\xc7\xe1\xad\x65\x7a\x1c\x9e\x18\xd4\xe3\xf6\x14\xe4\xcd\xd4\x3c\x99\xcc\x9b\x65\x15\x0f\x2a\x79\x32\x37\x4e\x2f\x43\xde\xac\x32\xa8\xef\x5f\x73\x50\xdf\xbf\xf6\xa0\xbe\x7f\x36\xe4\xc1\x7c\x2b\xf4\xfd\x0b\xac\xd0\xf7\x2f\xba\x42\xdf\x9f\xf2\x05\xfa\xfe\xd4\x2e\xd0\xf7\xbf\x71\x81\xbe\x3f\x4d\xc5\x72\x7f\x7c\x43\xfa\xfe\x88\x21\xbd\xff\xd1\x21\xbd\xff\xf1\x21\xbd\xff\xc9\x21\xbd\xff\xa9\x21\xbd\xff\xd9\x21\xbd\xff\x79\xc8\x8b\x15\x21\x2f\xa6\xe6\xbd\x02\x90\xf7\x92\x79\xb1\xaa\x62\x99\x17\xab\x29\xde\xfe\xe4\x54\x9e\xcb\xcd\x8b\xb5\x21\x2f\x46\x42\xcf\x8b\xa9\x79\xaf\xcb\x21\xef\x15\x6a\x4e\xe5\xb9\xdc\xbc\x58\x00\xf2\x62\x21\xc8\x8b\x85\x21\x2f\x16\x81\xbc\x58\x1c\xf2\x62\x6e\xde\xcb\xcd\x8b\x65\x84\x3e\x9e\x39\xc8\x8b\x95\x84\x7e\xfc\xc5\x2a\xfd\xf8\x87\x57\xe9\xc7\x3f\xba\x4a\x3f\xfe\x31\xc5\xf2\xf8\xa7\x57\xe9\xc7\x3f\xbf\x4a\x6f\xdf\x1e\xd6\xdb\x17\xc3\x7a\xfb\xa1\x61\xbd\x7d\x35\x8f\x25\xdb\x4f\x28\x96\xed\x27\x87\xf5\xfd\x93\x9f\x13\xf3\xe2\x8b\x2f\xbe\xe8\x21\x9b\xec\x89\xd9\xdd\xef\x79\x71\xff\xa5\x06\xbb\x97\x2d\xda\xbf\xe4\x59\xca\xd3\xc3\x33\x97\x17\xb8\xfc\xe6\xd5\xf2\xa3\x3c\x6c\x3a\x22\xf7\xb1\xa7\xe7\xa7\xf3\xe6\x52\x4f\x8f\xf3\x8d\x3b\xf2\xc3\x6c\x7a\x7a\xe4\x77\x69\x77\xbe\xc5\x46\x7e\xfa\xcd\xa5\xf2\x6b\x6d\x7a\x86\x7a\xe7\x9b\x17\xcc\x8f\xcb\x05\x77\xad\x79\xdd\xef\xbe\xff\x75\x6f\x5b\xfb\x57\x6b\xc6\xaa\x9b\x9e\xd8\xb0\x55\x7e\x4d\x13\xfd\x7e\xff\xdb\x43\xa7\x97\xfe\xdb\x1f\x0d\x1e\xfb\xe7\xef\x5e\xb5\x73\xfd\x9b\xee\x9a\xa9\xe6\xbd\x72\x13\xe2\x47\x97\xd7\x5b\x37\x7e\xe3\xc6\x3b\xbd\x5f\xdf\xb0\xff\xef\x6f\x7a\xe2\xaf\x88\xa8\xf7\xaf\xdf\x3d\xeb\x6d\xcb\xf6\x1e\x7b\x2c\x90\xfd\x93\xbb\xbe\x71\x64\xc3\xee\xff\x57\x6e\xf4\x5f\xec\x8f\xff\xf8\x8a\x93\x9f\x31\x7d\x4f\x7c\xe5\xa1\x59\x0f\x1e\xfb\xf1\xc3\xb2\xf0\xe2\xef\x7e\x78\xff\x67\xee\xec\xf9\xc8\xfb\xff\x36\xfc\x8a\xeb\xef\x5e\x70\xf3\x17\xe4\x86\xf6\x2e\x7e\xd3\xe9\xb7\x26\x6e\x7a\x6c\xe4\x83\xfd\x17\x9c\xb9\x70\xdf\xc7\xbe\x2c\x37\xb4\x73\xff\xd3\x91\x8b\xe2\x7f\xf1\xd8\xd1\x4a\xb0\x54\x5c\x7f\xf7\xca\x27\x65\xe1\x97\x36\x06\xe6\x5d\x6f\xbf\xef\xe7\x3f\xda\xf9\xce\x3f\xf3\xdc\xbb\xee\xae\xa7\x65\xe1\xa6\xef\xfc\xf3\xe8\x7f\xfb\xe2\xd1\x2f\x66\xff\x78\x47\xff\x1f\xdc\xff\xd0\xbf\x3c\x2b\x0b\x1f\xf9\xaf\xef\x1f\xfc\xab\xbf\xf9\xdc\x0b\xdf\xfa\x48\xe5\xde\xcb\xea\x6f\xda\x23\xbf\x71\xbd\xe7\xcf\xb3\xa7\x3f\xf5\x78\x79\xc3\xd3\x9f\x7c\x63\xcf\x25\x6f\xff\x1f\x5f\x5c\xf7\x03\x59\x78\xed\x7f\x39\x33\xf2\xc3\x55\x47\x3f\x73\xb4\x79\x2c\xfd\xf0\x25\xb1\xd7\xc9\x0f\xf8\xe9\x79\xe0\x0d\x8f\xfb\x16\x1d\x7e\x38\xfe\xfe\x1b\x33\x1f\xf8\xd6\x03\x3d\x17\x7a\x7a\xc9\xdb\xcd\x69\x19\x15\x4f\x8f\x69\xce\x32\x9e\x3d\xb3\xd5\x24\xcb\xf8\xf2\xc4\x61\xd3\x34\x3e\x68\x08\x73\x9d\xb5\xd1\x5c\xb3\xd2\x32\x1e\x9f\x10\xe6\x6c\xcb\xf8\x7c\x8f\xb0\x8c\x8f\x9f\x11\xe6\x1c\xcb\x78\xb4\x27\x68\x19\x1f\x3b\x73\x9d\x39\x60\x19\x9f\x35\x84\xb9\xf4\x7d\xe6\xf2\x21\x4f\x9f\x39\xcf\x78\xfb\xa4\x30\x2d\x2b\x68\x92\xf1\xdf\xdf\x28\xcc\x45\x96\xf1\x8f\x74\xd8\x32\x1e\x7e\xa3\x30\x83\xc6\x3f\x92\x90\x8f\xe4\x6f\xb3\xcf\x32\x56\x09\xcb\xb8\xe7\x8d\xc2\x78\x86\x64\xf5\x7b\xce\x59\x3d\xe8\x56\xdf\xfa\x1f\x51\xdd\xd3\x6b\xfa\x2c\xe3\xf3\xc6\x49\xd3\x7b\xb5\xa7\xcf\x9c\x6d\xfc\xf5\xe4\x56\xb9\x67\xc6\x1f\x39\xcb\xbc\x96\xf1\x6f\x86\xf0\xf4\x99\xf3\x8d\x7b\x26\x9d\x02\xe3\x99\x5e\xf1\x16\x73\xf5\x07\x2d\xe3\xcf\x27\x84\xe9\xb5\x8c\x2f\x18\x41\x39\xa0\x0f\x4e\x3c\x63\x9a\x96\xf1\x8f\x9e\xa0\x65\x3c\x7c\x3a\xe8\xe9\x33\x67\x59\xc6\x9d\x3d\x9d\x61\xea\xb7\x56\x9b\x03\x96\x30\xe7\x59\x23\xd2\xc6\xff\x77\x46\xb6\x35\xd7\x32\x1e\xef\xac\xfc\xc0\x44\xdc\x9c\x6b\x3c\x6e\x08\xe3\x81\x89\xa0\x6c\xf4\x39\x43\x58\xc6\xbb\x26\x9e\x31\xe7\x5a\xc6\xbf\x1a\x41\xcb\x78\xff\xc4\x5f\xc8\x81\xb7\x8c\x7c\x4f\xd0\xe9\xc6\xa7\x7a\xc5\x46\xcb\xf8\xc0\xc4\x56\x73\xbe\x65\x7c\xc7\x08\x9a\xde\x90\xa7\xcf\xb4\x8d\xa7\x27\x83\xce\x26\x3e\x7c\x46\x58\xc6\x57\xe4\x01\xfc\xab\x33\xbc\x27\x3f\x31\xae\xb3\x8c\xb7\x4e\xc4\x8d\x9f\x18\x41\xe3\xad\x13\x5b\x2d\xe3\xa7\xb2\xe9\x3b\x26\x84\xf1\x53\x43\x98\x64\xdc\x31\x71\xd2\xf4\xca\xc7\xd6\x36\x73\xf5\xff\x63\x19\xd9\xce\x1e\xbe\x60\x04\xcd\x25\xdb\x2c\xe3\x2d\x13\x41\x73\xad\x65\xfc\x5c\x9e\x09\x96\x30\xde\x3c\x21\xcc\xb9\x52\xf2\x91\xfc\x6d\x2e\xb7\x56\x5a\xc6\x9b\x26\x82\xe6\x42\xcb\x78\xb3\x47\x0c\x99\x6b\x43\x96\xf1\xb3\xd3\x72\xfc\x6c\xcb\x78\xb6\xe7\xa4\xec\x99\x39\xdb\x5a\x6d\xfa\x2c\x61\xf6\x5b\x1b\xa5\x8d\x77\xb8\x83\xf1\xc7\x1e\x61\x19\x3f\x3e\x7d\xd2\xf4\x5b\xc6\xbd\x1e\x61\x06\x2c\x5e\xf3\xbd\xce\x3e\xc9\x5a\x4b\x2c\xe3\xb9\x5e\x61\x19\x4f\x9c\x0e\xca\x35\x9e\x37\x3e\x29\x87\xef\xcf\x26\x0e\x9b\xab\x2d\xe3\xcf\x3c\x71\x73\xee\x4a\x4b\x18\xad\xd3\x5b\x8d\x9c\x47\xfe\x16\xee\x6f\x73\x8b\x5c\x2e\xcc\x25\x9b\x2c\xe3\x3b\xa7\x83\xe6\x52\xcb\x78\xa7\x47\x98\x73\x2d\x61\x7c\xfb\x74\xd0\x79\xec\xf4\xfe\x7f\x75\x1a\xbe\xcf\xb3\xd5\xbc\xd0\x38\x51\xb5\x8c\xe7\x4e\x3b\x63\xf0\xe5\xde\xeb\xac\xeb\x8c\x0f\x4e\x0a\xcb\x78\xba\x37\x68\x5d\x67\xce\xb1\x86\x2d\xe3\x3d\x93\x71\xe3\x5b\xbd\xc2\x78\xcf\xa4\x50\x7f\x9b\x17\x4e\x2b\x72\x7f\x9b\x5e\xb9\x95\xfc\xa4\x30\x9a\xbd\x02\x7f\x9b\xc3\xe2\xac\x8b\x9c\xf5\xde\x3d\x29\x8c\x67\x7b\x05\xfe\x36\x87\xbb\x0f\xf1\x77\x67\x7b\xef\x9a\x14\x72\xdc\xf0\xb7\x39\xdc\x7d\x88\xbf\x3b\xeb\xdd\x3d\x29\x8c\xff\xd5\x2b\xf0\xb7\x5c\xef\xee\xc9\xeb\xe4\x33\x33\xef\xd9\x6a\x2e\x5f\x69\x19\xdf\x3a\x1d\x94\xaf\x18\x5f\xed\x0d\x9a\x8b\x8c\x87\xe4\x6b\xc8\x73\xa7\x85\xf1\x2e\x77\x58\x9f\x3d\x2d\x8c\x77\x7b\xb6\x1a\xcf\x9e\x16\xf2\x14\x7a\x9f\xe7\x01\xcb\x78\xea\x74\x50\xbe\xb0\xfc\xb9\x7c\xc6\x7c\xf3\xb4\x30\x2f\xb0\x8c\x82\x47\x98\x7b\x2d\x61\x34\x4e\x0b\x73\x99\x65\xdc\xe3\x11\xe6\x42\x4b\x3e\x5c\xe9\xfc\x5e\x2d\xc7\xfc\x5f\x4f\x6f\x95\x4f\xb4\x7b\xe5\x6a\xdf\xe8\x1c\x9c\x6f\xf7\x08\xb3\x7f\xb5\xe9\xb5\x84\xe9\x97\x5b\xcb\x9d\x39\x6c\x2e\xb6\x8c\xe7\x7b\x82\xe6\x85\x96\x30\x87\x2c\xe3\x84\xb0\x8c\xf7\x9e\x91\xe7\x9c\xf1\xc3\x9e\xeb\x64\x4d\xe3\x4f\xce\x04\xcd\x35\x96\xf1\xa3\x1e\x61\xfa\x8d\x57\x0b\xcb\xf8\x9b\xce\xf2\xc7\x7a\x84\xb9\x7c\x85\x39\x60\x05\x4d\xd3\xda\x24\x4f\xaf\x07\xce\xd8\xdf\x95\xfb\xfa\xa5\x1e\x61\xce\xd9\x66\xae\xb0\x84\xf1\xc1\x33\x42\xae\xdc\x76\x56\xbe\x69\xda\xca\xe6\xb0\x39\x60\x17\x4d\x73\xb8\xb3\xfa\x97\xf5\xb5\xe5\x09\x36\x57\x76\x6b\xab\xec\x93\xf1\x74\x8f\x70\xfb\xf6\xb3\x9e\xc3\x4e\xdf\xde\x72\xc6\x79\x1d\xfb\xb9\x1c\xc7\x37\x9f\xd9\x6a\xfc\xbc\x47\x18\x6f\x3e\xe3\xbc\x3e\x7f\x55\x3e\xa5\x3f\xd0\x59\xfe\x35\xf9\xf8\xfe\x33\x5b\xe5\xc8\x3f\xd9\x13\x94\x6d\x1b\xf7\xcd\x58\xcd\xed\xb7\x5c\x3a\xd7\x92\x9b\x34\x87\xac\xa0\xbb\x79\x39\x86\x9f\x97\x4f\xab\x2f\x9e\x16\xc6\x87\x3d\x82\x7f\x07\xcd\xd5\x56\x67\x54\x85\xb5\xd2\xec\x37\x9e\x90\x67\xff\x8f\x26\x83\xa6\xb0\x8c\x8f\x7a\x84\x51\x9f\x14\xa6\x77\x9b\xb9\x6a\xa5\xe9\xb5\xb7\x58\xc6\xb7\x27\xaf\x93\x7b\xf6\xa0\x7c\x16\x7f\xa1\x73\x90\xde\xdd\x2b\x56\x9a\x4b\x57\x9a\x8b\x57\x9a\x73\x8d\xf7\xf4\xc6\xcd\x61\xe3\xdf\x27\x83\xe6\xa5\x96\x30\x23\xc2\xf4\x0a\xe3\x1b\x93\xce\x98\xdf\x2f\x37\xfe\xb5\xc9\xa0\x71\x7f\xaf\x30\x57\xcb\x43\xf8\xd5\xc9\xad\xc6\x07\x3a\xa7\xa1\xb9\x6a\x95\xb9\x66\x83\xb9\xea\x26\xd3\x7b\x95\xd9\x7f\x93\x65\x3c\x36\x79\x9d\xf1\xa1\x5e\x61\x3c\x36\x29\xe4\x09\xf4\x60\xaf\xec\x60\x50\x36\xf7\x85\x49\x61\xae\xb0\x8c\x8f\xf5\x0a\x73\xb9\xb1\x44\x98\x8b\x36\x9a\xab\x87\x2c\xe3\xe3\x93\xf2\x90\x1b\x65\x8f\x90\xc7\xa3\x7a\x5a\x9e\x16\xc6\xdb\x8c\xa0\xb9\x74\xb5\xb9\x76\xc8\x1c\xf8\x1d\x73\xed\x0a\xd3\x34\xbc\xf6\x61\xb9\xfc\x5b\x13\x0f\x98\xa6\xf1\x1e\x43\x9e\x77\xc6\xef\x6e\xb5\x8c\xdc\xa4\x30\x57\x1a\xad\x5e\x39\x7f\xe5\x26\x83\xf2\x05\xf7\xdf\x65\xd7\x8e\x58\xc6\xdb\xe4\x90\x7c\x4f\xf6\xfe\xae\xc9\x4f\xca\x99\xe4\xe3\xbd\xc2\xf4\x87\xe4\x4b\x97\x79\xb1\x30\xfe\x69\x52\x98\xaf\x34\x3e\xd1\x2b\xcc\xed\xc2\xf4\x5d\x67\xfa\xad\x55\xce\x4b\xdf\xe5\xd6\x4a\x33\x64\x05\xcd\xa1\xa0\xb9\x4e\x9e\xf8\x2b\x4d\xbf\xe1\x95\xaf\xa6\xaf\x17\x96\xf1\x19\xd9\xe6\xc3\xbd\x41\xcb\xf8\xc7\x49\x61\x3c\xdc\x2b\xfb\xb1\x59\x76\xec\xef\xe5\x78\x5b\xc6\xe7\x7a\x83\xbd\xbd\xd4\xd3\xa3\x4f\xef\x9f\xed\x4c\xef\x4f\xff\x92\xd3\xfb\x06\x9e\xde\x83\x38\xbd\xc7\xff\x93\x4f\xef\x9d\xe9\xbd\x2a\x5f\x75\xca\xe7\xa7\xf7\x19\xa7\xf7\xc3\xbf\xfa\xe9\xfd\x81\xf3\xd3\xfb\x7f\xba\xe9\x5d\x18\xef\xf7\x08\xf9\x5b\xce\x20\xf7\x7a\xc4\xf9\x69\xfe\x37\x78\x9a\x0f\x9e\x75\x9a\x17\xdd\x69\x3e\xf8\x2b\x9a\xe6\x83\xbf\xaa\x69\xfe\xe4\x6f\xef\x34\xff\x68\x67\x9a\xff\xfa\xf9\x28\xfe\xd7\x19\xc5\xff\x0f\xf9\xa2\xf5\xd1\xf3\xd3\xfc\xf9\x69\xfe\xfc\x34\xff\x7f\x66\x9a\x1f\x39\x3f\xcd\xff\xb6\x4d\xf3\x2f\x2f\x9a\x7f\xe5\x2f\x11\xcd\xc7\x61\x9a\x5f\x6a\x19\x6f\x37\x82\xe6\x85\x2b\xcc\x81\x35\x96\xf1\x9d\x09\x27\xf7\xf0\x1e\x43\xe8\xd3\xbc\xcf\xba\xca\x1c\x36\x7e\x3f\x68\x7a\x6f\xb3\x8c\x3b\x26\x85\x7c\xc9\x7d\x41\x76\xf5\x8f\x26\x85\x7c\x49\xfa\x59\x6f\xd0\x9c\xbb\xcd\x32\xde\x3c\x29\xeb\x1a\x7f\x61\x6c\x35\x87\x8d\x9b\x85\xe9\xbd\xe9\x9c\xd5\x17\x59\xc6\x1d\x46\xd0\x9c\xb7\xde\x5c\xd8\x1b\x5c\x68\x19\x2f\x4c\xc8\x14\xa9\xf1\x56\x43\x98\xdb\x2c\x61\x0e\x06\x4d\xef\x75\xc6\x4f\x26\x9c\x76\x0b\x86\x30\x87\x8d\x57\xbf\xa4\x76\xe7\x59\xc6\x5b\x8c\xad\xe6\xc2\xde\xf5\xdc\xe8\xd4\x86\xb6\xad\x36\x07\x2d\x61\x7a\xe3\x4e\xbb\xc3\x96\xf1\x93\xde\x97\xd4\xe4\xcb\xbc\x78\x11\xff\xdb\x17\x2f\x72\xf0\xff\x76\x32\x28\x73\xd6\x6f\x31\x82\x2f\x61\x1f\x5e\xca\xce\x9a\x24\xab\xf6\xf6\x4d\xbb\x2e\xfa\x62\xe7\xba\xe8\x89\xf3\xe9\x8f\x5f\x67\xfa\xe3\x71\x79\x5d\xf4\xc0\xf9\xeb\xa2\xf3\xd7\x45\xe7\xaf\x8b\xce\x5f\x17\x9d\xbf\x2e\x3a\x7f\x5d\xf4\xd2\xae\x8b\xae\xf8\x75\x5d\x17\x05\xf5\x4b\x85\x6d\x1b\x7f\xc3\x2f\x8a\xe0\x92\xe8\x17\x76\xff\x9c\x3b\xa9\x5c\x0c\x6d\x3c\x79\x2c\xb5\xf1\xd6\xd4\x61\xf9\x85\xc3\x87\xe4\x03\x3a\x72\xfc\xe0\xd1\x53\x87\xc6\x36\x1e\x3d\x72\xfc\x54\x5a\xd7\xc6\x60\xd0\xa9\xb2\xf1\xd4\xf8\x89\x8d\xee\x92\x74\x78\xcb\xe8\x96\x4b\x36\x38\xd5\x37\xdc\x76\xfc\xd4\xc6\x5b\x8f\x9c\x1c\xa7\x69\xed\x6e\x3c\x79\x30\xe5\x7c\x0d\xe3\x89\x0d\xe3\xb7\x1f\x7c\xed\x86\x5b\x53\x87\x83\x07\x9d\x6f\x06\x4b\x8e\x1d\x4d\x8d\x9d\x18\x0f\x26\x69\xc6\x2a\x47\x8e\xcb\x25\x6f\x48\x8d\x8d\x8f\x1e\xbb\x25\x95\x1a\x3b\xe1\x32\x98\xa4\xf1\x93\x87\x8e\x1c\x3f\xb9\x41\x7e\x1b\xa6\xac\x75\xf0\xf6\x63\xc7\x6e\x97\x0f\x64\xe3\x49\x3a\x92\x7a\xdd\x96\x60\x92\x8e\x1c\x97\xff\x7f\xea\x50\x4a\xae\x78\x50\xfe\xff\x91\xc3\xa3\x63\x27\x93\x4e\x4b\x47\xa4\x7f\xc1\x7f\xa2\x87\xc8\x43\xcf\xbf\xa8\x97\xf2\xb5\x23\x19\xd3\xca\x0e\xcf\x95\xbf\x0c\xca\x78\xdc\x12\xfe\xbb\x22\x5b\xfe\xbf\xe1\x7c\x6f\x94\xfa\xcf\xee\xe3\xfa\x50\xfe\x71\xab\x53\x9e\x84\x4d\xb4\x9d\x7a\x06\x35\xa1\xfe\x9f\xce\xe9\xd4\x6f\x43\xf9\x42\x77\xbb\xdd\x2b\xde\xce\xcf\x1d\x26\x6f\x17\xca\x2f\x75\xdb\x81\xf2\xe7\xbd\x9d\x72\x1b\xfa\xf3\xa9\x59\x9d\xfe\x08\x53\xef\xcf\xf5\x9e\x4e\x7f\x8a\x50\xff\x7f\xf2\xfe\x56\xa0\xfe\x1f\xf2\xfe\x46\x2d\x7d\x7f\xe7\x72\x3b\x4d\x28\x7f\x7e\x76\xa7\x9c\xa0\x9d\xbb\xdc\x7e\x42\xf9\x69\x1e\x07\x1f\x94\x7f\xcd\x1d\x1f\x28\xdf\xce\xe3\x53\x84\xed\x7e\x88\xb7\x9b\xf0\x76\x8b\x9c\x9f\x3f\xe7\x76\x9a\x50\x7e\x17\xd7\xcf\xcc\xea\x16\x39\x3f\x7f\xc7\xf5\x2b\x76\xb7\xc8\xf9\xb9\x9e\xc7\xa1\x05\xe5\xf2\xef\xf9\xe4\xfe\xda\xd0\xfe\x2e\x3e\xdf\x42\x50\xde\xcb\xe5\x49\xd8\xee\x87\x78\x7c\x8a\x50\xff\x36\x3e\x2e\x0d\xa8\x3f\xc9\xc7\xb7\x0c\xe5\x23\xdc\x4f\x1b\xca\xaf\xe5\x76\x0a\xb3\xbb\x45\xce\xcf\xa7\x7b\xb9\x9f\x50\xff\x19\x6e\x3f\xea\xeb\x16\x39\x3f\x5f\xe1\xe3\x2e\xa0\x9d\x37\xba\xed\x40\xf9\x6e\x1e\xe7\x38\x96\xf3\xfe\x66\xa0\xfc\x3b\x7c\x7c\x7d\x73\xba\x45\xce\xcf\x21\x2e\x2f\xcc\xef\x16\x39\x3f\x7f\xc0\xcf\x0b\x1f\x94\xdf\xc1\xc7\x25\x01\xed\xd4\x79\x7c\xb2\x50\x7f\x09\xf7\xa7\x05\xf5\xbf\xcb\xe3\x96\x84\xfa\x77\xf3\x38\x04\xe6\x76\x8b\x9c\x9f\x25\xbc\xbf\x09\x28\xbf\x90\xdb\xc9\x63\x7d\x1e\xb7\x1a\x94\x87\x79\xfc\xed\x79\xdd\x22\xe7\xe7\x93\x3c\x0e\x11\x28\xaf\xf0\x38\xa4\xa1\x3c\xca\xe7\x73\x09\xca\x4f\x72\xff\x6b\x50\x1e\xe1\x71\x6b\x41\xf9\x2c\x2e\xaf\xc1\x38\x2c\xe2\xed\xb6\xa1\xfc\xf7\x79\xbf\x7c\x4b\xbb\x45\xce\xf3\x3d\xc4\xdb\x6d\xc0\x79\xf5\x4e\x3e\x2e\x15\x28\x2f\xf0\x38\xe4\xe0\x46\xd4\x1a\x8f\xb3\xbf\xbf\x5b\xe4\xfc\xd4\xf8\x38\xa6\x17\x74\x8b\x9c\x9f\x0f\xbb\xe3\x0f\xe5\x82\xc7\x33\x04\xed\xff\x09\xd7\x6f\x0c\x74\x8b\x9c\x9f\x35\xfc\xfc\xcd\x42\xf9\x0b\xbc\x5f\x21\x28\xff\x37\x1e\x87\x0c\x94\xdf\xcb\xe5\xc9\x25\xdd\x22\xe7\xe7\x51\x1e\x67\x1f\x94\x1f\xe5\xfd\xcd\x62\x39\xef\x6f\xce\xaf\xef\x6f\x80\xeb\x27\x96\x77\x8b\x9c\x1f\x9f\x3b\xce\x50\xbf\xc8\xdb\x25\x38\x5e\xaf\xe6\xf3\xa7\x02\xed\x3c\xc5\xc7\x3d\x3e\xa8\x15\x53\x99\xc7\x41\x40\xf9\x15\xbc\xbf\x61\x28\x97\x3f\x06\x4e\x16\xf2\x5e\x60\x67\x9c\x4d\xe7\xfb\x03\xd5\x7f\xcf\x3b\xfd\x31\x29\x0d\xf3\x69\x4f\x1f\xd7\x87\xf2\x0f\x59\x9d\xf2\x14\xcc\x77\xcf\x3b\xf5\x4c\x6a\x41\xfd\xb7\xcc\xe9\xd4\x27\x98\x67\x67\xbb\xdb\x85\xf2\x49\xb3\x53\x9e\x85\xf2\x0d\x6e\x3b\xb0\xdd\x67\xbd\x9d\xed\xfa\xa0\xfc\x6f\x67\x75\xca\x03\x30\x14\xbb\x9d\xfd\x37\xa9\x04\xf5\x3f\xc7\xfb\x5b\x85\xfa\x47\x78\x7f\x63\x30\x3f\x9a\xdc\x4e\x0b\xca\x9f\x9d\xdd\x29\xc7\x79\xf9\x0e\xb7\x9f\x50\x3e\xce\xe3\xe0\x87\xf2\xc7\xdc\xf1\x81\xf2\xcb\x79\x7c\x4a\xb0\xdd\xfb\x79\xbb\x38\x5f\xbf\x83\xdb\xc1\xf9\xfa\x0e\xae\x9f\x86\x79\xea\x41\xae\x5f\x85\x79\x79\x37\x8f\x43\x1b\xca\xbf\x69\x74\xca\x7d\xd0\xfe\x95\x7c\xbe\x85\xa1\xfc\x27\x7c\x1c\x13\xb0\xdd\xfb\x79\x7c\x70\xbe\x1e\xe5\xe3\x52\x87\xfa\xa7\xf8\xf8\x96\xa0\x7c\x1d\xf7\x13\xe7\xeb\xab\xb8\x9d\x3c\xcc\x8f\x7f\xe7\x9c\x67\xe6\xb4\xf9\xfa\x6b\xdc\x7e\x18\x5e\x3f\xff\x85\x8f\xbb\x1f\xda\x79\x3d\xb7\x13\x80\xf2\xed\x3c\xce\x31\x2c\xe7\xfd\x4d\x43\xf9\x37\xf9\xf8\xda\x30\x6f\xbe\x86\xcb\xf3\x30\x2f\xdc\xc2\xe3\x69\x43\xf9\x24\x1f\x97\x38\xb4\xf3\x3f\x79\x7c\x32\x50\x7f\x3e\xf7\xa7\x09\xf5\x9f\xe5\x71\x4b\x40\xfd\xbb\x78\x1c\x04\xcc\xb3\xf3\x79\x7f\xe3\x50\x3e\xc4\xed\xe4\xb0\x3e\x8f\x5b\x15\xca\x37\xf2\xf8\x13\xcc\x9b\x1f\xe1\x71\x08\x43\xf9\x27\x78\x1c\x52\x50\x7e\x15\x9f\xcf\x45\x28\x7f\x2d\xf7\xbf\x0a\xe5\x97\xf1\xb8\x35\xa1\xbc\x87\xcb\xab\x30\x0e\xb3\x79\xbb\x2d\x28\xbf\x91\xf7\x0b\x5f\xff\x2f\xe4\xed\xd6\xe0\xbc\xfa\x53\x3e\x2e\x25\x28\x7f\x37\x8f\x43\x06\xe6\xd3\xcf\xf1\x38\xdb\x30\x5f\x7f\x8e\x8f\x63\x12\xe6\xe5\xfb\x79\xfc\xb3\x50\xbe\x84\xc7\x53\x40\xfb\x6f\xe2\xfa\x35\x98\x67\x07\xf9\x79\x9d\x86\xf2\xef\xf1\x7e\x09\x28\xff\x3a\x8f\x43\x0a\xca\xdf\xc3\xe5\x51\x98\x7f\x3f\xcd\xe3\xdc\x5e\xdc\x2d\x72\x7e\x0e\xf2\xfe\xa6\xa0\xfe\x41\xde\xdf\x0c\xcc\xbf\x43\x5c\x3f\x0e\xf3\x6c\x9f\x3b\xce\x50\xff\x7d\xbc\xdd\x16\x94\x5f\xcf\xe7\x4f\x19\xda\xa9\xf3\x71\x8f\xc1\xfc\xfb\x37\x3c\x0e\x7e\x28\x1f\xe1\xfd\x0d\x41\xb9\xfc\x31\xa8\x0f\x4a\x88\x5e\xe5\x8c\x73\xdf\xb4\xf8\xfa\x39\xa7\x3f\x7d\xd3\xe2\xeb\x9f\x58\x5c\x1f\xca\xef\xe7\x72\x8c\xaf\x9f\x73\xea\xf5\x4d\x8b\xaf\x4f\x3b\xfb\xd5\xc7\xf1\xf7\xd4\x3f\xc3\xdd\x2e\xcc\xcb\xa7\x4c\xde\x2e\x94\xaf\x71\xdb\x81\xf2\xa7\xbc\x9d\x72\x8c\xaf\x3f\xe2\x9c\xe7\x7d\xd3\xe2\xeb\xed\xce\xfe\x77\x3e\x77\x59\xfd\xef\x1f\x9c\x21\xeb\x9b\x16\x5f\xdf\xc2\xfb\x8b\xf1\xf5\xcf\x0c\xde\x5f\x28\x7f\x6a\x76\xa7\x1c\x2f\x99\x26\xdd\x7e\x42\xf9\x6b\x79\x1c\x70\x1e\xff\xbc\x3b\x3e\x50\x3e\xc2\xe3\x83\xf1\xf5\x7b\x79\xbb\x71\x98\xef\xfe\x94\xdb\x69\x40\xf9\x24\xd7\x4f\xc1\x3c\xf5\xd7\x5c\x1f\xe3\xeb\xed\x3c\x0e\x18\x5f\x7f\x9d\xc7\x01\xe3\xeb\x2d\x7c\xbe\x61\x7c\xfd\x5d\x3e\x8e\x71\xd8\xee\x7b\x79\x7c\x0a\x50\xff\x77\xf9\xb8\xd4\xa0\xfe\x31\x3e\xbe\x45\x28\x5f\xc9\xfd\x24\x28\xbf\x8c\xdb\xc9\xc1\xfc\xf8\xa0\x73\x3e\xf5\x51\x00\xea\x7f\x91\xdb\x0f\xc1\xeb\xe7\x3f\xf1\xf9\xe3\x83\x76\x6e\xe7\x76\x30\xee\xbe\x9c\xc7\x39\x8a\xe5\xbc\xbf\x29\x28\xff\x3a\x1f\x5f\x82\x79\xf3\x06\x2e\xcf\xc1\xbc\x10\xe7\xf1\x24\x28\x3f\xc5\xc7\x25\x06\xed\x7c\x96\xc7\x27\x0d\xf5\xfb\xb8\x3f\x0d\xa8\xdf\xe0\x71\x8b\x43\xfd\x3b\x78\x1c\xfc\x30\xcf\xf6\xf1\xfe\xc6\xa0\x7c\x09\xb7\x93\xc5\xfa\x3c\x6e\x15\x28\x5f\xcb\xe3\xdf\x86\xf2\x22\x8f\x43\x08\xe6\xd3\x0f\xf3\x38\x24\xa1\x7c\x0b\x9f\xcf\x05\x28\xbf\x95\xfb\x5f\x81\xf2\x20\x8f\x5b\x03\xca\xdb\x6e\x3f\x61\x1c\x7a\x79\xbb\x4d\x28\xdf\xc3\xf5\xf1\xf5\xff\x02\xde\x2e\xce\xd7\x6f\xe6\xe3\x82\xf3\xf5\xdb\x78\x1c\x70\xbe\x7e\x98\xc7\x19\xe7\xeb\x87\xf9\x38\xe2\x7c\x7d\x8f\x3b\xfe\x50\x3e\x8f\xc7\x13\xe7\xeb\x34\xd7\xc7\xf9\x7a\x21\x3f\xaf\x71\xbe\x7e\x8e\xf7\x0b\xe7\xeb\xc7\x79\x1c\x70\xbe\xce\x39\xf3\x75\xdf\xb4\xf9\xfa\x93\x3c\xfe\x38\x5f\xbf\x9a\xf7\x17\xe3\xf1\x57\xbb\xfb\x0b\xe3\xbc\xc8\x1d\xb7\x21\xb7\xa4\xf3\xef\xe7\xbc\xbf\x79\xa8\xff\x2e\xf7\xb8\x43\x79\x80\xdb\x8f\x2e\xd3\xdb\xff\x00\xef\x6f\x15\xca\x6f\xe6\xed\x86\xa0\xfc\x3a\x2e\x2f\xc2\x7c\xfd\x28\xf7\x27\xb0\xa2\x5b\xe4\xfc\x04\x78\x7c\x12\x50\x7f\x17\x9f\x6f\xf1\x61\xad\x98\x5e\xcf\xc7\x25\x02\xe5\xff\x95\xcb\x6d\x18\x87\x5e\xee\x4f\x56\x74\x8b\x9c\x9f\x43\x3c\x0e\xc5\x0b\x60\x7c\xb8\x9f\xc9\x55\x70\xdc\xf9\xbc\x6d\x40\xfb\x3f\xe4\xf6\x53\xd0\xfe\x0d\xdc\x7e\x18\xca\xef\xe1\xfd\x8a\x41\xf9\x30\x3f\x7f\x6d\xd8\xee\x20\xb7\xd3\x5c\xed\x96\x4c\xfd\x67\x10\x4c\x22\xf2\xaf\x87\x9d\x71\xf0\x4e\xcb\x57\x3c\xed\xb4\xef\x9d\x96\xaf\xf8\x9e\xc5\xf5\xa1\xfc\xbd\x5c\x8e\xf9\x8a\xa7\x9d\x7a\xde\x69\xf9\x8a\x71\x67\xbf\xbc\xd3\xf2\x15\x3f\xed\xe3\xed\x42\xf9\x31\xb3\x53\x8e\xf9\x8a\x15\x6e\x3b\xb0\xdd\xaf\x78\x3b\xdb\xc5\x7c\xc5\x7f\x77\xc6\xbf\xf3\xfd\x0d\xea\x7f\x97\x3b\xfb\xef\x9d\x96\xaf\xf8\x04\xf7\x07\xf3\x15\x37\xf1\xfe\x62\xbe\xe2\x07\x06\xef\x2f\x94\x7f\x65\x76\xa7\x1c\xaf\x73\x4e\xb9\xfd\x84\xf2\x83\x3c\xfe\x98\xaf\xf8\xb4\x7b\x5c\xa0\x7c\x1d\x8f\x0f\xe6\x2b\xee\xe6\xed\x62\xbe\xe2\x2d\xdc\x0e\xe6\x2b\x4e\x71\x7d\xcc\x57\xfc\x25\xd7\xc7\x7c\xc5\xe5\x3c\x0e\x98\xaf\xf8\x12\x8f\x03\xe6\x2b\x82\x7c\xbe\x61\xbe\xe2\x39\x3e\x8e\x98\xaf\xb8\x9b\xc7\x07\xf3\x15\xfb\xf8\xb8\x60\xbe\x62\x8c\x8f\x2f\xe6\x2b\x96\x72\x3f\x31\x5f\xb1\x91\xdb\xc1\x7c\xc5\x5f\x3b\xe7\x99\x77\x5a\xbe\xe2\x9f\xb8\x7d\xcc\x57\x7c\x8a\xcf\x1f\xcc\x57\xdc\xc6\xed\x60\xbe\x62\x84\xc7\x19\xf3\x15\x23\xbc\xbf\x98\xaf\xf8\x12\x1f\x5f\xcc\x57\xec\xe6\x72\xcc\x57\xec\xe3\xf1\xc4\x7c\xc5\x31\x3e\x2e\x98\xaf\xf8\x7b\x1e\x1f\xcc\x57\xbc\xe8\x9e\x27\x50\xff\x09\x1e\x37\xcc\x57\x4c\xf2\x38\x60\xbe\xe2\x45\x1e\x37\xcc\x57\xcc\xe7\x76\x30\x5f\xf1\x22\xbf\x6e\x60\xbe\x62\x05\xb7\x83\xf9\x8a\xf7\xf1\x38\x60\xbe\xe2\x7e\x1e\x07\xcc\x57\x6c\xe0\xfd\xc2\x7c\xc5\x4d\xdc\x7f\xcc\x57\xac\xe1\x71\xc3\x7c\xc5\xbf\xf3\xf1\xc5\x7c\xc5\x8f\xf9\xf8\x62\xbe\x62\x07\xd7\x6f\xc3\x7c\x3a\xc0\xdb\xad\xc3\x79\xf5\x06\x3e\x2e\x65\x28\xbf\x83\xc7\x21\x0b\xd7\x27\x1f\xe7\xed\xfa\xe0\xfa\xe7\xe3\x7c\x5e\xa5\xe0\x3a\xe7\x1d\xee\xf8\x43\xb9\xc9\xe3\x19\x80\xf6\x6f\xe7\xfa\x75\xb8\x6e\x99\xc5\xcf\x6b\x7c\xbf\xe0\x29\xde\xaf\x00\x94\x7f\x9e\xc7\x01\xaf\x97\xfe\x98\xcb\x63\x70\x3d\xf3\x11\x1e\x7f\x82\xf2\xeb\x79\x7f\x31\x5f\x71\xbd\xbb\xbf\x30\xce\xb3\x79\xdc\xf2\x30\x2f\xff\x80\xf7\xb7\x00\xf5\xef\x72\x8f\x3b\x94\x0f\x71\xfb\x31\xb8\x9e\x79\x2f\xef\x6f\x0d\xca\xf7\xf3\x76\xc3\x50\xbe\x9d\xcb\xcb\x70\x3d\xf3\x69\xee\x4f\x18\xae\x7f\x86\x78\x7c\x52\x83\x5a\x31\x6d\xe3\xf3\x3c\x03\xd7\x39\xc7\xf9\xb8\x24\xa1\x3c\xc3\xe5\x02\xc6\xe1\xc7\xbc\x5f\x05\xb8\xde\x78\x0d\x8f\x43\x15\xae\x7f\xee\xe2\x7e\x66\xe1\x3a\xe4\x29\x3e\x6f\xdb\xd0\xfe\x77\x78\x7f\xf1\xfa\x6a\x37\xb7\x8f\xd7\x39\xef\xe0\xfd\x4a\x42\xf9\x72\x7e\xfe\x86\x60\xbb\x0b\xb9\x1d\x3f\xec\xef\x21\xe7\x53\x5d\x8c\x69\x9f\x2b\xf3\x0f\x3d\x44\xfd\xca\x8b\x89\x9f\xaf\x53\xfe\xd4\x29\x5f\x30\xad\xfc\x0d\x4e\xb9\x6f\x5a\xf9\x6d\x4e\x79\xff\xb4\xf2\x9b\x9d\xf2\xf9\xd3\xca\x3f\xe2\x94\x2f\x9c\x56\xfe\x7e\xa7\x7c\xf6\xb4\xf2\xd3\x4e\xff\xcd\x69\x9f\x7f\x53\x75\xca\xfb\xa6\x7d\xfe\xcd\xe7\x9d\x76\xe6\x4c\x6b\xe7\x3a\xa7\x7c\xee\xb4\xf2\x6f\x39\xed\x78\xa7\x7d\x5e\xce\x0f\x49\xd6\x9f\x7a\x11\x70\x2f\x13\x73\x9d\xcb\x44\xf9\x71\x2f\xce\xbe\xa7\x79\x48\x7a\xf8\x33\x9b\x63\x3c\x74\xd2\xce\x67\xed\x2c\xd2\xdd\x56\xfc\x07\x3d\x44\xf1\x01\xdd\x7e\x8e\x73\xe4\xfa\xaf\xed\x21\xaa\x81\x4b\xfc\x7c\x97\xcb\x8f\xf6\x10\x85\xfc\xba\xe3\xe0\x0a\x3f\xef\xe4\xf2\x63\x3d\xd3\xfb\x9f\x84\xfe\x47\xa0\xff\xed\x85\xba\x9b\xd0\xff\x28\xf4\xdf\x86\xfe\x57\xa0\xff\x79\xe8\xbf\x1f\xfa\x1f\x81\xfe\x97\x7f\xc3\xfa\x9f\x83\xfe\xdb\xfe\x5f\x7c\x3c\xca\x9c\x9f\x97\x96\x39\x55\x9f\xb2\x3f\x7f\xd8\xd3\xf9\x9c\x38\x75\x79\x01\x96\x97\x06\xf5\xe5\xb4\x42\x5f\x6e\x0f\xe9\xcb\xe3\x8a\xff\x70\x86\xf1\x4a\xc1\x78\x45\x61\xbc\x68\x91\xee\x96\x62\x39\x3e\x31\x18\x2f\x1f\x8c\x57\xf5\x1c\xc7\xdb\x07\xc7\x3b\x0c\xc7\xbb\x02\xe3\xe5\x87\xf1\x8a\x2b\x96\xcb\x8b\xb0\xbc\x02\xe3\xe5\x83\xf1\x12\x43\xfa\xf2\x14\x8c\x97\x7b\x39\xdf\xcb\xe7\x1b\x0f\x97\x13\xae\xc9\xfa\xdc\x5d\xc7\xb2\xbe\xfb\xf2\xdc\xcb\xe3\x1b\x50\x2c\xf7\x3f\xa4\x58\x8e\x67\x58\xb1\x1c\xbf\x88\x62\x79\xfe\x46\x15\xcb\xf1\x88\x29\x1e\x97\xaf\x17\x8a\x65\x59\x02\x9c\x04\xa7\xc0\x69\x70\x06\x9c\x05\xe7\xc0\x79\x70\x01\x5c\x04\x97\xc0\x65\x70\x05\x5c\x05\xd7\xc0\x75\x70\x03\xdc\x04\xb7\xc0\x6d\x30\xf5\xe8\xb6\xc1\x3e\xb0\x1f\x2c\xc0\x01\x70\x48\xf1\x01\x22\x0a\x83\x23\xe0\x28\x38\x06\x8e\x83\x13\xe0\x24\x38\x05\x4e\x83\x33\xe0\x2c\x38\x07\xce\x83\x0b\xe0\x22\xb8\x04\x2e\x83\x2b\xe0\x2a\xb8\x06\xae\x83\x1b\xe0\x26\xb8\x05\x6e\x83\xa9\x57\xb7\xad\xf8\x03\xf2\xfe\x1b\xb0\x1f\x2c\xc0\x01\x70\x08\x1c\x06\x47\xc0\x51\x70\x0c\x1c\x07\x27\xc0\x49\x70\x0a\x9c\x06\x67\xc0\x59\x70\x0e\x9c\x07\x17\xc0\x45\x70\x09\x5c\x06\x57\xc0\x55\x70\x0d\x5c\x07\x37\xc0\x4d\x70\x0b\xec\xbe\x5f\x28\x7f\x59\xf2\x5c\x30\x74\xdb\x60\x1f\xd8\x0f\x16\xe0\x00\x38\x04\x0e\x83\x23\xe0\x28\x38\x06\x8e\x83\x13\xe0\x24\x38\x05\x4e\x83\x33\xe0\x2c\x38\x07\xce\x83\x0b\xe0\x22\xb8\x04\x2e\x83\x2b\xe0\x2a\xb8\x06\xae\x83\x1b\xe0\xfb\xd8\x1e\x9e\x2f\x1f\x52\x2c\xe7\xe7\xc7\x15\xcb\xf9\xfd\xdb\x8a\xe5\x6b\xa5\x87\x2f\xf4\x3d\x3c\x5f\x0f\x2a\x96\xf3\xf5\x25\x8a\xe5\x6b\xed\x1e\xc5\xaf\xe5\xef\x03\x71\x2d\xaf\x0f\x26\x14\xcb\xf9\x7c\x3d\xcf\x3f\x1e\x9e\x7f\xb6\x2a\x96\xaf\x3f\x7b\x14\xcb\xf3\xf5\x66\xc5\x56\xcf\xd4\x7c\xee\x81\xf9\xdb\x03\xf3\xb5\x07\xe6\x67\x0f\xcc\xc7\x1e\x98\x7f\x3d\x30\xdf\x7a\x60\x7e\xf5\xc0\x7c\xea\x81\xf9\xd3\x03\xf3\xa5\x07\xe6\x47\x0f\xcc\x87\xae\x23\xe0\x18\x38\x01\x4e\x81\x33\xe0\x1c\xb8\x00\x2e\x81\x2b\xe0\x1a\xb8\x01\x6e\x81\xa9\x57\xb7\x0f\x2c\xc0\x21\x70\x04\x1c\x03\x27\xc0\x29\x70\x06\x9c\x03\x17\xc0\x25\x70\x05\x5c\x03\x37\xc0\x2d\x30\x19\xba\x7d\x60\x01\x0e\x81\x23\xe0\x18\x38\x01\x4e\x81\x33\xe0\x1c\xb8\x00\x2e\x81\x2b\xe0\x1a\xb8\x01\x6e\x81\xc9\xa3\xdb\x07\x16\xe0\x10\x38\x02\x8e\x81\x13\xe0\x14\x38\x03\xce\x81\x0b\xe0\x12\xb8\x02\xae\x81\x1b\xe0\x16\x98\x4c\xdd\x3e\xb0\x00\x87\xc0\x11\x70\x0c\x9c\x00\xa7\xc0\x19\x70\x0e\x5c\x00\x97\xc0\x15\x70\x0d\xdc\x00\xb7\xc0\x64\xe9\xf6\x81\x05\x38\x04\x8e\x80\x63\xe0\x04\x38\x05\xce\x80\x73\xe0\x02\xb8\x04\xae\x80\x6b\xe0\x06\xb8\x05\xa6\x3e\xdd\x3e\xb0\x00\x87\xc0\x11\x70\x0c\x9c\x00\xa7\xc0\x19\x70\x0e\x5c\x00\x97\xc0\x15\x70\x0d\xdc\x00\xb7\xc0\x64\xeb\xf6\x81\x05\x38\x04\x8e\x80\x63\xe0\x04\x38\x05\xce\x80\x73\xe0\x02\xb8\x04\xae\x80\x6b\xe0\x06\xb8\x05\x26\xaf\x6e\x1f\x58\x80\x43\xe0\x08\x38\x06\x4e\x80\x53\xe0\x0c\x38\x07\x2e\x80\x4b\xe0\x0a\xb8\x06\x6e\x80\x5b\x60\x9a\xa5\xdb\x07\x16\xe0\x10\x38\x02\x8e\x81\x13\xe0\x14\x38\x03\xce\x81\x0b\xe0\x12\xb8\x02\xae\x81\x1b\xe0\x16\x98\x66\xeb\xf6\x81\x05\x38\x04\x8e\x80\x63\xe0\x04\x38\x05\xce\x80\x73\xe0\x02\xb8\x04\xae\x80\x6b\xe0\x06\xb8\x05\xa6\x39\xba\x7d\x60\x01\x0e\x81\x23\xe0\x18\x38\x01\x4e\x81\x33\xe0\x1c\xb8\x00\x2e\x81\x2b\xe0\x1a\xb8\x01\x6e\x81\x69\xae\x6e\x1f\x58\x80\x43\xe0\x08\x38\x06\x4e\x80\x53\xe0\x0c\x38\x07\x2e\x80\x4b\xe0\x0a\xb8\x06\x6e\x80\x5b\x60\x9a\xa7\xdb\x07\x16\xe0\x10\x38\x02\x8e\x81\x13\xe0\x14\x38\x03\xce\x81\x0b\xe0\x12\xb8\x02\xae\x81\x1b\xe0\x16\x98\xe6\xeb\xf6\x81\x05\x38\x04\x8e\x80\x63\xe0\x04\x38\x05\xce\x80\x73\xe0\x02\xb8\x04\xae\x80\x6b\xe0\x06\xb8\x05\x26\x9f\x6e\x1f\x58\x80\x43\xe0\x08\x38\x06\x4e\xfa\xf4\xfc\x40\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\xf7\xeb\xf6\x83\x03\xe0\x30\x38\x0a\x8e\x83\x93\xe0\x34\x38\x0b\xce\x83\x8b\xe0\x32\xb8\x0a\xae\x83\x9b\xe0\x36\xd8\x5e\xa0\xdb\x0f\x0e\x80\xc3\xe0\x28\x38\x0e\x4e\x82\xd3\xe0\x2c\x38\x0f\x2e\x82\xcb\xe0\x2a\xb8\x0e\x6e\x82\xdb\x60\x7b\xa1\x6e\x3f\x38\x00\x0e\x83\xa3\xe0\x38\x38\x09\x4e\x83\xb3\xe0\x3c\xb8\x08\x2e\x83\xab\xe0\x3a\xb8\x09\x6e\x83\xed\x45\xba\xfd\xe0\x00\x38\x0c\x8e\x82\xe3\xe0\x24\x38\x0d\xce\x82\xf3\xe0\x22\xb8\x0c\xae\x82\xeb\xe0\x26\xb8\x0d\xb6\x07\x74\xfb\xc1\x01\x70\x18\x1c\x05\xc7\xc1\x49\x70\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\x2f\xd6\xed\x07\x07\xc0\x61\x70\x14\x1c\x07\x27\xc1\x69\x70\x16\x9c\x07\x17\xc1\x65\x70\x15\x5c\x07\x37\xc1\x6d\xb0\xbd\x44\xb7\x1f\x1c\x00\x87\xc1\x51\x70\x1c\x9c\x04\xa7\xc1\x59\x70\x1e\x5c\x04\x97\xc1\x55\x70\x1d\xdc\x04\xb7\xc1\xb6\x5f\xb7\x1f\x1c\x00\x87\xc1\x51\x70\x1c\x9c\x04\xa7\xc1\x59\x70\x1e\x5c\x04\x97\xc1\x55\x70\x1d\xdc\x04\xb7\xc1\xf6\x52\xdd\x7e\x70\x00\x1c\x06\x47\xc1\x71\x70\x12\x9c\x06\x67\xc1\x79\x70\x11\x5c\x06\x57\xc1\x75\x70\x13\xdc\x06\xdb\xcb\x74\xfb\xc1\x01\x70\x18\x1c\x05\xc7\xc1\x49\x70\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\x2f\xd7\xed\x07\x07\xc0\x61\x70\x14\x1c\x07\x27\xc1\x69\x70\x16\x9c\x07\x17\xc1\x65\x70\x15\x5c\x07\x37\xc1\x6d\xb0\x3d\xa8\xdb\x0f\x0e\x80\xc3\xe0\x28\x38\x0e\x4e\x82\xd3\xe0\x2c\x38\x0f\x2e\x82\xcb\xe0\x2a\xb8\x0e\x6e\x82\xdb\x60\x7b\x85\x6e\x3f\x38\x00\x0e\x83\xa3\xe0\x38\x38\x09\x4e\x83\xb3\xe0\x3c\xb8\x08\x2e\x83\xab\xe0\x3a\xb8\x09\x6e\x83\xed\x0b\x74\xfb\xc1\x01\x70\x18\x1c\x05\xc7\xc1\x49\x70\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\x0f\xe9\xf6\x83\x03\xe0\x30\x38\x0a\x8e\x83\x93\xe0\x34\x38\x0b\xce\x83\x8b\xe0\x32\xb8\x0a\xae\x83\x9b\xe0\x36\xd8\x16\xba\xfd\xe0\x00\x38\x0c\x8e\x82\x13\x8a\xe5\xfb\xff\x29\x70\x06\x9c\x03\x17\xc0\x25\x70\x05\x5c\x03\x37\xc0\x2d\x30\xad\xd4\xed\x03\x0b\x70\x08\x1c\x01\xc7\xc0\x09\x70\x0a\x9c\x01\xe7\xc0\x05\x70\x09\x5c\x01\xd7\xc0\x0d\x70\x0b\x4c\xab\x74\xfb\xc0\x02\x1c\x02\x47\xc0\x31\x70\x02\x9c\x02\x67\xc0\x39\x70\x01\x5c\x02\x57\xc0\x35\x70\x03\xdc\x02\xd3\x6a\xdd\x3e\xb0\x00\x87\xc0\x11\x70\x0c\x9c\x00\xa7\xc0\x19\x70\x0e\x5c\x00\x97\xc0\x15\x70\x0d\xdc\x00\xb7\xc0\x34\xac\xdb\x07\x16\xe0\x10\x38\x02\x8e\x81\x13\xe0\x14\x38\x03\xce\x81\x0b\xe0\x12\xb8\x02\xae\x81\x1b\xe0\x16\x98\xd6\xe8\xf6\x81\x05\x38\x04\x8e\x80\x63\xe0\x04\x38\x05\xce\x80\x73\xe0\x02\xb8\x04\xae\x80\x6b\xe0\x06\xb8\x05\xa6\xb5\xba\x7d\x60\x01\x0e\x81\x23\xe0\x18\x38\x01\x4e\x81\x33\xe0\x1c\xb8\x00\x2e\x81\x2b\xe0\x1a\xb8\x01\x6e\x81\xe9\x42\xdd\x3e\xb0\x00\x87\xc0\x11\x70\x0c\x9c\x00\xa7\xc0\x19\x70\x0e\x5c\x00\x97\xc0\x15\x70\x0d\xdc\x00\xb7\xc0\x14\xd0\xed\x03\x0b\x70\x08\x1c\x01\xc7\xc0\x09\x70\x0a\x9c\x01\xe7\xc0\x05\x70\x09\x5c\x01\xd7\xc0\x0d\x70\x0b\x4c\xeb\x74\xfb\xc0\x02\x1c\x02\x47\xc0\x31\x70\x02\x9c\x02\x67\xc0\x39\x70\x01\x5c\x02\x57\xc0\x35\x70\x03\xdc\x02\xd3\x45\xba\x7d\x60\x01\x0e\x81\x23\xe0\x18\x38\x01\x4e\x81\x33\xe0\x1c\xb8\x00\x2e\x81\x2b\xe0\x1a\xb8\x01\x6e\x81\xe9\x62\xdd\x3e\xb0\x00\x87\xc0\x11\x70\x0c\x9c\x00\xa7\xc0\x19\x70\x0e\x5c\x00\x97\xc0\x15\x70\x0d\xdc\x00\xb7\xc0\xb4\x5e\xb7\x0f\x2c\xc0\x21\x70\x04\x1c\x03\x27\xc0\x29\x70\x06\x9c\x03\x17\xc0\x25\x70\x05\x5c\x03\x37\xc0\x2d\x30\x6d\xd0\xed\x03\x0b\x70\x08\x1c\x01\xc7\xc0\x09\x70\x0a\x9c\x01\xe7\xc0\x05\x70\x09\x5c\x01\xd7\xc0\x0d\x70\x0b\x4c\x41\xdd\x3e\xb0\x00\x87\xc0\x11\x70\x0c\x9c\x00\xa7\xc0\x19\x70\x0e\x5c\x00\x97\xc0\x15\x70\x0d\xdc\x00\xb7\xc0\xb4\x51\xb7\x0f\x2c\xc0\x21\x70\x04\x1c\x03\x27\xc0\x29\x70\x06\x9c\x03\x17\xc0\x25\x70\x05\x5c\x03\x37\xc0\x2d\x30\x85\x74\xfb\xc0\x02\x1c\x02\x47\xc0\x31\x70\x02\x9c\x02\x67\xc0\x39\x70\x01\x5c\x02\x57\xc0\x35\x70\x03\xdc\x02\xd3\x88\x6e\x1f\x58\x80\x43\xe0\x08\x38\x06\x4e\x80\x53\xe0\x0c\x38\x07\x2e\x80\x4b\xe0\x0a\xb8\x06\x6e\x80\x5b\x60\xda\xa4\xdb\x07\x16\xe0\x10\x38\x02\x8e\x81\x13\xe0\x14\x38\x03\xce\x81\x0b\xe0\x12\xb8\x02\xae\x81\x1b\xe0\x16\x98\x36\xeb\xf6\x81\x05\x38\x04\x8e\x80\x63\xe0\x04\x38\x05\xce\x80\xf3\x8a\xe5\xdf\xc7\x14\xc1\x65\x70\x15\x5c\x07\x37\xc1\x6d\xb0\x7d\x89\x6e\x3f\x38\x00\x0e\x83\xa3\xe0\x38\x38\x09\x4e\x83\xb3\xe0\x3c\xb8\x08\x2e\x83\xab\xe0\x3a\xb8\x09\x6e\x83\xed\x4b\x75\xfb\xc1\x01\x70\x18\x1c\x05\xc7\xc1\x49\x70\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\x6f\xd1\xed\x07\x07\xc0\x61\x70\x14\x1c\x07\x27\xc1\x69\x70\x16\x9c\x07\x17\xc1\x65\x70\x15\x5c\x07\x37\xc1\x6d\xb0\x7d\x99\x6e\x3f\x38\x00\x0e\x83\xa3\xe0\x38\x38\x09\x4e\x83\xb3\xe0\x3c\xb8\x08\x2e\x83\xab\xe0\x3a\xb8\x09\x6e\x83\xed\xb0\x6e\x3f\x38\x00\x0e\x83\xa3\xe0\x38\x38\x09\x4e\x83\xb3\xe0\x3c\xb8\x08\x2e\x83\xab\xe0\x3a\xb8\x09\x6e\x83\xed\xcb\x75\xfb\xc1\x01\x70\x18\x1c\x05\xc7\xc1\x49\x70\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\x5f\xa1\xdb\x0f\x0e\x80\xc3\xe0\x28\x38\x0e\x4e\x82\xd3\xe0\x2c\x38\x0f\x2e\x82\xcb\xe0\x2a\xb8\x0e\x6e\x82\xdb\x60\xfb\x15\xba\xfd\xe0\x00\x38\x0c\x8e\x82\xe3\xe0\x24\x38\x0d\xce\x82\xf3\xe0\x22\xb8\x0c\xae\x82\xeb\xe0\x26\xb8\x0d\xb6\xb7\xea\xf6\x83\x03\xe0\x30\x38\x0a\x8e\x83\x93\xe0\x34\x38\x0b\xce\x83\x8b\xe0\x32\xb8\x0a\xae\x83\x9b\xe0\x36\xd8\xde\xa6\xdb\x0f\x0e\x80\xc3\xe0\x28\x38\x0e\x4e\x82\xd3\xe0\x2c\x38\x0f\x2e\x82\xcb\xe0\x2a\xb8\x0e\x6e\x82\xdb\x60\xfb\x4a\xdd\x7e\x70\x00\x1c\x06\x47\xc1\x71\x70\x12\x9c\x06\x67\xc1\x79\x70\x11\x5c\x06\x57\xc1\x75\x70\x13\xdc\x06\xdb\x57\xe9\xf6\x83\x03\xe0\x30\x38\x0a\x8e\x83\x93\xe0\x34\x38\x0b\xce\x83\x8b\xe0\x32\xb8\x0a\xae\x83\x9b\xe0\x36\xd8\x8e\xe8\xf6\x83\x03\xe0\x30\x38\x0a\x8e\x83\x93\xe0\x34\x38\x0b\xce\x83\x8b\xe0\x32\xb8\x0a\xae\x83\x9b\xe0\x36\xd8\xde\xae\xdb\x0f\x0e\x80\xc3\xe0\x28\x38\x0e\x4e\x82\xd3\xe0\x2c\x38\x0f\x2e\x82\xcb\xe0\x2a\xb8\x0e\x6e\x82\xdb\x60\xfb\x6a\xdd\x7e\x70\x00\x1c\x06\x47\xc1\x71\x70\x12\x9c\x06\x67\xc1\x79\x70\x11\x5c\x06\x57\xc1\x75\x70\x13\xdc\x06\xdb\x3b\x74\xfb\xc1\x01\x70\x18\x1c\x05\xc7\xc1\x49\x70\x1a\x9c\x05\xe7\xc1\x45\x70\x19\x5c\x05\xd7\xc1\x4d\x70\x1b\x6c\x5f\xa3\xdb\x0f\x0e\x80\xc3\xe0\x28\x38\x0e\x4e\x82\xd3\xe0\x2c\x38\x0f\x2e\x82\xcb\xe0\x2a\xb8\x0e\x6e\x82\xdb\x60\x7b\xa7\x6e\x3f\x38\x00\x0e\x83\xa3\xe0\x38\x38\x09\x4e\x83\xb3\xe0\x3c\xb8\x08\x2e\x83\xab\xe0\x3a\xb8\x09\x6e\x83\xed\x5d\xba\xfd\xe0\x00\x38\x0c\x8e\x82\xe3\x60\x3f\xc1\xe7\x87\x29\x76\xee\x17\x53\xfc\x01\xf8\xfc\x37\xd9\x9f\xff\xd2\xa3\xaf\x7f\x93\x57\x5f\x3f\xd2\xaf\xaf\x1f\x5f\xa6\xaf\x4f\xc1\x43\x63\xb7\x9e\xba\x6d\xf4\x96\x5b\x6f\x3d\x31\xf6\x3a\x0a\x9e\x1c\x4b\x9f\xa4\xe0\x89\xb1\xa3\xc1\xab\x0f\xec\x0a\x3a\xe8\x54\x38\x71\xfc\xb6\xa3\x47\xc6\x4f\x8e\xbb\x3e\x7a\xfb\x41\xcd\xe3\x27\x4f\x8c\xde\x7e\xf8\xf0\xf8\x98\xac\x72\x62\xec\xe8\xc1\xa3\xb7\x8c\x8f\x1f\x39\x7c\x64\x4c\x7e\xe3\xed\x6d\x27\xc6\xc6\xa7\x15\x8f\x71\x69\x77\x7d\xf7\xe1\xd1\x23\xc7\xc7\x3a\x96\xfd\xe0\xfe\x1d\x3a\xd4\x5d\x7e\xe4\xf8\xe1\xdb\xcf\xb2\x8d\xd1\x13\x47\x8e\xdf\x76\xeb\xa9\xc3\x33\x6f\xab\xbb\x74\xf4\xe8\x91\x83\x63\xc7\xc7\xc7\xd4\x2d\xc8\xad\xaa\x3e\x7c\xe2\x96\x63\x63\x33\x7f\xb5\x6e\x70\xfc\xe4\x89\x93\xb7\xdc\x4a\xc1\xf1\x37\x1c\x93\xbf\x8f\xdd\x92\x1a\xdf\x78\xc3\xee\xbd\xd7\x5e\x7d\xe3\xae\xd1\x3d\xfb\xf6\x1f\xe8\x94\xec\xda\xb3\xef\x77\xf7\xf3\xc3\xdd\x7b\x0e\xec\xbc\x61\x34\xb6\xef\x86\x03\x7a\xc9\x8e\xdd\xd7\xdc\x00\x75\x6e\xd8\x77\x60\xdf\x8e\x7d\x7b\xb8\x74\xe7\xab\x76\xee\x3d\xb0\x7f\xf4\x77\xb6\xc7\x3a\xde\xbf\xfd\x77\x62\x7b\x76\xef\xbd\xb6\xa3\x1d\xfb\xf6\xee\xda\x7d\xad\x56\x93\xfb\xd1\x3d\x88\xb4\xe7\xea\xab\x37\x8f\x5e\x2e\x7f\x6d\xea\xfc\x1a\xe9\xfc\x0a\x75\x7e\x6d\x1e\xbd\xdc\x5d\xe8\x2e\x75\x17\xf3\xf2\x91\x30\x57\xe0\x07\x23\xee\x83\x90\xfb\x60\xf3\xe8\xc8\x65\x6e\x9d\xcb\xdc\x3a\x97\xb9\x75\x2e\xe3\x3a\x5b\xb8\xca\x16\xae\xb1\x85\x2b\x6c\x71\xdb\x70\x2b\x8c\xb8\x35\xf8\x41\xc8\x7d\xb0\x79\xf4\x52\xae\x72\x29\xd7\xb8\x94\x2b\x5c\xea\xb6\xe1\x56\x18\x71\x6b\xf0\x83\x90\xfb\x60\xf3\xe8\xc8\x26\xb7\xce\x26\xb7\xce\x26\xb7\xce\x26\xae\xb3\x69\x84\xeb\xf0\x83\xcd\xa3\x23\x6e\x09\x3f\x18\x71\x1f\x84\xdc\x07\x9b\x47\x37\x85\xdc\xb5\x42\xee\x5a\x97\x87\x79\xad\xce\x83\xcd\xa3\x97\x71\x41\xe7\xf7\x08\x3b\xc4\xbf\xe5\x48\xba\x6b\xb8\x35\xf8\x41\xc8\x7d\x20\x47\xca\xad\xb3\xc5\xad\xb3\xc5\xad\xb3\x85\xeb\x5c\xc2\x55\x2e\xe1\x1a\x97\x70\x85\x4b\x78\xf9\x66\x5e\xbe\x99\x97\x6f\xe6\xe5\x9b\x79\xf9\x88\x5b\x81\x1f\x8c\xb8\x0f\x42\xee\x83\xcd\xa3\x9b\xb8\xca\x26\xae\xb1\x89\x2b\x6c\x72\xdb\x18\x71\xdb\x18\x71\xdb\x18\x09\xd3\x9e\xab\xff\x7f\xd2\xce\xd8\xd5\x8e\x22\x8c\xe2\xb3\xfa\x1e\x3c\x35\x92\x42\x84\xa8\x41\x82\x11\x9e\x45\x0c\x33\xf3\xed\xcc\xde\x5b\x08\x12\x50\xde\x3f\x20\x6a\xa1\xfb\x9e\x88\xa4\x10\x0c\x92\xc2\x3a\x26\xf1\x29\x09\x04\x6b\x2d\xec\xa2\x46\xb0\xb0\x49\x50\x48\x25\xfa\x1f\x3c\x44\xb4\xb1\x88\x5d\xc0\x22\x85\x85\xb2\xd9\xdf\xb9\xb9\xbb\x77\x47\x2f\xe4\x5e\xc8\x9e\xfd\xce\xef\xcc\x97\x5d\x66\x27\x2f\x90\xcc\x9e\xf2\xaa\x58\x3b\x6b\x3a\x27\x72\x0c\x1c\x3d\x47\x6b\x83\x00\x44\x90\xf0\x12\xd6\x66\x90\x0c\x91\x01\xb2\xc6\x48\x1a\x23\x69\x8c\xa4\x31\x12\x63\x84\x5a\x4c\x2d\xa6\x16\x53\xc3\x18\x88\x41\x18\x80\x69\x8c\xa8\x31\xa2\xc6\x88\x1a\x23\x32\x46\x10\x22\x42\x00\x7e\x14\x80\xb0\x36\x78\x45\xbc\x32\x5e\x21\x2f\x66\x9e\x61\x7a\x61\x6d\x43\xa1\x3f\x06\xce\x3d\xc7\xee\xf7\xa1\x84\x08\x84\x97\xb0\x36\x81\x24\x88\x04\x90\xf0\x6b\xfc\x1a\xbf\xc6\xaf\xf1\x0d\xdf\xf0\x0d\xdf\xf0\x83\x00\x44\x90\xf0\x12\xd6\x46\x90\x08\x11\x01\xa2\xc6\x10\x10\x44\x20\xbc\x44\xf7\x5c\x6a\x10\x4f\x25\x71\x61\x5c\x17\x97\xc5\x7a\x88\x39\x4f\xac\x87\x89\xf5\x30\xf5\xeb\x61\x83\xdf\xe0\x37\xf8\x0d\x7e\xc6\xcf\xf8\x19\x3f\xe3\x07\x01\x88\x20\xe1\x25\xba\xd9\x28\xa6\x16\x53\x8b\xa9\x61\x0c\xc4\x20\x0c\xc0\x34\x46\xd4\x18\x51\x63\x44\x8d\x11\x19\x23\x08\x11\x21\x00\x3f\x0a\x40\x74\xcf\xb4\x22\x8b\xcc\x22\xb4\xa8\xcc\x6a\x2a\xbd\xb0\x36\xd7\xdc\x93\x9a\x7b\x02\x90\xf1\x13\x7e\xc2\x4f\xf8\x09\xbf\xc6\xaf\xf1\x6b\xfc\x1a\xdf\xf0\x0d\xdf\xf0\x4d\x3e\x36\x2e\x26\x2b\x90\xb1\x02\x19\x2b\x90\xb1\x02\x99\x56\x20\x00\x84\xb5\x0d\x85\x86\x44\x43\xa2\x51\x42\x40\x10\x81\xf0\x12\xd6\x66\x90\x0c\x91\x01\x32\x7e\xc2\x4f\xf8\x09\x3f\xe1\x87\x1a\x00\x11\x24\xbc\x84\xb5\x11\x24\x42\x44\x80\x28\x3f\x08\x08\xaa\x78\x55\x3c\x95\xa0\x0a\x22\x48\x78\x89\x6e\xf5\x89\x30\xf3\x48\x65\xa6\xca\x8c\x4a\xa6\xd0\x1f\x03\xe7\x9e\x63\x37\x13\x95\x48\x10\x08\x2f\x61\xad\x81\x18\x84\x01\x98\xc6\x10\x10\x44\x20\xbc\x44\x77\xcd\x30\x08\x6b\xe7\x81\xe7\x3d\xf0\xbc\x07\x9e\x77\xce\xc3\x8c\x02\xc2\xda\x86\x44\x43\xa2\x21\xd1\xe0\x07\x01\x88\x20\xe1\x25\xac\xcd\x20\x19\x22\x03\x64\xfc\x84\x9f\xf0\x13\x7e\xc2\x0f\x35\x00\x22\x48\x78\x09\x6b\x0d\xc4\x20\x0c\xc0\x34\x86\x80\x20\x02\xe1\x25\xba\xfb\xa5\xdb\xc5\x1c\x02\x88\xf2\x17\x80\x2a\x41\x15\x44\x90\xf0\x12\xdd\x2a\x02\x22\x42\x00\x7e\x14\x80\xe8\x12\x8b\xc8\x22\xb3\x08\x05\x77\xf2\xfd\xf7\xde\xde\x3b\xbb\xd7\xfd\x68\x1e\x4e\x0a\x98\x7b\x80\x5e\x58\x9b\x3d\x37\xdd\x73\xd3\x01\x32\x7e\x10\x80\x08\x12\x5e\xc2\xda\x04\x92\x20\x12\x40\xc2\x37\x7c\xc3\x37\x7c\xc3\x8f\x11\x00\xd1\x3d\x74\xaa\xe8\x9f\x0f\xdd\xf7\x27\x55\x8b\xad\x1a\x07\xdf\xfd\xbf\xfb\xbf\x30\x7e\xfa\xe8\xa0\xec\xaa\xc1\x59\xbf\xcd\x4b\x75\x6f\xbb\xbb\xc5\xf7\xc5\xc1\xd9\xbd\xcf\xc6\xe0\xcc\xb9\xd7\xff\x27\x3f\x7e\xaf\xc7\xd6\xe0\xcc\xb9\x57\xd8\x6a\x6c\xdc\xef\x2a\x1b\x4e\x6b\x1f\xd3\x6d\xb6\xa4\x54\x9e\xed\x4a\xdc\x99\x42\xff\x6f\x8f\x0e\xdf\x2b\x51\xea\xff\x6e\xa1\xff\xe9\x2f\x57\xfb\x6f\x4e\xf4\xff\xa1\xd0\xff\xc8\x7c\xf8\xfe\x8a\x52\xff\xeb\x85\xfe\xcf\x7d\xd5\xf7\xd7\xbe\xaa\xdb\x6c\xc9\x37\xee\xff\x6b\xa1\xff\x95\x37\x86\xef\xbd\x28\xf5\x3f\x28\xf4\x7f\xed\xeb\xd5\xfe\x0f\x4d\xf4\xff\xac\xea\xfb\x8f\xe7\xa0\x3f\xc7\x7d\xa8\x06\xe5\x95\xf9\xf3\x63\x21\xbf\xf3\xe1\x7a\xf9\x97\x0a\xf9\xdd\xf3\xeb\xe5\x6f\x14\xf2\x1f\x5c\x58\x2f\xff\x6a\x21\x7f\xe5\xe2\x7a\xf9\xb3\x85\xfc\xd5\x8f\xd6\xcb\xbf\x55\xc8\xdf\xdc\x5f\x2f\x7f\xb1\x90\x3f\xf8\x78\xbd\xfc\xb5\x42\xfe\xf6\x27\xeb\xe5\xef\xb8\xe9\xfc\xd6\xa5\x69\x7e\x34\x9c\xfb\xf9\x50\x5f\x8b\x83\xaa\x73\x87\xc8\x1f\xae\xa6\xf3\x3a\xc6\x25\xbd\xfc\x79\xea\x32\xd7\x71\x62\x50\x5e\x61\xab\x89\x5a\xf7\x82\xb9\x47\x6e\xf0\x3e\xec\x07\x56\xf9\xe5\xef\x37\x85\xfc\xa5\xef\xef\x1e\xdc\xf3\xc7\x07\xe5\x15\xf6\x99\x42\xfe\xfa\xef\xbc\x5f\x69\x73\x50\x5e\x61\x4f\x15\xf2\x07\x7f\xf4\xf9\x13\x1b\xff\x9d\x3f\x47\x7e\xfc\xe7\xd9\xc6\x9f\xbc\xb7\xf3\xe1\x41\xd9\x55\xa3\xe3\x17\x4b\x7a\xf9\xfb\xe6\x5f\xdd\xaf\xce\xdd\x1a\x4d\x8c\x31\xfb\x79\x61\xfd\xda\xbf\xd6\xaf\x5f\xda\x07\xb4\x5b\xbf\x8e\x4e\xac\x5f\xbf\x31\x7f\xc7\xdf\x9d\x3b\xbc\x87\x6a\x74\xff\xc7\xf3\xf1\x97\x6a\xba\xff\xce\x77\x7d\x7f\xed\xd3\xdd\xf5\x7f\x7a\xa2\xff\x13\x85\xeb\xdf\x7f\xb2\xaf\x9e\x7e\x79\x50\x5e\x79\x1e\x1e\x2f\x5c\xff\x2d\xfa\x1f\x23\xdf\xf5\x3f\x36\xd1\xff\xb1\xc2\xf5\xdf\x66\x83\xee\x33\xc3\xb2\xdb\x1a\x9e\xba\xc3\x85\xeb\xdf\xe2\xe7\x0f\xd5\xb7\x99\xab\xe3\xfe\x0f\x16\xfa\xef\xee\xf5\xf9\xdd\xe3\x43\x73\xcc\xfe\x53\xb8\xfe\x9d\x89\xfe\xcf\x4e\xf4\xbf\x5c\x98\xbf\x37\xcf\x57\x77\xe7\xef\x66\x35\xdd\x5f\xc7\x17\x2a\xf6\x8b\x1b\x7d\x7f\xba\xd0\x13\xfa\x7f\xb7\xdd\xd9\x3b\x4b\xef\xa1\x39\xe2\x9c\x73\xce\xb9\x7f\x07\x00\x64\x11\xa5\x8e\x20\x13\x02\x00")
 
 func tcptracerSockEbpfOBytes() ([]byte, error) {
 	return bindataRead(
@@ -83,7 +83,7 @@ func tcptracerSockEbpfO() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "tcptracer-sock-ebpf.o", size: 14720, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "tcptracer-sock-ebpf.o", size: 135968, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
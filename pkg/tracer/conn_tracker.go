@@ -0,0 +1,85 @@
+package tracer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// connIdleTimeout is the time after which
+// the state of an idle connection is dropped
+const connIdleTimeout = 5 * time.Minute
+
+// connTracker remembers which side started a connection.
+// TCP connections are started by the SYN, UDP flows
+// by the first packet which is observed.
+type connTracker struct {
+	mu         sync.Mutex
+	timeout    time.Duration
+	lastExpire time.Time
+	// conns are keyed by the client to server direction
+	conns map[flowKey]time.Time
+}
+
+func newConnTracker(timeout time.Duration) *connTracker {
+	return &connTracker{
+		timeout: timeout,
+		conns:   make(map[flowKey]time.Time),
+	}
+}
+
+// trackTCP returns true if the segment was sent by the server.
+// It returns nil if the start of the connection was not observed.
+func (t *connTracker) trackTCP(key flowKey, now time.Time, syn, ack, rst bool) *wrappers.BoolValue {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.maybeExpire(now)
+	if syn && !ack {
+		t.conns[key] = now
+	}
+	reply := t.lookup(key, now)
+	if rst {
+		delete(t.conns, key)
+		delete(t.conns, key.reverse())
+	}
+	return reply
+}
+
+// trackUDP returns true if the datagram was sent by the server.
+// The sender of the first datagram of a flow is the client.
+func (t *connTracker) trackUDP(key flowKey, now time.Time) *wrappers.BoolValue {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.maybeExpire(now)
+	if reply := t.lookup(key, now); reply != nil {
+		return reply
+	}
+	t.conns[key] = now
+	return &wrappers.BoolValue{Value: false}
+}
+
+func (t *connTracker) lookup(key flowKey, now time.Time) *wrappers.BoolValue {
+	if _, ok := t.conns[key]; ok {
+		t.conns[key] = now
+		return &wrappers.BoolValue{Value: false}
+	}
+	if _, ok := t.conns[key.reverse()]; ok {
+		t.conns[key.reverse()] = now
+		return &wrappers.BoolValue{Value: true}
+	}
+	return nil
+}
+
+// maybeExpire drops idle connections
+func (t *connTracker) maybeExpire(now time.Time) {
+	if now.Sub(t.lastExpire) < t.timeout {
+		return
+	}
+	t.lastExpire = now
+	for key, lastSeen := range t.conns {
+		if now.Sub(lastSeen) > t.timeout {
+			delete(t.conns, key)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package tracer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConnTracker(t *testing.T) {
+	tracker := newConnTracker(time.Minute)
+	now := time.Now()
+	client := flowKey{srcIP: "10.0.0.1", dstIP: "10.0.0.2", srcPort: 40000, dstPort: 8080}
+	server := client.reverse()
+
+	if reply := tracker.trackTCP(client, now, false, true, false); reply != nil {
+		t.Errorf("unexpected reply for unknown connection: %v", reply)
+	}
+	for i, row := range []struct {
+		key      flowKey
+		syn      bool
+		ack      bool
+		rst      bool
+		expected bool
+	}{
+		{key: client, syn: true},
+		{key: server, syn: true, ack: true, expected: true},
+		{key: client, ack: true},
+		{key: server, ack: true, expected: true},
+		{key: server, ack: true, rst: true, expected: true},
+	} {
+		reply := tracker.trackTCP(row.key, now, row.syn, row.ack, row.rst)
+		if reply == nil || reply.Value != row.expected {
+			t.Errorf("[%d] unexpected reply. expected %v, got %v", i, row.expected, reply)
+		}
+	}
+	if reply := tracker.trackTCP(client, now, false, true, false); reply != nil {
+		t.Errorf("unexpected reply after reset: %v", reply)
+	}
+
+	if reply := tracker.trackUDP(client, now); reply == nil || reply.Value {
+		t.Errorf("unexpected reply for first datagram: %v", reply)
+	}
+	if reply := tracker.trackUDP(server, now); reply == nil || !reply.Value {
+		t.Errorf("unexpected reply for response: %v", reply)
+	}
+	// expired: the response is the first datagram
+	if reply := tracker.trackUDP(server, now.Add(2*time.Minute)); reply == nil || reply.Value {
+		t.Errorf("unexpected reply after expiry: %v", reply)
+	}
+}
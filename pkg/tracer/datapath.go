@@ -14,7 +14,31 @@ import (
 	"github.com/vishvananda/netlink"
 )
 
-func compileAndLoad() (*ebpf.Collection, error) {
+// hooks maps the eBPF programs to the clsact hooks
+var hooks = map[string]uint32{
+	"ingress": netlink.HANDLE_MIN_INGRESS,
+	"egress":  netlink.HANDLE_MIN_EGRESS,
+}
+
+// configMapName is the map of struct tracer_config
+const configMapName = "CONFIG"
+
+// tracerConfig is struct tracer_config of the eBPF programs
+type tracerConfig struct {
+	Aggregate uint32
+	// the union of the actions of each filter map
+	CIDRFilters     uint32
+	PortFilters     uint32
+	ProtocolFilters uint32
+	SampleRate      uint32
+	_               uint32
+	// the cost of a sample and the upper limit of
+	// the credit of a flow in nanoseconds
+	FlowTokenNs uint64
+	FlowBurstNs uint64
+}
+
+func compileAndLoad(buffers BufferOptions, aggregation AggregationOptions, sampling SamplingOptions) (*ebpf.Collection, error) {
 	buf, err := Asset("tcptracer-sock-ebpf.o")
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't find asset")
@@ -23,8 +47,23 @@ func compileAndLoad() (*ebpf.Collection, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error loading collection spec")
 	}
-	// change max size according to num cpus
-	spec.Maps["EVENTS_MAP"].MaxEntries = uint32(runtime.NumCPU())
+	selectOutput(spec, buffers)
+	if perfMap := spec.Maps[perfMapName]; perfMap != nil {
+		// change max size according to num cpus
+		perfMap.MaxEntries = uint32(runtime.NumCPU())
+	}
+	if flows := spec.Maps[flowsMapName]; flows != nil {
+		flows.MaxEntries = 1
+		if aggregation.Enabled {
+			flows.MaxEntries = uint32(aggregation.MaxFlows)
+		}
+	}
+	if state := spec.Maps[samplingMapName]; state != nil {
+		state.MaxEntries = 1
+		if sampling.enabled() {
+			state.MaxEntries = defaultMaxFlows
+		}
+	}
 	coll, err := ebpf.NewCollection(spec)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating collection")
@@ -32,11 +71,28 @@ func compileAndLoad() (*ebpf.Collection, error) {
 	return coll, nil
 }
 
-func replaceDatapath(coll *ebpf.Collection, ifacePrefix string) error {
+// writeConfig configures the programs before they are attached
+func writeConfig(coll *ebpf.Collection, config tracerConfig) error {
+	m := coll.Maps[configMapName]
+	if m == nil {
+		if config == (tracerConfig{}) {
+			// the defaults of an object without config
+			return nil
+		}
+		return errors.New("the eBPF object has no config map")
+	}
+	return m.Put(uint32(0), config)
+}
+
+// replaceDatapath attaches the programs to the matching links.
+// Links which have the programs attached already are skipped.
+// The links whose clsact qdisc is added are recorded in addedQdiscs.
+func replaceDatapath(coll *ebpf.Collection, ifacePrefix, instance string, addedQdiscs map[int]bool) error {
 	links, err := netlink.LinkList()
 	if err != nil {
 		return errors.Wrap(err, "error loading link list")
 	}
+	resetIfnames(links)
 
 	for _, link := range links {
 		attrs := link.Attrs()
@@ -48,29 +104,45 @@ func replaceDatapath(coll *ebpf.Collection, ifacePrefix string) error {
 			log.Debugf("skipping link: %s", attrs.Name)
 			continue
 		}
-		err = replaceQdisc(link)
+		err = attachLink(coll, link, instance, addedQdiscs)
 		if err != nil {
-			log.Errorf("error creating qdisc for %s: %s", attrs.Name, err)
-			continue
+			log.Error(err)
 		}
-		log.Infof("created qdisc for: %s", attrs.Name)
-		prog := coll.Programs["ingress"]
+	}
+	return nil
+}
+
+// attachLink attaches the programs to the clsact hooks of a link
+func attachLink(coll *ebpf.Collection, link netlink.Link, instance string, addedQdiscs map[int]bool) error {
+	attrs := link.Attrs()
+	added, err := ensureQdisc(link)
+	if err != nil {
+		return errors.Wrapf(err, "error creating qdisc for %s", attrs.Name)
+	}
+	if added {
+		addedQdiscs[attrs.Index] = true
+	}
+	var errs []string
+	for name, parent := range hooks {
+		prog := coll.Programs[name]
 		if prog == nil {
-			return fmt.Errorf("ingress program is missing")
+			return fmt.Errorf("%s program is missing", name)
 		}
-		err = createFilter(
-			prog,
-			link,
-			netlink.HANDLE_MIN_EGRESS,
-		)
+		err = createFilter(prog, link, parent, filterName(name, instance))
 		if err != nil {
-			log.Errorf("error creating qdisc filter for %s: %s", attrs.Name, err.Error())
+			errs = append(errs, fmt.Sprintf("error creating %s qdisc filter for %s: %s", name, attrs.Name, err))
 		}
 	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
 	return nil
 }
 
-func resetDatapath(coll *ebpf.Collection, ifacePrefix string) error {
+// resetDatapath detaches the programs from the matching links.
+// The clsact qdiscs in addedQdiscs are deleted if no other filters
+// use them, qdiscs which existed before are left in place.
+func resetDatapath(ifacePrefix string, addedQdiscs map[int]bool) error {
 	var errs []string
 	links, err := netlink.LinkList()
 	if err != nil {
@@ -86,11 +158,18 @@ func resetDatapath(coll *ebpf.Collection, ifacePrefix string) error {
 			log.Debugf("cleanup: skipping %s", attrs.Name)
 			continue
 		}
-		err = deleteQdisc(link)
-		if err != nil {
-			errs = append(errs, err.Error())
+		for name, parent := range hooks {
+			if err := deleteFilter(link, parent); err != nil {
+				errs = append(errs, fmt.Sprintf("%s %s: %s", attrs.Name, name, err))
+			}
+		}
+		if !addedQdiscs[attrs.Index] {
 			continue
 		}
+		if err := deleteUnusedQdisc(link); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", attrs.Name, err))
+		}
+		delete(addedQdiscs, attrs.Index)
 	}
 	if len(errs) > 0 {
 		return fmt.Errorf(strings.Join(errs, ", "))
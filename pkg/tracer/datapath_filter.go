@@ -0,0 +1,220 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/ebpf"
+)
+
+const (
+	cidrFiltersMapName     = "FILTER_CIDRS"
+	portFiltersMapName     = "FILTER_PORTS"
+	protocolFiltersMapName = "FILTER_PROTOCOLS"
+	// actions of the filter maps
+	filterInclude = 1
+	filterExclude = 2
+	// cidrKeyLen is the size of struct cidr_key
+	cidrKeyLen = 24
+)
+
+// FilterOptions selects the packets which are sampled by the eBPF programs.
+// A packet is dropped if its source or destination is excluded. If a filter
+// has includes, the source or destination must be included. The longest
+// matching CIDR applies, so includes and excludes can be nested.
+type FilterOptions struct {
+	// IncludeCIDRs and ExcludeCIDRs are CIDRs or single addresses
+	IncludeCIDRs []string
+	ExcludeCIDRs []string
+	// IncludePorts and ExcludePorts match TCP and UDP ports.
+	// Packets without ports do not match included ports.
+	IncludePorts []string
+	ExcludePorts []string
+	// IncludeProtocols and ExcludeProtocols are
+	// tcp, udp, icmp, icmpv6 or protocol numbers
+	IncludeProtocols []string
+	ExcludeProtocols []string
+}
+
+// filterEntries are the values of a filter map by key
+type filterEntries map[string]byte
+
+func (e filterEntries) add(key []byte, action byte, value string) error {
+	if existing, ok := e[string(key)]; ok && existing != action {
+		return fmt.Errorf("%s is included and excluded", value)
+	}
+	e[string(key)] = action
+	return nil
+}
+
+// actions returns the union of the actions, it is
+// the value of the filter in struct tracer_config
+func (e filterEntries) actions() uint32 {
+	var actions uint32
+	for _, action := range e {
+		actions |= uint32(action)
+	}
+	return actions
+}
+
+// datapathFilters are the entries of the filter maps
+type datapathFilters struct {
+	cidrs     filterEntries
+	ports     filterEntries
+	protocols filterEntries
+}
+
+func newDatapathFilters(opts FilterOptions) (*datapathFilters, error) {
+	f := &datapathFilters{
+		cidrs:     make(filterEntries),
+		ports:     make(filterEntries),
+		protocols: make(filterEntries),
+	}
+	for _, filter := range []struct {
+		entries filterEntries
+		values  []string
+		action  byte
+		key     func(string) ([]byte, error)
+	}{
+		{f.cidrs, opts.IncludeCIDRs, filterInclude, cidrKey},
+		{f.cidrs, opts.ExcludeCIDRs, filterExclude, cidrKey},
+		{f.ports, opts.IncludePorts, filterInclude, portKey},
+		{f.ports, opts.ExcludePorts, filterExclude, portKey},
+		{f.protocols, opts.IncludeProtocols, filterInclude, protocolKey},
+		{f.protocols, opts.ExcludeProtocols, filterExclude, protocolKey},
+	} {
+		for _, value := range filter.values {
+			key, err := filter.key(value)
+			if err != nil {
+				return nil, err
+			}
+			err = filter.entries.add(key, filter.action, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return f, nil
+}
+
+// cidrKey returns struct cidr_key. The prefix
+// starts with the ip version of the address.
+func cidrKey(value string) ([]byte, error) {
+	ip, ipnet, err := net.ParseCIDR(value)
+	if err != nil {
+		ip = net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid cidr %q", value)
+		}
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			bits = 8 * net.IPv4len
+		}
+		ipnet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+	key := make([]byte, cidrKeyLen)
+	ones, bits := ipnet.Mask.Size()
+	binary.LittleEndian.PutUint32(key[0:4], uint32(8+ones))
+	if ip4 := ipnet.IP.To4(); ip4 != nil && bits == 8*net.IPv4len {
+		key[4] = 4
+		copy(key[5:], ip4)
+	} else {
+		key[4] = 6
+		copy(key[5:], ipnet.IP.To16())
+	}
+	return key, nil
+}
+
+// portKey returns the port in host byte order
+func portKey(value string) ([]byte, error) {
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid port %q", value)
+	}
+	key := make([]byte, 2)
+	binary.LittleEndian.PutUint16(key, uint16(port))
+	return key, nil
+}
+
+// protocolNumbers are the names of the trace filters,
+// icmp is an alias of icmpv4
+var protocolNumbers = map[string]byte{
+	"icmp":   1,
+	"icmpv4": 1,
+	"tcp":    6,
+	"udp":    17,
+	"icmpv6": 58,
+}
+
+func protocolKey(value string) ([]byte, error) {
+	if proto, ok := protocolNumbers[strings.ToLower(value)]; ok {
+		return []byte{proto}, nil
+	}
+	proto, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protocol %q", value)
+	}
+	return []byte{byte(proto)}, nil
+}
+
+// writeFilters replaces the entries of the filter maps and returns the
+// config which enables them. The maps are updated in place while the
+// programs are running: a packet may briefly see a mix of both filters.
+func writeFilters(coll *ebpf.Collection, config tracerConfig, opts FilterOptions) (tracerConfig, error) {
+	filters, err := newDatapathFilters(opts)
+	if err != nil {
+		return config, err
+	}
+	for _, filter := range []struct {
+		name    string
+		entries filterEntries
+		config  *uint32
+	}{
+		{cidrFiltersMapName, filters.cidrs, &config.CIDRFilters},
+		{portFiltersMapName, filters.ports, &config.PortFilters},
+		{protocolFiltersMapName, filters.protocols, &config.ProtocolFilters},
+	} {
+		m := coll.Maps[filter.name]
+		if m == nil {
+			if len(filter.entries) > 0 {
+				return config, fmt.Errorf("the eBPF object has no map %s", filter.name)
+			}
+			continue
+		}
+		err := syncFilterMap(m, filter.entries)
+		if err != nil {
+			return config, fmt.Errorf("failed to write %s: %s", filter.name, err)
+		}
+		*filter.config = filter.entries.actions()
+	}
+	return config, nil
+}
+
+// syncFilterMap deletes the stale entries of the map and writes the others
+func syncFilterMap(m *ebpf.Map, entries filterEntries) error {
+	var stale [][]byte
+	var key, value []byte
+	iter := m.Iterate()
+	for iter.Next(&key, &value) {
+		if _, ok := entries[string(key)]; !ok {
+			stale = append(stale, append([]byte(nil), key...))
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	for _, key := range stale {
+		if err := m.Delete(key); err != nil {
+			return err
+		}
+	}
+	for key, action := range entries {
+		if err := m.Put([]byte(key), []byte{action}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatapathFilters(t *testing.T) {
+	// hex encoded keys and their actions
+	type entries map[string]byte
+	for i, row := range []struct {
+		opts      FilterOptions
+		cidrs     entries
+		ports     entries
+		protocols entries
+		err       string
+	}{
+		{
+			opts:      FilterOptions{},
+			cidrs:     entries{},
+			ports:     entries{},
+			protocols: entries{},
+		},
+		{
+			opts: FilterOptions{
+				IncludeCIDRs:     []string{"10.0.0.0/8", "fd00::/64"},
+				ExcludeCIDRs:     []string{"10.1.2.3"},
+				IncludePorts:     []string{"80"},
+				ExcludePorts:     []string{"10250"},
+				IncludeProtocols: []string{"TCP", "17"},
+				ExcludeProtocols: []string{"icmpv6"},
+			},
+			cidrs: entries{
+				"10000000040a000000000000000000000000000000000000": filterInclude,
+				"28000000040a010203000000000000000000000000000000": filterExclude,
+				"4800000006fd000000000000000000000000000000000000": filterInclude,
+			},
+			ports: entries{
+				"5000": filterInclude,
+				"0a28": filterExclude,
+			},
+			protocols: entries{
+				"06": filterInclude,
+				"11": filterInclude,
+				"3a": filterExclude,
+			},
+		},
+		{
+			opts: FilterOptions{
+				IncludeProtocols: []string{"icmpv4", "ICMP"},
+			},
+			cidrs: entries{},
+			ports: entries{},
+			protocols: entries{
+				"01": filterInclude,
+			},
+		},
+		{
+			opts: FilterOptions{IncludeCIDRs: []string{"10.0.0.0/33"}},
+			err:  `invalid cidr "10.0.0.0/33"`,
+		},
+		{
+			opts: FilterOptions{ExcludePorts: []string{"0"}},
+			err:  `invalid port "0"`,
+		},
+		{
+			opts: FilterOptions{IncludeProtocols: []string{"sctp"}},
+			err:  `invalid protocol "sctp"`,
+		},
+		{
+			opts: FilterOptions{IncludePorts: []string{"443"}, ExcludePorts: []string{"443"}},
+			err:  "443 is included and excluded",
+		},
+	} {
+		filters, err := newDatapathFilters(row.opts)
+		if row.err != "" {
+			if err == nil || err.Error() != row.err {
+				t.Errorf("[%d] expected error %q, got %v", i, row.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %s", i, err)
+			continue
+		}
+		for _, m := range []struct {
+			name     string
+			entries  filterEntries
+			expected entries
+		}{
+			{"cidrs", filters.cidrs, row.cidrs},
+			{"ports", filters.ports, row.ports},
+			{"protocols", filters.protocols, row.protocols},
+		} {
+			actual := make(entries)
+			for key, action := range m.entries {
+				actual[hex.EncodeToString([]byte(key))] = action
+			}
+			if diff := cmp.Diff(m.expected, actual); diff != "" {
+				t.Errorf("[%d] unexpected %s: %s", i, m.name, diff)
+			}
+		}
+	}
+}
+
+func TestCIDRKey(t *testing.T) {
+	for i, row := range []struct {
+		cidr      string
+		prefixlen uint32
+		version   byte
+		addr      []byte
+	}{
+		{"10.0.0.0/8", 16, 4, []byte{10, 0, 0, 0}},
+		{"192.168.1.1", 40, 4, []byte{192, 168, 1, 1}},
+		{"fd00::/64", 72, 6, []byte{0xfd, 0, 0, 0, 0, 0, 0, 0}},
+		{"fd00::1", 136, 6, []byte{0xfd, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}},
+	} {
+		key, err := cidrKey(row.cidr)
+		if err != nil {
+			t.Errorf("[%d] unexpected error: %s", i, err)
+			continue
+		}
+		if len(key) != cidrKeyLen {
+			t.Errorf("[%d] unexpected key length %d", i, len(key))
+			continue
+		}
+		if prefixlen := binary.LittleEndian.Uint32(key[0:4]); prefixlen != row.prefixlen {
+			t.Errorf("[%d] unexpected prefix length %d", i, prefixlen)
+		}
+		if key[4] != row.version {
+			t.Errorf("[%d] unexpected ip version %d", i, key[4])
+		}
+		if diff := cmp.Diff(row.addr, key[5:5+len(row.addr)]); diff != "" {
+			t.Errorf("[%d] unexpected address: %s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// +build linux
+
+package tracer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cilium/ebpf"
+)
+
+// TestAsset checks that the embedded eBPF object
+// has the programs and maps which are used by the tracer
+func TestAsset(t *testing.T) {
+	buf, err := Asset("tcptracer-sock-ebpf.o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := ebpf.LoadCollectionSpecFromReader(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var programs []string
+	for hook := range hooks {
+		programs = append(programs, hook, hook+ringbufProgramSuffix)
+	}
+	maps := []string{
+		perfMapName,
+		ringbufMapName,
+		ringbufLostSamplesMapName,
+		configMapName,
+		flowsMapName,
+		cidrFiltersMapName,
+		portFiltersMapName,
+		protocolFiltersMapName,
+		samplingMapName,
+	}
+	for _, name := range programs {
+		if spec.Programs[name] == nil {
+			t.Errorf("program %s is missing", name)
+		}
+	}
+	for _, name := range maps {
+		if spec.Maps[name] == nil {
+			t.Errorf("map %s is missing", name)
+		}
+	}
+}
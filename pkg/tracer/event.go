@@ -5,24 +5,70 @@ package tracer
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
+
+	pb "github.com/moolen/juno/proto"
+	"golang.org/x/sys/unix"
 )
 
+// TraceMetadata is the metadata the eBPF program
+// puts in front of every packet sample
 type TraceMetadata struct {
-	Ifname string
-	SKBLen uint16
+	Ifname    string
+	SKBLen    uint16
+	Time      time.Time
+	Direction pb.TrafficDirection
+	// SampleRate is the number of packets of the flow
+	// which are represented by the sample
+	SampleRate uint32
+}
+
+// metadataLen is the size of struct trace_metadata
+const metadataLen = 21
+
+// directions are the tc hooks of the eBPF program
+var directions = map[byte]pb.TrafficDirection{
+	0: pb.TrafficDirection_INGRESS,
+	1: pb.TrafficDirection_EGRESS,
 }
 
 // ErrInvalidDataLen indicates that the delivered frame had a invalid length
 var ErrInvalidDataLen = fmt.Errorf("invalid data length")
 
+// bootTime is the wall clock time at which the monotonic clock started.
+// bpf_ktime_get_ns() returns nanoseconds since then.
+var bootTime = monotonicBootTime()
+
+func monotonicBootTime() time.Time {
+	var ts unix.Timespec
+	now := time.Now()
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return now
+	}
+	return now.Add(-time.Duration(ts.Nano()))
+}
+
+// ktimeToTime converts a bpf_ktime_get_ns() timestamp to wall clock time
+func ktimeToTime(ns uint64) time.Time {
+	return bootTime.Add(time.Duration(ns))
+}
+
 func perfEventToGo(data []byte) (*TraceMetadata, []byte, error) {
-	if len(data) < 6 {
+	if len(data) < metadataLen {
 		return nil, nil, ErrInvalidDataLen
 	}
-	metadata := data[:6]
-	skb := data[6:]
+	metadata := data[:metadataLen]
+	skb := data[metadataLen:]
+	// perf samples are padded, ring buffer
+	// records are reserved with a fixed size
+	if capLen := int(binary.LittleEndian.Uint16(metadata[15:17])); capLen < len(skb) {
+		skb = skb[:capLen]
+	}
 	return &TraceMetadata{
-		Ifname: ifname(int(binary.LittleEndian.Uint32(metadata[0:4]))),
-		SKBLen: binary.LittleEndian.Uint16(metadata[4:]),
+		Ifname:     ifname(int(binary.LittleEndian.Uint32(metadata[0:4]))),
+		SKBLen:     binary.LittleEndian.Uint16(metadata[4:6]),
+		Time:       ktimeToTime(binary.LittleEndian.Uint64(metadata[6:14])),
+		Direction:  directions[metadata[14]],
+		SampleRate: binary.LittleEndian.Uint32(metadata[17:21]),
 	}, skb, nil
 }
@@ -0,0 +1,58 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	pb "github.com/moolen/juno/proto"
+	"golang.org/x/sys/unix"
+)
+
+func TestPerfEventToGo(t *testing.T) {
+	data := make([]byte, metadataLen+8)
+	binary.LittleEndian.PutUint32(data[0:4], 0)
+	binary.LittleEndian.PutUint16(data[4:6], 1500)
+	binary.LittleEndian.PutUint64(data[6:14], uint64(90*time.Second))
+	data[14] = 1
+	binary.LittleEndian.PutUint16(data[15:17], 3)
+	binary.LittleEndian.PutUint32(data[17:21], 10)
+	copy(data[metadataLen:], []byte{1, 2, 3})
+
+	md, skb, err := perfEventToGo(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md.SKBLen != 1500 {
+		t.Errorf("unexpected skb len: %d", md.SKBLen)
+	}
+	if !md.Time.Equal(bootTime.Add(90 * time.Second)) {
+		t.Errorf("unexpected time: %s", md.Time)
+	}
+	if md.Direction != pb.TrafficDirection_EGRESS {
+		t.Errorf("unexpected direction: %s", md.Direction)
+	}
+	if md.SampleRate != 10 {
+		t.Errorf("unexpected sample rate: %d", md.SampleRate)
+	}
+	if len(skb) != 3 {
+		t.Errorf("unexpected skb: %v", skb)
+	}
+	if _, _, err := perfEventToGo(data[:metadataLen-1]); err != ErrInvalidDataLen {
+		t.Errorf("expected ErrInvalidDataLen, got %v", err)
+	}
+}
+
+func TestKtimeToTime(t *testing.T) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	converted := ktimeToTime(uint64(ts.Nano()))
+	if diff := now.Sub(converted); diff < -time.Second || diff > time.Second {
+		t.Errorf("converted time %s is not close to %s", converted, now)
+	}
+}
@@ -0,0 +1,218 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	// sizes of struct flow_key and struct flow_stats
+	flowKeyLen   = 44
+	flowStatsLen = 40
+	// defaults of the aggregation options
+	defaultAggregationInterval = 10 * time.Second
+	defaultMaxFlows            = 65536
+	flowsMapName               = "FLOWS"
+)
+
+// tcp flag bits in the tcp header
+const (
+	tcpFlagFIN = 1 << iota
+	tcpFlagSYN
+	tcpFlagRST
+	tcpFlagPSH
+	tcpFlagACK
+	tcpFlagURG
+	tcpFlagECE
+	tcpFlagCWR
+)
+
+// AggregationOptions configures the in-kernel flow aggregation
+type AggregationOptions struct {
+	// Enabled counts the packets of each flow in the kernel. Samples are
+	// only emitted for TCP SYN, FIN and RST and for packets with payload.
+	Enabled bool
+	// Interval is the interval in which the counters are summarized
+	Interval time.Duration
+	// MaxFlows is the size of the LRU map of the flows
+	MaxFlows int
+}
+
+// flowStats is struct flow_stats of the eBPF programs
+type flowStats struct {
+	packets uint64
+	bytes   uint64
+	// bpf_ktime_get_ns() timestamps
+	firstSeen uint64
+	lastSeen  uint64
+	tcpFlags  uint8
+}
+
+func decodeFlowStats(b []byte) flowStats {
+	return flowStats{
+		packets:   binary.LittleEndian.Uint64(b[0:8]),
+		bytes:     binary.LittleEndian.Uint64(b[8:16]),
+		firstSeen: binary.LittleEndian.Uint64(b[16:24]),
+		lastSeen:  binary.LittleEndian.Uint64(b[24:32]),
+		tcpFlags:  b[32],
+	}
+}
+
+type flowEntry struct {
+	key   string
+	stats flowStats
+}
+
+// flowAggregator summarizes the counters of the flows map.
+// The counters are never reset by the agent: a summary contains
+// the difference to the counters of the previous summary.
+type flowAggregator struct {
+	flows       *ebpf.Map
+	idleTimeout time.Duration
+	// last are the counters of the previous summaries by key
+	last map[string]flowStats
+}
+
+func newFlowAggregator(flows *ebpf.Map, interval time.Duration) *flowAggregator {
+	return &flowAggregator{
+		flows:       flows,
+		idleTimeout: 2 * interval,
+		last:        make(map[string]flowStats),
+	}
+}
+
+// drain returns a summary of each flow which had
+// packets since the previous drain. Idle flows are deleted.
+func (a *flowAggregator) drain(now time.Time) ([]*pb.Trace, error) {
+	var entries []flowEntry
+	var key, value []byte
+	iter := a.flows.Iterate()
+	for iter.Next(&key, &value) {
+		if len(key) != flowKeyLen || len(value) != flowStatsLen {
+			continue
+		}
+		entries = append(entries, flowEntry{
+			key:   string(key),
+			stats: decodeFlowStats(value),
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	traces, idle := a.summarize(entries, now)
+	// keys must not be deleted while iterating
+	for _, key := range idle {
+		a.flows.Delete([]byte(key))
+	}
+	return traces, nil
+}
+
+// summarize returns the summaries of the entries and
+// the keys of the flows which are idle
+func (a *flowAggregator) summarize(entries []flowEntry, now time.Time) ([]*pb.Trace, []string) {
+	var traces []*pb.Trace
+	var idle []string
+	current := make(map[string]flowStats, len(entries))
+	for _, entry := range entries {
+		last := a.last[entry.key]
+		if last.firstSeen != entry.stats.firstSeen {
+			// the flow was evicted and recreated
+			last = flowStats{}
+		}
+		if entry.stats.packets > last.packets {
+			if trace := newFlowSummary([]byte(entry.key), entry.stats, last); trace != nil {
+				traces = append(traces, trace)
+			}
+		}
+		if now.Sub(ktimeToTime(entry.stats.lastSeen)) > a.idleTimeout {
+			idle = append(idle, entry.key)
+			continue
+		}
+		current[entry.key] = entry.stats
+	}
+	// flows which are missing were evicted from the LRU map
+	a.last = current
+	return traces, idle
+}
+
+// newFlowSummary decodes struct flow_key and returns a trace with the
+// counters since the last summary. It returns nil for unknown flows.
+func newFlowSummary(key []byte, stats, last flowStats) *pb.Trace {
+	trace := &pb.Trace{
+		IP:               &pb.IP{},
+		TrafficDirection: directions[key[42]],
+	}
+	switch key[41] {
+	case 4:
+		trace.IP.Source = net.IP(key[4:8]).String()
+		trace.IP.Destination = net.IP(key[20:24]).String()
+		trace.IP.IpVersion = pb.IPVersion_IPv4
+	case 6:
+		trace.IP.Source = net.IP(key[4:20]).String()
+		trace.IP.Destination = net.IP(key[20:36]).String()
+		trace.IP.IpVersion = pb.IPVersion_IPv6
+	default:
+		return nil
+	}
+	srcPort := uint32(binary.BigEndian.Uint16(key[36:38]))
+	dstPort := uint32(binary.BigEndian.Uint16(key[38:40]))
+	switch key[40] {
+	case 6:
+		trace.L4 = &pb.Layer4{
+			Protocol: &pb.Layer4_TCP{
+				TCP: &pb.TCP{
+					SourcePort:      srcPort,
+					DestinationPort: dstPort,
+					Flags:           decodeTCPFlags(stats.tcpFlags),
+				},
+			},
+		}
+	case 17:
+		trace.L4 = &pb.Layer4{
+			Protocol: &pb.Layer4_UDP{
+				UDP: &pb.UDP{
+					SourcePort:      srcPort,
+					DestinationPort: dstPort,
+				},
+			},
+		}
+	case 1:
+		trace.L4 = &pb.Layer4{Protocol: &pb.Layer4_ICMPv4{ICMPv4: &pb.ICMPv4{}}}
+	case 58:
+		trace.L4 = &pb.Layer4{Protocol: &pb.Layer4_ICMPv6{ICMPv6: &pb.ICMPv6{}}}
+	}
+	firstSeen, err := ptypes.TimestampProto(ktimeToTime(stats.firstSeen))
+	if err != nil {
+		return nil
+	}
+	lastSeen, err := ptypes.TimestampProto(ktimeToTime(stats.lastSeen))
+	if err != nil {
+		return nil
+	}
+	trace.Time = lastSeen
+	trace.FlowSummary = &pb.FlowSummary{
+		Packets:   stats.packets - last.packets,
+		Bytes:     stats.bytes - last.bytes,
+		FirstSeen: firstSeen,
+		LastSeen:  lastSeen,
+	}
+	return trace
+}
+
+func decodeTCPFlags(flags uint8) *pb.TCPFlags {
+	return &pb.TCPFlags{
+		FIN: flags&tcpFlagFIN != 0,
+		SYN: flags&tcpFlagSYN != 0,
+		RST: flags&tcpFlagRST != 0,
+		PSH: flags&tcpFlagPSH != 0,
+		ACK: flags&tcpFlagACK != 0,
+		URG: flags&tcpFlagURG != 0,
+		ECE: flags&tcpFlagECE != 0,
+		CWR: flags&tcpFlagCWR != 0,
+	}
+}
@@ -0,0 +1,131 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+// newFlowKey serializes struct flow_key
+func newFlowKey(src, dst string, srcPort, dstPort uint16, proto, direction byte) string {
+	key := make([]byte, flowKeyLen)
+	binary.LittleEndian.PutUint32(key[0:4], 7)
+	srcIP, dstIP := net.ParseIP(src), net.ParseIP(dst)
+	if ip := srcIP.To4(); ip != nil {
+		copy(key[4:20], ip)
+		copy(key[20:36], dstIP.To4())
+		key[41] = 4
+	} else {
+		copy(key[4:20], srcIP)
+		copy(key[20:36], dstIP)
+		key[41] = 6
+	}
+	binary.BigEndian.PutUint16(key[36:38], srcPort)
+	binary.BigEndian.PutUint16(key[38:40], dstPort)
+	key[40] = proto
+	key[42] = direction
+	return string(key)
+}
+
+func TestDecodeFlowStats(t *testing.T) {
+	b := make([]byte, flowStatsLen)
+	binary.LittleEndian.PutUint64(b[0:8], 3)
+	binary.LittleEndian.PutUint64(b[8:16], 180)
+	binary.LittleEndian.PutUint64(b[16:24], 1000)
+	binary.LittleEndian.PutUint64(b[24:32], 2000)
+	b[32] = tcpFlagSYN | tcpFlagACK
+	expected := flowStats{packets: 3, bytes: 180, firstSeen: 1000, lastSeen: 2000, tcpFlags: tcpFlagSYN | tcpFlagACK}
+	if stats := decodeFlowStats(b); stats != expected {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestFlowAggregator(t *testing.T) {
+	base := uint64(time.Hour)
+	now := ktimeToTime(base)
+	ts := func(ns uint64) time.Time { return ktimeToTime(ns) }
+	tcpKey := newFlowKey("10.0.0.1", "10.0.0.2", 40000, 80, 6, 1)
+	udpKey := newFlowKey("fd00::1", "fd00::2", 40001, 53, 17, 0)
+	a := newFlowAggregator(nil, 10*time.Second)
+
+	type summary struct {
+		src, dst       string
+		packets, bytes uint64
+		firstSeen      time.Time
+		lastSeen       time.Time
+		flags          *pb.TCPFlags
+		direction      pb.TrafficDirection
+	}
+	for i, row := range []struct {
+		now      time.Time
+		entries  []flowEntry
+		expected []summary
+		idle     []string
+	}{
+		{
+			// new flows
+			now: now,
+			entries: []flowEntry{
+				{key: tcpKey, stats: flowStats{packets: 3, bytes: 180, firstSeen: base - 100, lastSeen: base - 10, tcpFlags: tcpFlagSYN | tcpFlagACK}},
+				{key: udpKey, stats: flowStats{packets: 1, bytes: 80, firstSeen: base - 50, lastSeen: base - 50}},
+			},
+			expected: []summary{
+				{"10.0.0.1", "10.0.0.2", 3, 180, ts(base - 100), ts(base - 10), &pb.TCPFlags{SYN: true, ACK: true}, pb.TrafficDirection_EGRESS},
+				{"fd00::1", "fd00::2", 1, 80, ts(base - 50), ts(base - 50), nil, pb.TrafficDirection_INGRESS},
+			},
+		},
+		{
+			// the tcp flow continues, the udp flow is unchanged
+			now: now.Add(10 * time.Second),
+			entries: []flowEntry{
+				{key: tcpKey, stats: flowStats{packets: 5, bytes: 300, firstSeen: base - 100, lastSeen: base + 5, tcpFlags: tcpFlagSYN | tcpFlagACK | tcpFlagFIN}},
+				{key: udpKey, stats: flowStats{packets: 1, bytes: 80, firstSeen: base - 50, lastSeen: base - 50}},
+			},
+			expected: []summary{
+				{"10.0.0.1", "10.0.0.2", 2, 120, ts(base - 100), ts(base + 5), &pb.TCPFlags{SYN: true, ACK: true, FIN: true}, pb.TrafficDirection_EGRESS},
+			},
+		},
+		{
+			// the tcp flow was evicted and recreated, the udp flow is idle
+			now: now.Add(30 * time.Second),
+			entries: []flowEntry{
+				{key: tcpKey, stats: flowStats{packets: 1, bytes: 60, firstSeen: base + uint64(25*time.Second), lastSeen: base + uint64(25*time.Second), tcpFlags: tcpFlagSYN}},
+				{key: udpKey, stats: flowStats{packets: 1, bytes: 80, firstSeen: base - 50, lastSeen: base - 50}},
+			},
+			expected: []summary{
+				{"10.0.0.1", "10.0.0.2", 1, 60, ts(base + uint64(25*time.Second)), ts(base + uint64(25*time.Second)), &pb.TCPFlags{SYN: true}, pb.TrafficDirection_EGRESS},
+			},
+			idle: []string{udpKey},
+		},
+	} {
+		traces, idle := a.summarize(row.entries, row.now)
+		var summaries []summary
+		for _, trace := range traces {
+			firstSeen, _ := ptypes.Timestamp(trace.FlowSummary.FirstSeen)
+			lastSeen, _ := ptypes.Timestamp(trace.FlowSummary.LastSeen)
+			summaries = append(summaries, summary{
+				src:       trace.IP.Source,
+				dst:       trace.IP.Destination,
+				packets:   trace.FlowSummary.Packets,
+				bytes:     trace.FlowSummary.Bytes,
+				firstSeen: firstSeen,
+				lastSeen:  lastSeen,
+				flags:     trace.L4.GetTCP().GetFlags(),
+				direction: trace.TrafficDirection,
+			})
+		}
+		if diff := cmp.Diff(row.expected, summaries, cmp.AllowUnexported(summary{})); diff != "" {
+			t.Errorf("[%d] unexpected summaries: %s", i, diff)
+		}
+		if diff := cmp.Diff(row.idle, idle); diff != "" {
+			t.Errorf("[%d] unexpected idle flows: %s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package tracer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	// httpRequestTimeout is the time after which
+	// a request without response is dropped
+	httpRequestTimeout = 30 * time.Second
+	// maxPendingRequests limits the number of requests
+	// waiting for a response per connection
+	maxPendingRequests = 32
+)
+
+// connKey identifies a TCP connection from the
+// client's point of view on the capturing interface
+type connKey struct {
+	ifname     string
+	clientIP   string
+	serverIP   string
+	clientPort uint32
+	serverPort uint32
+}
+
+type pendingRequest struct {
+	http *pb.HTTP
+	time time.Time
+}
+
+// httpTracker pairs HTTP responses with the outstanding
+// requests of the same connection.
+// HTTP/1.x responses are sent in the order of the requests,
+// so every connection keeps a queue of pending requests.
+type httpTracker struct {
+	mu         sync.Mutex
+	timeout    time.Duration
+	lastExpire time.Time
+	conns      map[connKey][]pendingRequest
+}
+
+func newHTTPTracker(timeout time.Duration) *httpTracker {
+	return &httpTracker{
+		timeout: timeout,
+		conns:   make(map[connKey][]pendingRequest),
+	}
+}
+
+// track records requests and adds method, url, host and latency
+// of the matching request to responses. ifname is the interface
+// which captured the packet of the trace.
+func (t *httpTracker) track(ifname string, trace *pb.Trace) {
+	tcp := trace.GetL4().GetTCP()
+	if tcp == nil || trace.IP == nil {
+		return
+	}
+	ts, err := ptypes.Timestamp(trace.Time)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ts.Sub(t.lastExpire) > t.timeout {
+		t.expire(ts)
+		t.lastExpire = ts
+	}
+
+	http := trace.GetL7().GetHttp()
+	switch {
+	case http != nil && http.Method != "":
+		key := connKey{
+			ifname:     ifname,
+			clientIP:   trace.IP.Source,
+			serverIP:   trace.IP.Destination,
+			clientPort: tcp.SourcePort,
+			serverPort: tcp.DestinationPort,
+		}
+		pending := t.conns[key]
+		if len(pending) >= maxPendingRequests {
+			pending = pending[1:]
+		}
+		t.conns[key] = append(pending, pendingRequest{
+			http: http,
+			time: ts,
+		})
+	case http != nil && http.Code != 0:
+		key := connKey{
+			ifname:     ifname,
+			clientIP:   trace.IP.Destination,
+			serverIP:   trace.IP.Source,
+			clientPort: tcp.DestinationPort,
+			serverPort: tcp.SourcePort,
+		}
+		pending := t.conns[key]
+		if len(pending) == 0 {
+			return
+		}
+		req := pending[0]
+		if len(pending) == 1 {
+			delete(t.conns, key)
+		} else {
+			t.conns[key] = pending[1:]
+		}
+		http.Method = req.http.Method
+		http.Url = req.http.Url
+		http.Host = req.http.Host
+		if latency := ts.Sub(req.time); latency > 0 {
+			http.LatencyNs = uint64(latency)
+		}
+	case tcp.Flags.GetFIN() || tcp.Flags.GetRST():
+		// the connection is closed: drop requests
+		// of both directions
+		delete(t.conns, connKey{
+			ifname:     ifname,
+			clientIP:   trace.IP.Source,
+			serverIP:   trace.IP.Destination,
+			clientPort: tcp.SourcePort,
+			serverPort: tcp.DestinationPort,
+		})
+		delete(t.conns, connKey{
+			ifname:     ifname,
+			clientIP:   trace.IP.Destination,
+			serverIP:   trace.IP.Source,
+			clientPort: tcp.DestinationPort,
+			serverPort: tcp.SourcePort,
+		})
+	}
+}
+
+// expire drops requests older than the timeout
+func (t *httpTracker) expire(now time.Time) {
+	for key, pending := range t.conns {
+		i := 0
+		for i < len(pending) && now.Sub(pending[i].time) > t.timeout {
+			i++
+		}
+		if i == len(pending) {
+			delete(t.conns, key)
+		} else if i > 0 {
+			t.conns[key] = pending[i:]
+		}
+	}
+}
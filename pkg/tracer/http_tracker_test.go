@@ -0,0 +1,102 @@
+package tracer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/moolen/juno/proto"
+)
+
+func newHTTPTrace(t *testing.T, ts time.Time, src, dst string, sport, dport uint32, http *pb.HTTP, flags *pb.TCPFlags) *pb.Trace {
+	pts, err := ptypes.TimestampProto(ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trace := &pb.Trace{
+		Time: pts,
+		IP: &pb.IP{
+			Source:      src,
+			Destination: dst,
+		},
+		L4: &pb.Layer4{
+			Protocol: &pb.Layer4_TCP{
+				TCP: &pb.TCP{
+					SourcePort:      sport,
+					DestinationPort: dport,
+					Flags:           flags,
+				},
+			},
+		},
+	}
+	if http != nil {
+		trace.L7 = &pb.Layer7{
+			Record: &pb.Layer7_Http{
+				Http: http,
+			},
+		}
+	}
+	return trace
+}
+
+func TestHTTPTracker(t *testing.T) {
+	base := time.Unix(100, 0)
+	tracker := newHTTPTracker(time.Minute)
+	request := func(offset time.Duration, port uint32, url string) {
+		tracker.track("eth0", newHTTPTrace(t, base.Add(offset), "10.0.1.5", "10.0.2.7", port, 8080,
+			&pb.HTTP{Method: "GET", Url: url, Host: "api"}, nil))
+	}
+	response := func(offset time.Duration, port uint32, code uint32) *pb.HTTP {
+		http := &pb.HTTP{Code: code}
+		tracker.track("eth0", newHTTPTrace(t, base.Add(offset), "10.0.2.7", "10.0.1.5", 8080, port, http, nil))
+		return http
+	}
+
+	// pipelined requests are answered in order
+	request(0, 40000, "/a")
+	request(time.Millisecond, 40000, "/b")
+	request(2*time.Millisecond, 40001, "/c")
+	for i, row := range []struct {
+		offset  time.Duration
+		port    uint32
+		url     string
+		latency time.Duration
+	}{
+		{offset: 5 * time.Millisecond, port: 40001, url: "/c", latency: 3 * time.Millisecond},
+		{offset: 10 * time.Millisecond, port: 40000, url: "/a", latency: 10 * time.Millisecond},
+		{offset: 12 * time.Millisecond, port: 40000, url: "/b", latency: 11 * time.Millisecond},
+		// no outstanding request
+		{offset: 13 * time.Millisecond, port: 40000},
+	} {
+		http := response(row.offset, row.port, 200)
+		if http.Url != row.url {
+			t.Errorf("[%d] unexpected url. expected %q, found %q", i, row.url, http.Url)
+		}
+		if time.Duration(http.LatencyNs) != row.latency {
+			t.Errorf("[%d] unexpected latency. expected %s, found %s", i, row.latency, time.Duration(http.LatencyNs))
+		}
+		if row.url != "" && (http.Method != "GET" || http.Host != "api" || http.Code != 200) {
+			t.Errorf("[%d] unexpected http: %#v", i, http)
+		}
+	}
+	if len(tracker.conns) != 0 {
+		t.Errorf("unexpected pending requests: %#v", tracker.conns)
+	}
+
+	// requests are dropped when the connection is closed
+	request(20*time.Millisecond, 40002, "/d")
+	tracker.track("eth0", newHTTPTrace(t, base.Add(21*time.Millisecond), "10.0.2.7", "10.0.1.5", 8080, 40002, nil, &pb.TCPFlags{FIN: true}))
+	if http := response(22*time.Millisecond, 40002, 200); http.Url != "" {
+		t.Errorf("unexpected url after FIN: %s", http.Url)
+	}
+
+	// requests are dropped after the timeout
+	request(30*time.Millisecond, 40003, "/e")
+	request(2*time.Minute, 40004, "/f")
+	if http := response(2*time.Minute, 40003, 200); http.Url != "" {
+		t.Errorf("unexpected url after timeout: %s", http.Url)
+	}
+	if len(tracker.conns) != 1 {
+		t.Errorf("unexpected pending requests: %#v", tracker.conns)
+	}
+}
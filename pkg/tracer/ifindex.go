@@ -19,13 +19,14 @@ package tracer
 import (
 	"fmt"
 	"sync"
-	"time"
 
 	"github.com/vishvananda/netlink"
 )
 
 type linkMap map[int]string
 
+// ifindexMap is updated by the link subscription of the tracer
+// and replaced on every sync of the datapath
 var (
 	ifindexMap = linkMap{}
 	mutex      sync.RWMutex
@@ -42,27 +43,26 @@ func ifname(ifindex int) string {
 	return fmt.Sprintf("%d", ifindex)
 }
 
-func init() {
-	go func() {
-		for {
-			newMap := linkMap{}
-
-			links, err := netlink.LinkList()
-			if err != nil {
-				goto sleep
-			}
-
-			for _, link := range links {
-				newMap[link.Attrs().Index] = link.Attrs().Name
-			}
+// resetIfnames replaces the names with those of the links
+func resetIfnames(links []netlink.Link) {
+	newMap := linkMap{}
+	for _, link := range links {
+		newMap[link.Attrs().Index] = link.Attrs().Name
+	}
 
-			mutex.Lock()
-			ifindexMap = newMap
-			mutex.Unlock()
+	mutex.Lock()
+	ifindexMap = newMap
+	mutex.Unlock()
+}
 
-		sleep:
-			time.Sleep(time.Duration(15) * time.Second)
-		}
+func setIfname(link netlink.Link) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	ifindexMap[link.Attrs().Index] = link.Attrs().Name
+}
 
-	}()
+func deleteIfname(link netlink.Link) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(ifindexMap, link.Attrs().Index)
 }
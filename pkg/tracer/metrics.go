@@ -0,0 +1,37 @@
+package tracer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	reassemblyStreams = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "tcp_reassembly_streams",
+		Help: "juno agent number of reassembled tcp streams",
+	})
+	reassemblyGaps = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tcp_reassembly_gap_count",
+		Help: "juno agent tcp streams with missing data",
+	})
+	reassemblyOverflows = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tcp_reassembly_overflow_count",
+		Help: "juno agent messages dropped because they exceed the stream buffer",
+	})
+	reassemblyTruncations = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tcp_reassembly_truncated_count",
+		Help: "juno agent segments which were truncated by the sample size",
+	})
+	reassemblyUntracked = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tcp_reassembly_untracked_count",
+		Help: "juno agent tcp streams which were not reassembled because of the stream limit",
+	})
+	reassemblyEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tcp_reassembly_eviction_count",
+		Help: "juno agent tcp streams evicted because they were idle",
+	})
+	lostSamples = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "lost_samples_count",
+		Help: "juno agent samples lost because the event buffer was full",
+	})
+)
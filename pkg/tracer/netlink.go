@@ -2,6 +2,7 @@ package tracer
 
 import (
 	"fmt"
+	"strings"
 	"syscall"
 
 	"github.com/cilium/ebpf"
@@ -9,6 +10,21 @@ import (
 	"github.com/vishvananda/netlink"
 )
 
+const (
+	// the filters of the agent are identified by priority and handle.
+	// Qdiscs and filters of other programs are left untouched.
+	filterPriority = 10
+	filterHandle   = 0x4a55
+	// filterPrefix is the prefix of the names of our filters
+	filterPrefix = "juno-"
+)
+
+// filterName returns the name of a filter. The instance
+// distinguishes our filters from those of a previous agent.
+func filterName(hook, instance string) string {
+	return filterPrefix + hook + "-" + instance
+}
+
 func qdiscAttrs(link netlink.Link) *netlink.GenericQdisc {
 	return &netlink.GenericQdisc{
 		QdiscAttrs: netlink.QdiscAttrs{
@@ -20,51 +36,106 @@ func qdiscAttrs(link netlink.Link) *netlink.GenericQdisc {
 	}
 }
 
-func replaceQdisc(link netlink.Link) error {
-	qdisc := qdiscAttrs(link)
-	netlink.QdiscDel(qdisc)
-	if err := netlink.QdiscAdd(qdisc); err != nil {
-		return fmt.Errorf("netlink: replacing qdisc for %s failed: %s", link.Attrs().Name, err)
+// ensureQdisc adds the clsact qdisc unless it exists.
+// It returns true if the qdisc was added.
+func ensureQdisc(link netlink.Link) (bool, error) {
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil {
+		return false, fmt.Errorf("netlink: listing qdiscs of %s failed: %s", link.Attrs().Name, err)
 	}
-	log.Printf("netlink: replacing qdisc for %s succeeded\n", link.Attrs().Name)
-	return nil
+	for _, qdisc := range qdiscs {
+		if qdisc.Type() == "clsact" {
+			return false, nil
+		}
+	}
+	if err := netlink.QdiscAdd(qdiscAttrs(link)); err != nil {
+		return false, fmt.Errorf("netlink: adding qdisc for %s failed: %s", link.Attrs().Name, err)
+	}
+	log.Infof("netlink: added clsact qdisc for %s", link.Attrs().Name)
+	return true, nil
 }
 
-func deleteQdisc(link netlink.Link) error {
-	qdisc := qdiscAttrs(link)
-	return netlink.QdiscDel(qdisc)
+// deleteUnusedQdisc deletes the clsact qdisc
+// if there are no filters attached to it
+func deleteUnusedQdisc(link netlink.Link) error {
+	for _, parent := range hooks {
+		filters, err := netlink.FilterList(link, parent)
+		if err != nil {
+			return err
+		}
+		if len(filters) > 0 {
+			return nil
+		}
+	}
+	return netlink.QdiscDel(qdiscAttrs(link))
 }
 
-func filterAttrs(prog *ebpf.Program, link netlink.Link, parent uint32) *netlink.U32 {
-	return &netlink.U32{
+func filterAttrs(prog *ebpf.Program, link netlink.Link, parent uint32, name string) *netlink.BpfFilter {
+	return &netlink.BpfFilter{
 		FilterAttrs: netlink.FilterAttrs{
 			LinkIndex: link.Attrs().Index,
 			Parent:    parent,
-			Handle:    netlink.MakeHandle(0, 1),
-			Priority:  1,
+			Handle:    filterHandle,
+			Priority:  filterPriority,
 			Protocol:  syscall.ETH_P_ALL,
 		},
-		ClassId: netlink.MakeHandle(1, 1),
-		Actions: []netlink.Action{
-			&netlink.BpfAction{
-				Fd:   prog.FD(),
-				Name: prog.String(),
-			},
-		},
+		Fd:           prog.FD(),
+		Name:         name,
+		DirectAction: true,
 	}
 }
 
-func createFilter(prog *ebpf.Program, link netlink.Link, parent uint32) error {
-	filter := filterAttrs(prog, link, parent)
-	err := netlink.FilterAdd(filter)
+// ownFilter returns the filter at our priority and handle, if any.
+// It returns an error if a foreign filter occupies them.
+func ownFilter(link netlink.Link, parent uint32) (*netlink.BpfFilter, error) {
+	filters, err := netlink.FilterList(link, parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list filters: %s", err)
+	}
+	for _, f := range filters {
+		attrs := f.Attrs()
+		if attrs.Priority != filterPriority || attrs.Handle != filterHandle {
+			continue
+		}
+		bpf, ok := f.(*netlink.BpfFilter)
+		if !ok || !strings.HasPrefix(bpf.Name, filterPrefix) {
+			return nil, fmt.Errorf("foreign %s filter at priority %d handle %x", f.Type(), filterPriority, filterHandle)
+		}
+		return bpf, nil
+	}
+	return nil, nil
+}
+
+// createFilter attaches the program unless it is attached already.
+// A filter of a previous agent is replaced.
+func createFilter(prog *ebpf.Program, link netlink.Link, parent uint32, name string) error {
+	existing, err := ownFilter(link, parent)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Name == name {
+		log.Debugf("filter %s is attached to %s", name, link.Attrs().Name)
+		return nil
+	}
+	if existing != nil {
+		if err := netlink.FilterDel(existing); err != nil {
+			return fmt.Errorf("failed to delete stale filter %s: %s", existing.Name, err)
+		}
+		log.Infof("deleted stale filter %s of %s", existing.Name, link.Attrs().Name)
+	}
+	err = netlink.FilterAdd(filterAttrs(prog, link, parent, name))
 	if err != nil {
 		return fmt.Errorf("failed to add filter: %s", err)
 	}
-	log.Printf("successfully added filter for %s \n", prog.String())
+	log.Infof("successfully added filter %s for %s", name, link.Attrs().Name)
 	return nil
 }
 
-func deleteFilter(prog *ebpf.Program, link netlink.Link, parent uint32) error {
-	filter := filterAttrs(prog, link, parent)
-	return netlink.FilterDel(filter)
+// deleteFilter detaches our filter, foreign filters are left untouched
+func deleteFilter(link netlink.Link, parent uint32) error {
+	existing, err := ownFilter(link, parent)
+	if err != nil || existing == nil {
+		return err
+	}
+	return netlink.FilterDel(existing)
 }
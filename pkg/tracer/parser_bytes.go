@@ -0,0 +1,93 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// errShortSample indicates a read beyond the sampled data
+var errShortSample = fmt.Errorf("read beyond the sample")
+
+// byteReader reads big endian integers and length prefixed data.
+// The first read beyond the data sets err, later reads return zero values.
+type byteReader struct {
+	data []byte
+	err  error
+}
+
+func (r *byteReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.data) < n {
+		r.err = errShortSample
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *byteReader) skip(n int) {
+	r.next(n)
+}
+
+func (r *byteReader) uint16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b)
+}
+
+func (r *byteReader) int16() int16 {
+	return int16(r.uint16())
+}
+
+func (r *byteReader) int32() int32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (r *byteReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errShortSample
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+// vector reads data with a length prefix of lenSize bytes
+func (r *byteReader) vector(lenSize int) []byte {
+	b := r.next(lenSize)
+	if b == nil {
+		return nil
+	}
+	n := 0
+	for _, c := range b {
+		n = n<<8 | int(c)
+	}
+	return r.next(n)
+}
+
+func isPrintable(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package tracer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"unicode/utf8"
+)
+
+// KeyMode decides how the keys of redis and memcached commands are recorded
+type KeyMode string
+
+const (
+	// KeyModePlain records keys as they are
+	KeyModePlain KeyMode = "plain"
+	// KeyModeHash records a hash of the keys
+	KeyModeHash KeyMode = "hash"
+	// KeyModeRedact replaces keys with a placeholder
+	KeyModeRedact KeyMode = "redact"
+)
+
+// ParseKeyMode validates a key mode. The empty string is KeyModePlain
+func ParseKeyMode(s string) (KeyMode, error) {
+	switch KeyMode(s) {
+	case "", KeyModePlain:
+		return KeyModePlain, nil
+	case KeyModeHash, KeyModeRedact:
+		return KeyMode(s), nil
+	}
+	return "", fmt.Errorf("invalid key mode: %s", s)
+}
+
+// apply returns the key to record
+func (m KeyMode) apply(key string) string {
+	if key == "" {
+		return ""
+	}
+	switch m {
+	case KeyModeHash:
+		sum := sha256.Sum256([]byte(key))
+		return "sha256:" + hex.EncodeToString(sum[:8])
+	case KeyModeRedact:
+		return redactedValue
+	}
+	if !utf8.ValidString(key) {
+		return ""
+	}
+	return key
+}
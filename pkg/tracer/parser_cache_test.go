@@ -0,0 +1,26 @@
+package tracer
+
+import "testing"
+
+func TestParseKeyMode(t *testing.T) {
+	for i, row := range []struct {
+		input    string
+		expected KeyMode
+		err      bool
+	}{
+		{input: "", expected: KeyModePlain},
+		{input: "plain", expected: KeyModePlain},
+		{input: "hash", expected: KeyModeHash},
+		{input: "redact", expected: KeyModeRedact},
+		{input: "foo", err: true},
+	} {
+		mode, err := ParseKeyMode(row.input)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if mode != row.expected {
+			t.Errorf("[%d] unexpected mode. expected %q, got %q", i, row.expected, mode)
+		}
+	}
+}
@@ -1,14 +1,69 @@
 package tracer
 
 import (
-	"strconv"
+	"encoding/binary"
+	"fmt"
 
+	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
+	pb "github.com/moolen/juno/proto"
 )
 
-func parseDNSMetadata(dns *layers.DNS) (map[string]string, error) {
-	m := make(map[string]string)
-	m["OPCODE"] = dns.OpCode.String()
-	m["QR"] = strconv.FormatBool(dns.QR)
-	return m, nil
+const dnsPort = 53
+
+func parseDNS(dns *layers.DNS) *pb.DNS {
+	out := &pb.DNS{
+		Rcode:    uint32(dns.ResponseCode),
+		Response: dns.QR,
+	}
+	for _, q := range dns.Questions {
+		if out.Query == "" {
+			out.Query = fqdn(q.Name)
+		}
+		out.Qtypes = appendUnique(out.Qtypes, q.Type.String())
+	}
+	for i, a := range dns.Answers {
+		out.Rrtypes = appendUnique(out.Rrtypes, a.Type.String())
+		if i == 0 || a.TTL < out.Ttl {
+			out.Ttl = a.TTL
+		}
+		switch a.Type {
+		case layers.DNSTypeA, layers.DNSTypeAAAA:
+			out.Ips = append(out.Ips, a.IP.String())
+		case layers.DNSTypeCNAME:
+			out.Cnames = append(out.Cnames, fqdn(a.CNAME))
+		}
+	}
+	return out
+}
+
+// parseDNSOverTCP decodes a DNS message sent over TCP,
+// which is prefixed with a two byte length field
+func parseDNSOverTCP(payload []byte) (*pb.DNS, error) {
+	if len(payload) < 2 {
+		return nil, fmt.Errorf("dns over tcp message too short")
+	}
+	length := int(binary.BigEndian.Uint16(payload[:2]))
+	if len(payload)-2 < length {
+		return nil, fmt.Errorf("truncated dns over tcp message")
+	}
+	dns := &layers.DNS{}
+	err := dns.DecodeFromBytes(payload[2:2+length], gopacket.NilDecodeFeedback)
+	if err != nil {
+		return nil, err
+	}
+	return parseDNS(dns), nil
+}
+
+func fqdn(name []byte) string {
+	return string(name) + "."
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, e := range list {
+		if e == s {
+			return list
+		}
+	}
+	return append(list, s)
 }
@@ -0,0 +1,120 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	pb "github.com/moolen/juno/proto"
+)
+
+func newDNSMessage(t *testing.T, dns *layers.DNS) []byte {
+	buf := gopacket.NewSerializeBuffer()
+	err := dns.SerializeTo(buf, gopacket.SerializeOptions{FixLengths: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseDNS(t *testing.T) {
+	question := layers.DNSQuestion{
+		Name:  []byte("api.default.svc.cluster.local"),
+		Type:  layers.DNSTypeA,
+		Class: layers.DNSClassIN,
+	}
+	for i, row := range []struct {
+		dns      *layers.DNS
+		expected *pb.DNS
+	}{
+		{
+			dns: &layers.DNS{
+				ID:        1,
+				Questions: []layers.DNSQuestion{question},
+			},
+			expected: &pb.DNS{
+				Query:  "api.default.svc.cluster.local.",
+				Qtypes: []string{"A"},
+			},
+		},
+		{
+			dns: &layers.DNS{
+				ID:           2,
+				QR:           true,
+				ResponseCode: layers.DNSResponseCodeNXDomain,
+				Questions:    []layers.DNSQuestion{question},
+			},
+			expected: &pb.DNS{
+				Query:    "api.default.svc.cluster.local.",
+				Qtypes:   []string{"A"},
+				Rcode:    3,
+				Response: true,
+			},
+		},
+		{
+			dns: &layers.DNS{
+				ID:        3,
+				QR:        true,
+				Questions: []layers.DNSQuestion{question},
+				Answers: []layers.DNSResourceRecord{
+					{
+						Name:  []byte("api.default.svc.cluster.local"),
+						Type:  layers.DNSTypeCNAME,
+						Class: layers.DNSClassIN,
+						TTL:   300,
+						CNAME: []byte("api.example.com"),
+					},
+					{
+						Name:  []byte("api.example.com"),
+						Type:  layers.DNSTypeA,
+						Class: layers.DNSClassIN,
+						TTL:   30,
+						IP:    net.ParseIP("10.0.2.7").To4(),
+					},
+					{
+						Name:  []byte("api.example.com"),
+						Type:  layers.DNSTypeA,
+						Class: layers.DNSClassIN,
+						TTL:   60,
+						IP:    net.ParseIP("10.0.2.8").To4(),
+					},
+				},
+			},
+			expected: &pb.DNS{
+				Query:    "api.default.svc.cluster.local.",
+				Qtypes:   []string{"A"},
+				Rrtypes:  []string{"CNAME", "A"},
+				Ips:      []string{"10.0.2.7", "10.0.2.8"},
+				Cnames:   []string{"api.example.com."},
+				Ttl:      30,
+				Response: true,
+			},
+		},
+	} {
+		msg := newDNSMessage(t, row.dns)
+		dns := &layers.DNS{}
+		if err := dns.DecodeFromBytes(msg, gopacket.NilDecodeFeedback); err != nil {
+			t.Fatalf("[%d] unexpected err: %s", i, err)
+		}
+		if diff := cmp.Diff(row.expected, parseDNS(dns)); diff != "" {
+			t.Errorf("[%d] unexpected dns: %s", i, diff)
+		}
+
+		// same message over tcp
+		tcpMsg := make([]byte, 2, len(msg)+2)
+		binary.BigEndian.PutUint16(tcpMsg, uint16(len(msg)))
+		found, err := parseDNSOverTCP(append(tcpMsg, msg...))
+		if err != nil {
+			t.Fatalf("[%d] unexpected tcp err: %s", i, err)
+		}
+		if diff := cmp.Diff(row.expected, found); diff != "" {
+			t.Errorf("[%d] unexpected tcp dns: %s", i, diff)
+		}
+		if _, err := parseDNSOverTCP(append(tcpMsg, msg[:len(msg)-1]...)); err == nil {
+			t.Errorf("[%d] expected error for truncated message", i)
+		}
+	}
+}
@@ -2,11 +2,15 @@ package tracer
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"net/textproto"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
+
+	pb "github.com/moolen/juno/proto"
 )
 
 // HTTPMetadata contains HTTP metadata
@@ -18,38 +22,120 @@ type HTTPMetadata struct {
 
 var ErrUnsupportedProto = fmt.Errorf("unsupported l7 proto")
 
-func parseHTTPMetadata(input *bufio.Reader) (string, string, string, uint32, error) {
+// redactedValue replaces the value of redacted headers
+const redactedValue = "[redacted]"
+
+// HeaderFilter decides which HTTP headers are recorded
+type HeaderFilter struct {
+	allow  map[string]bool
+	redact map[string]bool
+}
+
+// NewHeaderFilter returns a filter which records the allowed headers
+// and replaces the values of the redacted headers.
+// An empty allowlist allows all headers.
+func NewHeaderFilter(allow, redact []string) *HeaderFilter {
+	f := &HeaderFilter{
+		allow:  make(map[string]bool),
+		redact: make(map[string]bool),
+	}
+	for _, h := range allow {
+		f.allow[textproto.CanonicalMIMEHeaderKey(h)] = true
+	}
+	for _, h := range redact {
+		f.redact[textproto.CanonicalMIMEHeaderKey(h)] = true
+	}
+	return f
+}
+
+// headers returns the recorded headers sorted by key.
+// A nil filter records no headers.
+func (f *HeaderFilter) headers(h textproto.MIMEHeader) []*pb.HTTPHeader {
+	if f == nil {
+		return nil
+	}
+	var keys []string
+	for k := range h {
+		if len(f.allow) > 0 && !f.allow[k] {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out []*pb.HTTPHeader
+	for _, k := range keys {
+		for _, v := range h[k] {
+			if !utf8.ValidString(v) {
+				continue
+			}
+			if f.redact[k] {
+				v = redactedValue
+			}
+			out = append(out, &pb.HTTPHeader{
+				Key:   k,
+				Value: v,
+			})
+		}
+	}
+	return out
+}
+
+// newHTTPReader returns a reader for a sampled payload.
+// The sample may end in the middle of a header line,
+// that line is dropped.
+func newHTTPReader(payload []byte) *bufio.Reader {
+	if i := bytes.LastIndex(payload, []byte("\r\n")); i >= 0 {
+		payload = payload[:i+2]
+	}
+	buf := make([]byte, 0, len(payload)+2)
+	buf = append(buf, payload...)
+	buf = append(buf, "\r\n"...)
+	return bufio.NewReader(bytes.NewReader(buf))
+}
+
+func parseHTTPMetadata(input *bufio.Reader, filter *HeaderFilter) (*pb.HTTP, error) {
 	tr := textproto.NewReader(input)
 	line, err := tr.ReadLine()
 	if err != nil {
-		return "", "", "", 0, err
+		return nil, err
 	}
 	p1, p2, p3, ok := parseRequestLine(line)
 	if !ok {
-		return "", "", "", 0, fmt.Errorf("could not parse HTTP request line")
+		return nil, fmt.Errorf("could not parse HTTP request line")
 	}
-	var method, uri, proto string
-	var code uint32
+	out := &pb.HTTP{}
 	// this is a response
-	if p1 == "HTTP/1.1" {
-		proto = p1
+	if isHTTP1(p1) {
+		out.Protocol = p1
 		c, _ := strconv.Atoi(p2)
-		code = uint32(c)
-	} else if p3 == "HTTP/1.1" {
-		method = p1
-		uri = p2
-		proto = p3
+		out.Code = uint32(c)
+		out.Status = p3
+	} else if isHTTP1(p3) {
+		out.Method = p1
+		out.Url = p2
+		out.Protocol = p3
 	} else {
-		return "", "", "", 0, ErrUnsupportedProto
+		return nil, ErrUnsupportedProto
 	}
 
-	for _, v := range []string{method, uri, proto} {
+	for _, v := range []string{out.Method, out.Url, out.Protocol, out.Status} {
 		if !utf8.ValidString(v) {
-			return "", "", "", 0, fmt.Errorf("invalid utf8 string: %#v", v)
+			return nil, fmt.Errorf("invalid utf8 string: %#v", v)
 		}
 	}
 
-	return method, uri, proto, code, nil
+	// the sample is usually truncated:
+	// use the headers which could be read
+	header, _ := tr.ReadMIMEHeader()
+	if host := header.Get("Host"); out.Method != "" && utf8.ValidString(host) {
+		out.Host = host
+	}
+	out.Headers = filter.headers(header)
+	return out, nil
+}
+
+func isHTTP1(proto string) bool {
+	return proto == "HTTP/1.1" || proto == "HTTP/1.0"
 }
 
 func (h *HTTPMetadata) String() string {
@@ -0,0 +1,301 @@
+package tracer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	pb "github.com/moolen/juno/proto"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+const (
+	// http2IdleTimeout is the time after which
+	// the state of an idle connection is dropped
+	http2IdleTimeout = 10 * time.Minute
+	// maxHTTP2Streams limits the number of
+	// open streams tracked per connection
+	maxHTTP2Streams = 1024
+	// hpack default of SETTINGS_HEADER_TABLE_SIZE
+	http2HeaderTableSize = 4096
+	http2FrameHeaderLen  = 9
+)
+
+var http2Preface = []byte(http2.ClientPreface)
+
+// flowKey identifies one direction of a connection on the interface
+// which captured it. Packets between pods of the same node pass the
+// hooks of both veths, every interface keeps its own state.
+type flowKey struct {
+	ifname  string
+	srcIP   string
+	dstIP   string
+	srcPort uint32
+	dstPort uint32
+}
+
+func (k flowKey) reverse() flowKey {
+	return flowKey{
+		ifname:  k.ifname,
+		srcIP:   k.dstIP,
+		dstIP:   k.srcIP,
+		srcPort: k.dstPort,
+		dstPort: k.srcPort,
+	}
+}
+
+// http2Stream is a request of a client
+type http2Stream struct {
+	path    string
+	service string
+	method  string
+}
+
+// http2Flow is one direction of a HTTP/2 connection.
+// HPACK is stateful: every direction has its own decoder
+type http2Flow struct {
+	// decoder is nil once the flow is desynced: a header block was
+	// truncated or could not be decoded, the following blocks
+	// would be decoded with a corrupt dynamic table
+	decoder  *hpack.Decoder
+	fields   []hpack.HeaderField
+	lastSeen time.Time
+	// header block which is continued by CONTINUATION frames
+	block          bool
+	blockStreamID  uint32
+	blockEndStream bool
+	// requests sent by the client, only tracked
+	// on the client to server direction
+	streams map[uint32]*http2Stream
+}
+
+func newHTTP2Flow(now time.Time) *http2Flow {
+	f := &http2Flow{
+		lastSeen: now,
+		streams:  make(map[uint32]*http2Stream),
+	}
+	f.decoder = hpack.NewDecoder(http2HeaderTableSize, func(hf hpack.HeaderField) {
+		f.fields = append(f.fields, hf)
+	})
+	return f
+}
+
+// http2Tracker decodes the HEADERS frames of HTTP/2 connections.
+// Connections are detected by the client preface, connections
+// established before the agent started are not decoded.
+// Decoding is best effort: a direction of a connection is no
+// longer decoded once a header block was truncated by the sample
+// size. Segments which are not sampled corrupt the HPACK dynamic
+// table unnoticed.
+type http2Tracker struct {
+	mu         sync.Mutex
+	timeout    time.Duration
+	lastExpire time.Time
+	flows      map[flowKey]*http2Flow
+}
+
+func newHTTP2Tracker(timeout time.Duration) *http2Tracker {
+	return &http2Tracker{
+		timeout: timeout,
+		flows:   make(map[flowKey]*http2Flow),
+	}
+}
+
+// track decodes the payload of a segment. It returns the
+// first complete header block or nil if there is none.
+func (t *http2Tracker) track(key flowKey, now time.Time, payload []byte, closed bool) *pb.HTTP2 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now.Sub(t.lastExpire) > t.timeout {
+		t.expire(now)
+		t.lastExpire = now
+	}
+	if closed {
+		delete(t.flows, key)
+		delete(t.flows, key.reverse())
+		return nil
+	}
+	if bytes.HasPrefix(payload, http2Preface) {
+		t.flows[key] = newHTTP2Flow(now)
+		t.flows[key.reverse()] = newHTTP2Flow(now)
+		payload = payload[len(http2Preface):]
+	}
+	flow, ok := t.flows[key]
+	if !ok || len(payload) == 0 {
+		return nil
+	}
+	flow.lastSeen = now
+	return t.decodeFrames(flow, t.flows[key.reverse()], payload)
+}
+
+func (t *http2Tracker) decodeFrames(flow, peer *http2Flow, payload []byte) *pb.HTTP2 {
+	var out *pb.HTTP2
+	for len(payload) >= http2FrameHeaderLen && flow.decoder != nil {
+		length := int(payload[0])<<16 | int(payload[1])<<8 | int(payload[2])
+		typ := http2.FrameType(payload[3])
+		flags := http2.Flags(payload[4])
+		streamID := binary.BigEndian.Uint32(payload[5:9]) & (1<<31 - 1)
+		body := payload[http2FrameHeaderLen:]
+		truncated := len(body) < length
+		if !truncated {
+			body = body[:length]
+		}
+		payload = payload[http2FrameHeaderLen+len(body):]
+
+		switch {
+		case typ == http2.FrameHeaders && flow.block:
+			// the end of the previous block is missing
+			flow.desync()
+			continue
+		case typ == http2.FrameHeaders:
+			flow.block = true
+			flow.blockStreamID = streamID
+			flow.blockEndStream = flags.Has(http2.FlagHeadersEndStream)
+			flow.fields = flow.fields[:0]
+		case typ == http2.FrameContinuation && flow.block:
+		default:
+			continue
+		}
+		if truncated {
+			flow.desync()
+			break
+		}
+		if typ == http2.FrameHeaders {
+			var padding int
+			if flags.Has(http2.FlagHeadersPadded) {
+				if len(body) < 1 {
+					flow.desync()
+					break
+				}
+				padding = int(body[0])
+				body = body[1:]
+			}
+			if flags.Has(http2.FlagHeadersPriority) {
+				if len(body) < 5 {
+					flow.desync()
+					break
+				}
+				body = body[5:]
+			}
+			if padding > len(body) {
+				flow.desync()
+				break
+			}
+			body = body[:len(body)-padding]
+		}
+		if _, err := flow.decoder.Write(body); err != nil {
+			flow.desync()
+			break
+		}
+		// the block is continued by CONTINUATION frames
+		if !flags.Has(http2.FlagHeadersEndHeaders) {
+			continue
+		}
+		flow.block = false
+		if err := flow.decoder.Close(); err != nil {
+			flow.desync()
+			break
+		}
+		record := newHTTP2Record(flow.blockStreamID, flow.fields)
+		flow.correlate(peer, record, flow.blockEndStream)
+		if out == nil {
+			out = record
+		}
+	}
+	return out
+}
+
+// desync stops the decoding of the flow
+func (f *http2Flow) desync() {
+	f.decoder = nil
+	f.fields = nil
+	f.block = false
+}
+
+// correlate stores the stream of a request or
+// adds the request of the stream to a response
+func (f *http2Flow) correlate(peer *http2Flow, record *pb.HTTP2, endStream bool) {
+	if record.Method != "" {
+		if len(f.streams) >= maxHTTP2Streams {
+			f.streams = make(map[uint32]*http2Stream)
+		}
+		f.streams[record.StreamId] = &http2Stream{
+			path:    record.Path,
+			service: record.GrpcService,
+			method:  record.GrpcMethod,
+		}
+		return
+	}
+	if peer == nil {
+		return
+	}
+	stream, ok := peer.streams[record.StreamId]
+	if !ok {
+		return
+	}
+	record.Path = stream.path
+	record.GrpcService = stream.service
+	record.GrpcMethod = stream.method
+	if endStream {
+		delete(peer.streams, record.StreamId)
+	}
+}
+
+func newHTTP2Record(streamID uint32, fields []hpack.HeaderField) *pb.HTTP2 {
+	out := &pb.HTTP2{
+		StreamId: streamID,
+	}
+	var grpc bool
+	for _, f := range fields {
+		if !utf8.ValidString(f.Value) {
+			continue
+		}
+		switch f.Name {
+		case ":method":
+			out.Method = f.Value
+		case ":path":
+			out.Path = f.Value
+		case ":authority":
+			out.Authority = f.Value
+		case ":status":
+			code, _ := strconv.Atoi(f.Value)
+			out.Code = uint32(code)
+		case "content-type":
+			grpc = strings.HasPrefix(f.Value, "application/grpc")
+		case "grpc-status":
+			if status, err := strconv.ParseUint(f.Value, 10, 32); err == nil {
+				out.GrpcStatus = &wrappers.UInt32Value{Value: uint32(status)}
+			}
+		case "grpc-message":
+			out.GrpcMessage = f.Value
+		}
+	}
+	if grpc && out.Method != "" {
+		out.GrpcService, out.GrpcMethod = parseGRPCPath(out.Path)
+	}
+	return out
+}
+
+// parseGRPCPath splits /<package>.<service>/<method>
+func parseGRPCPath(path string) (string, string) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+// expire drops idle connections
+func (t *http2Tracker) expire(now time.Time) {
+	for key, flow := range t.flows {
+		if now.Sub(flow.lastSeen) > t.timeout {
+			delete(t.flows, key)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package tracer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// http2Writer encodes HEADERS frames of one direction of a connection
+type http2Writer struct {
+	t   *testing.T
+	buf bytes.Buffer
+	enc *hpack.Encoder
+}
+
+func newHTTP2Writer(t *testing.T) *http2Writer {
+	w := &http2Writer{t: t}
+	w.enc = hpack.NewEncoder(&w.buf)
+	return w
+}
+
+// block encodes a header block
+func (w *http2Writer) block(kv ...string) []byte {
+	w.buf.Reset()
+	for i := 0; i < len(kv); i += 2 {
+		if err := w.enc.WriteField(hpack.HeaderField{Name: kv[i], Value: kv[i+1]}); err != nil {
+			w.t.Fatal(err)
+		}
+	}
+	return append([]byte(nil), w.buf.Bytes()...)
+}
+
+func (w *http2Writer) headers(streamID uint32, endStream bool, kv ...string) []byte {
+	return headersFrame(w.t, streamID, endStream, true, w.block(kv...))
+}
+
+func headersFrame(t *testing.T, streamID uint32, endStream, endHeaders bool, fragment []byte) []byte {
+	var out bytes.Buffer
+	err := http2.NewFramer(&out, nil).WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      streamID,
+		BlockFragment: fragment,
+		EndHeaders:    endHeaders,
+		EndStream:     endStream,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func continuationFrame(t *testing.T, streamID uint32, endHeaders bool, fragment []byte) []byte {
+	var out bytes.Buffer
+	if err := http2.NewFramer(&out, nil).WriteContinuation(streamID, endHeaders, fragment); err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func settingsFrame(t *testing.T) []byte {
+	var out bytes.Buffer
+	if err := http2.NewFramer(&out, nil).WriteSettings(); err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func TestHTTP2Tracker(t *testing.T) {
+	now := time.Unix(100, 0)
+	tracker := newHTTP2Tracker(time.Minute)
+	client := flowKey{srcIP: "10.0.1.5", dstIP: "10.0.2.7", srcPort: 40000, dstPort: 9090}
+	server := client.reverse()
+	cw := newHTTP2Writer(t)
+	sw := newHTTP2Writer(t)
+	request := func(streamID uint32) []byte {
+		return cw.headers(streamID, false,
+			":method", "POST",
+			":scheme", "http",
+			":path", "/juno.Tracer/GetTraces",
+			":authority", "juno-agent:3000",
+			"content-type", "application/grpc",
+		)
+	}
+
+	// unknown connections are not decoded
+	unknown := newHTTP2Writer(t).headers(1, false, ":method", "GET", ":path", "/")
+	if r := tracker.track(client, now, unknown, false); r != nil {
+		t.Errorf("unexpected record without preface: %#v", r)
+	}
+
+	// preface, settings and headers in one segment
+	segment := append(append(append([]byte(nil), http2Preface...), settingsFrame(t)...), request(3)...)
+	r := tracker.track(client, now, segment, false)
+	if r == nil {
+		t.Fatalf("expected request record")
+	}
+	if r.StreamId != 3 || r.Method != "POST" || r.Path != "/juno.Tracer/GetTraces" || r.Authority != "juno-agent:3000" {
+		t.Errorf("unexpected request: %#v", r)
+	}
+	if r.GrpcService != "juno.Tracer" || r.GrpcMethod != "GetTraces" {
+		t.Errorf("unexpected grpc fields: %#v", r)
+	}
+
+	// the second request uses the dynamic table
+	if r := tracker.track(client, now, request(5), false); r.GetPath() != "/juno.Tracer/GetTraces" {
+		t.Errorf("unexpected second request: %#v", r)
+	}
+
+	r = tracker.track(server, now, sw.headers(3, false, ":status", "200", "content-type", "application/grpc"), false)
+	if r.GetCode() != 200 || r.GrpcMethod != "GetTraces" || r.GrpcStatus != nil {
+		t.Errorf("unexpected response: %#v", r)
+	}
+	r = tracker.track(server, now, sw.headers(3, true, "grpc-status", "14", "grpc-message", "unavailable"), false)
+	if r.GetGrpcStatus().GetValue() != 14 || r.GrpcMessage != "unavailable" || r.GrpcService != "juno.Tracer" {
+		t.Errorf("unexpected trailers: %#v", r)
+	}
+	if _, ok := tracker.flows[client].streams[3]; ok {
+		t.Errorf("stream should be closed")
+	}
+	if _, ok := tracker.flows[client].streams[5]; !ok {
+		t.Errorf("stream should be open")
+	}
+
+	// the connection is closed
+	tracker.track(server, now, nil, true)
+	if len(tracker.flows) != 0 {
+		t.Errorf("unexpected flows: %#v", tracker.flows)
+	}
+}
+
+func TestParseGRPCPath(t *testing.T) {
+	for i, row := range []struct {
+		path    string
+		service string
+		method  string
+	}{
+		{path: "/juno.Tracer/GetTraces", service: "juno.Tracer", method: "GetTraces"},
+		{path: "/foo"},
+		{path: "/a/b/c"},
+	} {
+		service, method := parseGRPCPath(row.path)
+		if service != row.service || method != row.method {
+			t.Errorf("[%d] unexpected result: %s %s", i, service, method)
+		}
+	}
+}
+
+func TestHTTP2TrackerContinuation(t *testing.T) {
+	now := time.Unix(100, 0)
+	tracker := newHTTP2Tracker(time.Minute)
+	client := flowKey{srcIP: "10.0.1.5", dstIP: "10.0.2.7", srcPort: 40000, dstPort: 9090}
+	cw := newHTTP2Writer(t)
+	tracker.track(client, now, http2Preface, false)
+
+	// the block is split across segments
+	block := cw.block(":method", "GET", ":path", "/foo", ":authority", "example.com")
+	if r := tracker.track(client, now, headersFrame(t, 1, true, false, block[:4]), false); r != nil {
+		t.Errorf("unexpected record of an incomplete block: %#v", r)
+	}
+	r := tracker.track(client, now, continuationFrame(t, 1, true, block[4:]), false)
+	if r.GetStreamId() != 1 || r.Method != "GET" || r.Path != "/foo" || r.Authority != "example.com" {
+		t.Errorf("unexpected request: %#v", r)
+	}
+}
+
+func TestHTTP2TrackerTruncated(t *testing.T) {
+	now := time.Unix(100, 0)
+	tracker := newHTTP2Tracker(time.Minute)
+	client := flowKey{srcIP: "10.0.1.5", dstIP: "10.0.2.7", srcPort: 40000, dstPort: 9090}
+	server := client.reverse()
+	cw := newHTTP2Writer(t)
+	sw := newHTTP2Writer(t)
+	request := func(streamID uint32) []byte {
+		return cw.headers(streamID, false, ":method", "GET", ":path", "/foo", ":authority", "example.com")
+	}
+	tracker.track(client, now, http2Preface, false)
+
+	// the sample ends within the block
+	segment := request(1)
+	if r := tracker.track(client, now, segment[:len(segment)-3], false); r != nil {
+		t.Errorf("unexpected record of a truncated block: %#v", r)
+	}
+	if tracker.flows[client].decoder != nil {
+		t.Errorf("flow should be desynced")
+	}
+	// the dynamic table of the flow is unknown
+	if r := tracker.track(client, now, request(3), false); r != nil {
+		t.Errorf("unexpected record of a desynced flow: %#v", r)
+	}
+	// the other direction has its own decoder
+	if r := tracker.track(server, now, sw.headers(3, false, ":status", "200"), false); r.GetCode() != 200 {
+		t.Errorf("unexpected response: %#v", r)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"bufio"
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
 )
 
 func TestParseHTTPPartial(t *testing.T) {
@@ -13,6 +16,8 @@ func TestParseHTTPPartial(t *testing.T) {
 		uri    string
 		proto  string
 		code   uint32
+		status string
+		host   string
 		err    bool
 	}{
 		{
@@ -41,29 +46,114 @@ func TestParseHTTPPartial(t *testing.T) {
 			method: "",
 			proto:  "HTTP/1.1",
 			uri:    "",
+			status: "OK",
 			err:    false,
 		},
+		{
+			input:  "GET /foo HTTP/1.0",
+			method: "GET",
+			proto:  "HTTP/1.0",
+			uri:    "/foo",
+		},
+		{
+			input:  "HTTP/1.0 404 Not Found",
+			code:   404,
+			proto:  "HTTP/1.0",
+			status: "Not Found",
+		},
+		{
+			input:  "GET /foo HTTP/1.1\r\nHost: api.example.com\r\nAccept: */*\r\n\r\n",
+			method: "GET",
+			proto:  "HTTP/1.1",
+			uri:    "/foo",
+			host:   "api.example.com",
+		},
+		{
+			// responses have no host
+			input:  "HTTP/1.1 301 Moved Permanently\r\nHost: api.example.com\r\n\r\n",
+			code:   301,
+			proto:  "HTTP/1.1",
+			status: "Moved Permanently",
+		},
 	} {
-		method, uri, proto, code, err := parseHTTPMetadata(bufio.NewReader(strings.NewReader(row.input)))
+		http, err := parseHTTPMetadata(bufio.NewReader(strings.NewReader(row.input)), nil)
 		if (err != nil && !row.err) || (err == nil && row.err) {
 			t.Errorf("unexpected err result. expected %v, got %v", row.err, err)
 		}
+		if http == nil {
+			http = &pb.HTTP{}
+		}
+
+		if row.code != http.Code {
+			t.Errorf("unexpected code. expected %v, found %v", row.code, http.Code)
+		}
+
+		if row.uri != http.Url {
+			t.Errorf("unexpected uri. expected %v, found %v", row.uri, http.Url)
+		}
 
-		if row.code != code {
-			t.Errorf("unexpected code. expected %v, found %v", row.code, code)
+		if row.proto != http.Protocol {
+			t.Errorf("unexpected proto. expected %v, found %v", row.proto, http.Protocol)
 		}
 
-		if row.uri != uri {
-			t.Errorf("unexpected uri. expected %v, found %v", row.uri, uri)
+		if row.method != http.Method {
+			t.Errorf("unexpected method. expected %v, found %v", row.method, http.Method)
 		}
 
-		if row.proto != proto {
-			t.Errorf("unexpected proto. expected %v, found %v", row.proto, proto)
+		if row.status != http.Status {
+			t.Errorf("unexpected status. expected %v, found %v", row.status, http.Status)
 		}
 
-		if row.method != method {
-			t.Errorf("unexpected method. expected %v, found %v", row.method, method)
+		if row.host != http.Host {
+			t.Errorf("unexpected host. expected %v, found %v", row.host, http.Host)
 		}
 	}
 
 }
+
+func TestParseHTTPHeaders(t *testing.T) {
+	request := "GET /foo HTTP/1.1\r\n" +
+		"Host: api.example.com\r\n" +
+		"authorization: Bearer secret\r\n" +
+		"Accept: text/html\r\n" +
+		"Accept: application/json\r\n" +
+		"X-Request-Id: 42\r\n" +
+		"User-Agent: curl/7.6"
+	for i, row := range []struct {
+		filter   *HeaderFilter
+		expected []*pb.HTTPHeader
+	}{
+		{
+			filter: nil,
+		},
+		{
+			filter: NewHeaderFilter(nil, []string{"Authorization"}),
+			expected: []*pb.HTTPHeader{
+				{Key: "Accept", Value: "text/html"},
+				{Key: "Accept", Value: "application/json"},
+				{Key: "Authorization", Value: redactedValue},
+				{Key: "Host", Value: "api.example.com"},
+				{Key: "X-Request-Id", Value: "42"},
+			},
+		},
+		{
+			filter: NewHeaderFilter([]string{"x-request-id", "authorization"}, []string{"authorization"}),
+			expected: []*pb.HTTPHeader{
+				{Key: "Authorization", Value: redactedValue},
+				{Key: "X-Request-Id", Value: "42"},
+			},
+		},
+	} {
+		// the truncated User-Agent line is dropped
+		http, err := parseHTTPMetadata(newHTTPReader([]byte(request)), row.filter)
+		if err != nil {
+			t.Fatalf("[%d] unexpected err: %s", i, err)
+		}
+		if http.Host != "api.example.com" {
+			t.Errorf("[%d] unexpected host: %s", i, http.Host)
+		}
+		if diff := cmp.Diff(row.expected, http.Headers); diff != "" {
+			t.Errorf("[%d] unexpected headers: %s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package tracer
+
+import (
+	"encoding/binary"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	pb "github.com/moolen/juno/proto"
+)
+
+func parseICMPv4(icmp *layers.ICMPv4) *pb.ICMPv4 {
+	out := &pb.ICMPv4{
+		Type: uint32(icmp.TypeCode.Type()),
+		Code: uint32(icmp.TypeCode.Code()),
+	}
+	switch icmp.TypeCode.Type() {
+	case layers.ICMPv4TypeDestinationUnreachable:
+		if icmp.TypeCode.Code() == layers.ICMPv4CodeFragmentationNeeded {
+			// the lower 16 bits of the rest of header contain the next-hop MTU
+			out.Mtu = uint32(icmp.Seq)
+		}
+		out.Original = parseOriginalFlow(icmp.Payload, layers.LayerTypeIPv4)
+	case layers.ICMPv4TypeTimeExceeded:
+		out.Original = parseOriginalFlow(icmp.Payload, layers.LayerTypeIPv4)
+	}
+	return out
+}
+
+func parseICMPv6(icmp *layers.ICMPv6) *pb.ICMPv6 {
+	out := &pb.ICMPv6{
+		Type: uint32(icmp.TypeCode.Type()),
+		Code: uint32(icmp.TypeCode.Code()),
+	}
+	switch icmp.TypeCode.Type() {
+	case layers.ICMPv6TypeDestinationUnreachable,
+		layers.ICMPv6TypePacketTooBig,
+		layers.ICMPv6TypeTimeExceeded:
+		// gopacket only decodes the first 4 bytes of the header,
+		// the payload starts with the rest of the header
+		if len(icmp.Payload) < 4 {
+			return out
+		}
+		if icmp.TypeCode.Type() == layers.ICMPv6TypePacketTooBig {
+			out.Mtu = binary.BigEndian.Uint32(icmp.Payload[:4])
+		}
+		out.Original = parseOriginalFlow(icmp.Payload[4:], layers.LayerTypeIPv6)
+	}
+	return out
+}
+
+// parseOriginalFlow decodes the ip header and the ports of the packet
+// quoted in an ICMP error message. The quoted l4 header is truncated,
+// so only the ports are read.
+func parseOriginalFlow(data []byte, first gopacket.LayerType) *pb.ICMPOriginalFlow {
+	var proto layers.IPProtocol
+	var payload []byte
+	flow := &pb.ICMPOriginalFlow{}
+	switch first {
+	case layers.LayerTypeIPv4:
+		ip := &layers.IPv4{}
+		if err := ip.DecodeFromBytes(data, gopacket.NilDecodeFeedback); err != nil {
+			return nil
+		}
+		flow.IP = &pb.IP{
+			Source:      ip.SrcIP.String(),
+			Destination: ip.DstIP.String(),
+			IpVersion:   pb.IPVersion_IPv4,
+		}
+		proto, payload = ip.Protocol, ip.Payload
+	case layers.LayerTypeIPv6:
+		ip := &layers.IPv6{}
+		if err := ip.DecodeFromBytes(data, gopacket.NilDecodeFeedback); err != nil {
+			return nil
+		}
+		flow.IP = &pb.IP{
+			Source:      ip.SrcIP.String(),
+			Destination: ip.DstIP.String(),
+			IpVersion:   pb.IPVersion_IPv6,
+		}
+		proto, payload = ip.NextHeader, ip.Payload
+	default:
+		return nil
+	}
+	if len(payload) < 4 {
+		return flow
+	}
+	src := uint32(binary.BigEndian.Uint16(payload[0:2]))
+	dst := uint32(binary.BigEndian.Uint16(payload[2:4]))
+	switch proto {
+	case layers.IPProtocolTCP:
+		flow.L4 = &pb.Layer4{
+			Protocol: &pb.Layer4_TCP{
+				TCP: &pb.TCP{
+					SourcePort:      src,
+					DestinationPort: dst,
+				},
+			},
+		}
+	case layers.IPProtocolUDP:
+		flow.L4 = &pb.Layer4{
+			Protocol: &pb.Layer4_UDP{
+				UDP: &pb.UDP{
+					SourcePort:      src,
+					DestinationPort: dst,
+				},
+			},
+		}
+	}
+	return flow
+}
@@ -0,0 +1,140 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+var testMAC = make(net.HardwareAddr, 6)
+
+// quotedPacket returns the ip header and the first
+// 8 bytes of the l4 header of a tcp packet
+func quotedPacket(t *testing.T, ip gopacket.NetworkLayer, headerLen int) []byte {
+	tcp := &layers.TCP{SrcPort: 39198, DstPort: 8080, ACK: true}
+	tcp.SetNetworkLayerForChecksum(ip)
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true},
+		ip.(gopacket.SerializableLayer), tcp, gopacket.Payload(make([]byte, 1400)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()[:headerLen+8]
+}
+
+func TestProcessSampleICMPv4(t *testing.T) {
+	inner := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP("10.0.1.5").To4(),
+		DstIP:    net.ParseIP("10.0.2.7").To4(),
+	}
+	outer := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolICMPv4,
+		SrcIP:    net.ParseIP("10.0.0.1").To4(),
+		DstIP:    net.ParseIP("10.0.1.5").To4(),
+	}
+	for i, row := range []struct {
+		icmp     *layers.ICMPv4
+		payload  []byte
+		mtu      uint32
+		original bool
+	}{
+		{
+			icmp: &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0), Id: 1, Seq: 1},
+		},
+		{
+			icmp:     &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeDestinationUnreachable, layers.ICMPv4CodeFragmentationNeeded), Seq: 1400},
+			payload:  quotedPacket(t, inner, 20),
+			mtu:      1400,
+			original: true,
+		},
+		{
+			icmp:     &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeTimeExceeded, layers.ICMPv4CodeTTLExceeded)},
+			payload:  quotedPacket(t, inner, 20),
+			original: true,
+		},
+	} {
+		data := newSample(t,
+			&layers.Ethernet{EthernetType: layers.EthernetTypeIPv4, SrcMAC: testMAC, DstMAC: testMAC},
+			outer, row.icmp, gopacket.Payload(row.payload),
+		)
+		trace, err := newSampleProcessor(ParserOptions{}).processSample(data)
+		if err != nil {
+			t.Fatalf("[%d] unexpected err: %s", i, err)
+		}
+		icmp := trace.L4.GetICMPv4()
+		if icmp == nil {
+			t.Fatalf("[%d] expected icmpv4: %#v", i, trace.L4)
+		}
+		if icmp.Type != uint32(row.icmp.TypeCode.Type()) || icmp.Code != uint32(row.icmp.TypeCode.Code()) {
+			t.Errorf("[%d] unexpected type/code: %d/%d", i, icmp.Type, icmp.Code)
+		}
+		if icmp.Mtu != row.mtu {
+			t.Errorf("[%d] unexpected mtu: %d", i, icmp.Mtu)
+		}
+		if !row.original {
+			if icmp.Original != nil {
+				t.Errorf("[%d] unexpected original flow: %#v", i, icmp.Original)
+			}
+			continue
+		}
+		if icmp.Original.GetIP().GetSource() != "10.0.1.5" || icmp.Original.GetIP().GetDestination() != "10.0.2.7" {
+			t.Errorf("[%d] unexpected original ip: %#v", i, icmp.Original.GetIP())
+		}
+		tcp := icmp.Original.GetL4().GetTCP()
+		if tcp.GetSourcePort() != 39198 || tcp.GetDestinationPort() != 8080 {
+			t.Errorf("[%d] unexpected original l4: %#v", i, icmp.Original.GetL4())
+		}
+	}
+}
+
+func TestProcessSampleICMPv6(t *testing.T) {
+	inner := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolTCP,
+		SrcIP:      net.ParseIP("fd00::10"),
+		DstIP:      net.ParseIP("fd00::20"),
+	}
+	outer := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolICMPv6,
+		SrcIP:      net.ParseIP("fd00::1"),
+		DstIP:      net.ParseIP("fd00::10"),
+	}
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypePacketTooBig, 0)}
+	icmp.SetNetworkLayerForChecksum(outer)
+	mtu := make([]byte, 4)
+	binary.BigEndian.PutUint32(mtu, 1280)
+	data := newSample(t,
+		&layers.Ethernet{EthernetType: layers.EthernetTypeIPv6, SrcMAC: testMAC, DstMAC: testMAC},
+		outer, icmp, gopacket.Payload(append(mtu, quotedPacket(t, inner, 40)...)),
+	)
+	trace, err := newSampleProcessor(ParserOptions{}).processSample(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := trace.L4.GetICMPv6()
+	if out == nil {
+		t.Fatalf("expected icmpv6: %#v", trace.L4)
+	}
+	if out.Type != uint32(layers.ICMPv6TypePacketTooBig) || out.Mtu != 1280 {
+		t.Errorf("unexpected icmpv6: %#v", out)
+	}
+	if out.Original.GetIP().GetDestination() != "fd00::20" {
+		t.Errorf("unexpected original ip: %#v", out.Original.GetIP())
+	}
+	if out.Original.GetL4().GetTCP().GetDestinationPort() != 8080 {
+		t.Errorf("unexpected original l4: %#v", out.Original.GetL4())
+	}
+}
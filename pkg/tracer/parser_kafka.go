@@ -0,0 +1,194 @@
+package tracer
+
+import (
+	"fmt"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	kafkaProduce = 0
+	kafkaFetch   = 1
+	// requests have a size, api key, api version, correlation id
+	// and the length of the client id
+	kafkaMinHeaderLen = 4 + 2 + 2 + 4 + 2
+	// highest supported api version, anything above
+	// is most likely not a kafka request
+	kafkaMaxAPIVersion = 20
+	// default socket.request.max.bytes of the broker
+	kafkaMaxRequestSize = 100 * 1024 * 1024
+)
+
+// ErrNoKafka indicates that the payload is not a kafka request
+var ErrNoKafka = fmt.Errorf("not a kafka request")
+
+// kafkaAPIs are the names of the api keys
+var kafkaAPIs = []string{
+	"Produce", "Fetch", "ListOffsets", "Metadata", "LeaderAndIsr", "StopReplica",
+	"UpdateMetadata", "ControlledShutdown", "OffsetCommit", "OffsetFetch",
+	"FindCoordinator", "JoinGroup", "Heartbeat", "LeaveGroup", "SyncGroup",
+	"DescribeGroups", "ListGroups", "SaslHandshake", "ApiVersions", "CreateTopics",
+	"DeleteTopics", "DeleteRecords", "InitProducerId", "OffsetForLeaderEpoch",
+	"AddPartitionsToTxn", "AddOffsetsToTxn", "EndTxn", "WriteTxnMarkers",
+	"TxnOffsetCommit", "DescribeAcls", "CreateAcls", "DeleteAcls", "DescribeConfigs",
+	"AlterConfigs", "AlterReplicaLogDirs", "DescribeLogDirs", "SaslAuthenticate",
+	"CreatePartitions", "CreateDelegationToken", "RenewDelegationToken",
+	"ExpireDelegationToken", "DescribeDelegationToken", "DeleteGroups", "ElectLeaders",
+	"IncrementalAlterConfigs", "AlterPartitionReassignments",
+	"ListPartitionReassignments", "OffsetDelete",
+}
+
+// parseKafka decodes the header of a kafka request. The payload is
+// checked for plausibility as kafka has no magic bytes.
+func parseKafka(payload []byte) (*pb.Kafka, error) {
+	if len(payload) < kafkaMinHeaderLen {
+		return nil, ErrNoKafka
+	}
+	r := &kafkaReader{byteReader{data: payload}}
+	size := r.int32()
+	apiKey := r.int16()
+	apiVersion := r.int16()
+	correlationID := r.int32()
+	clientID, ok := r.nullableString()
+	if !ok || size < kafkaMinHeaderLen-4 || size > kafkaMaxRequestSize ||
+		apiKey < 0 || int(apiKey) >= len(kafkaAPIs) ||
+		apiVersion < 0 || apiVersion > kafkaMaxAPIVersion ||
+		!isPrintable(clientID) {
+		return nil, ErrNoKafka
+	}
+	out := &pb.Kafka{
+		ApiKey:        uint32(apiKey),
+		Api:           kafkaAPIs[apiKey],
+		ApiVersion:    uint32(apiVersion),
+		CorrelationId: correlationID,
+		ClientId:      clientID,
+	}
+	flexible := (apiKey == kafkaProduce && apiVersion >= 9) ||
+		(apiKey == kafkaFetch && apiVersion >= 12)
+	if flexible {
+		// request header v2 ends with tagged fields
+		r.skipTaggedFields()
+	}
+	switch apiKey {
+	case kafkaProduce:
+		out.Topics = parseKafkaProduceTopics(r, apiVersion, flexible)
+	case kafkaFetch:
+		out.Topics = parseKafkaFetchTopics(r, apiVersion, flexible)
+	}
+	return out, nil
+}
+
+// parseKafkaProduceTopics reads the topic names of a Produce request.
+// Only the first topic is read for flexible versions.
+func parseKafkaProduceTopics(r *kafkaReader, version int16, flexible bool) []string {
+	if flexible {
+		r.compactString() // transactional_id
+		r.skip(2 + 4)     // acks, timeout_ms
+		if r.uvarint() == 0 {
+			return nil
+		}
+		return validTopics(r.compactString())
+	}
+	if version >= 3 {
+		r.nullableString() // transactional_id
+	}
+	r.skip(2 + 4) // acks, timeout
+	var topics []string
+	for n := r.int32(); n > 0 && r.err == nil; n-- {
+		topic, _ := r.nullableString()
+		if r.err != nil {
+			break
+		}
+		topics = append(topics, validTopics(topic)...)
+		// partitions: index and records
+		for p := r.int32(); p > 0 && r.err == nil; p-- {
+			r.skip(4)
+			if n := r.int32(); n > 0 {
+				r.skip(int(n))
+			}
+		}
+	}
+	return topics
+}
+
+// parseKafkaFetchTopics reads the topic names of a Fetch request.
+// Only the first topic is read for flexible versions,
+// topic ids of version 13 and later are not resolved.
+func parseKafkaFetchTopics(r *kafkaReader, version int16, flexible bool) []string {
+	// replica_id, max_wait_ms, min_bytes
+	r.skip(4 + 4 + 4)
+	if version >= 3 {
+		r.skip(4) // max_bytes
+	}
+	if version >= 4 {
+		r.skip(1) // isolation_level
+	}
+	if version >= 7 {
+		r.skip(4 + 4) // session_id, session_epoch
+	}
+	if flexible {
+		if version >= 13 || r.uvarint() == 0 {
+			return nil
+		}
+		return validTopics(r.compactString())
+	}
+	partitionLen := 4 + 8 + 4 // partition, fetch_offset, partition_max_bytes
+	if version >= 5 {
+		partitionLen += 8 // log_start_offset
+	}
+	if version >= 9 {
+		partitionLen += 4 // current_leader_epoch
+	}
+	var topics []string
+	for n := r.int32(); n > 0 && r.err == nil; n-- {
+		topic, _ := r.nullableString()
+		if r.err != nil {
+			break
+		}
+		topics = append(topics, validTopics(topic)...)
+		r.skip(int(r.int32()) * partitionLen)
+	}
+	return topics
+}
+
+func validTopics(topic string) []string {
+	if topic == "" || !isPrintable(topic) {
+		return nil
+	}
+	return []string{topic}
+}
+
+// kafkaReader reads the strings and tagged fields of the kafka protocol
+type kafkaReader struct {
+	byteReader
+}
+
+// nullableString reads a string with int16 length.
+// It returns false if the string could not be read.
+func (r *kafkaReader) nullableString() (string, bool) {
+	n := r.int16()
+	if r.err != nil {
+		return "", false
+	}
+	if n < 0 {
+		return "", true
+	}
+	b := r.next(int(n))
+	return string(b), r.err == nil
+}
+
+// compactString reads a string with uvarint length + 1
+func (r *kafkaReader) compactString() string {
+	n := r.uvarint()
+	if n == 0 || r.err != nil {
+		return ""
+	}
+	return string(r.next(int(n - 1)))
+}
+
+func (r *kafkaReader) skipTaggedFields() {
+	for n := r.uvarint(); n > 0 && r.err == nil; n-- {
+		r.uvarint() // tag
+		r.skip(int(r.uvarint()))
+	}
+}
@@ -0,0 +1,171 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+// kafkaRequest builds a request with a v1 header
+type kafkaRequest []byte
+
+func (k kafkaRequest) i8(v int8) kafkaRequest { return append(k, byte(v)) }
+func (k kafkaRequest) i16(v int16) kafkaRequest {
+	return append(k, byte(v>>8), byte(v))
+}
+func (k kafkaRequest) i32(v int32) kafkaRequest {
+	return append(k, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+func (k kafkaRequest) i64(v int64) kafkaRequest { return k.i32(int32(v >> 32)).i32(int32(v)) }
+func (k kafkaRequest) str(s string) kafkaRequest {
+	return append(k.i16(int16(len(s))), s...)
+}
+func (k kafkaRequest) uvarint(v uint64) kafkaRequest {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(k, buf[:binary.PutUvarint(buf, v)]...)
+}
+func (k kafkaRequest) compactStr(s string) kafkaRequest {
+	return append(k.uvarint(uint64(len(s)+1)), s...)
+}
+func (k kafkaRequest) append(b []byte) kafkaRequest { return append(k, b...) }
+
+func newKafkaRequest(apiKey, apiVersion int16, correlationID int32, clientID string) kafkaRequest {
+	// the size is set by bytes()
+	return kafkaRequest{}.i32(0).i16(apiKey).i16(apiVersion).i32(correlationID).str(clientID)
+}
+
+func (k kafkaRequest) bytes() []byte {
+	binary.BigEndian.PutUint32(k[:4], uint32(len(k)-4))
+	return k
+}
+
+func TestParseKafka(t *testing.T) {
+	records := make([]byte, 20)
+	for i, row := range []struct {
+		input    []byte
+		expected *pb.Kafka
+		err      bool
+	}{
+		{
+			input: []byte(""),
+			err:   true,
+		},
+		{
+			input: []byte("GET /foo HTTP/1.1\r\n\r\n"),
+			err:   true,
+		},
+		{
+			// unknown api key
+			input: newKafkaRequest(99, 0, 1, "producer").bytes(),
+			err:   true,
+		},
+		{
+			// binary client id
+			input: newKafkaRequest(3, 1, 1, "\x00\x01").bytes(),
+			err:   true,
+		},
+		{
+			input: newKafkaRequest(3, 8, 42, "consumer-1").i32(0).bytes(),
+			expected: &pb.Kafka{
+				ApiKey:        3,
+				Api:           "Metadata",
+				ApiVersion:    8,
+				CorrelationId: 42,
+				ClientId:      "consumer-1",
+			},
+		},
+		{
+			// produce v2 with two topics
+			input: newKafkaRequest(0, 2, 7, "producer").
+				i16(1).i32(1000).
+				i32(2).
+				str("orders").i32(1).i32(0).i32(int32(len(records))).append(records).
+				str("payments").i32(1).i32(0).i32(int32(len(records))).append(records).
+				bytes(),
+			expected: &pb.Kafka{
+				ApiKey:        0,
+				Api:           "Produce",
+				ApiVersion:    2,
+				CorrelationId: 7,
+				ClientId:      "producer",
+				Topics:        []string{"orders", "payments"},
+			},
+		},
+		{
+			// produce v7 with transactional id, the sample is truncated
+			input: newKafkaRequest(0, 7, 8, "producer").
+				str("tx").i16(-1).i32(1000).
+				i32(2).
+				str("orders").i32(1).i32(0).i32(512).append(records).
+				bytes(),
+			expected: &pb.Kafka{
+				ApiKey:        0,
+				Api:           "Produce",
+				ApiVersion:    7,
+				CorrelationId: 8,
+				ClientId:      "producer",
+				Topics:        []string{"orders"},
+			},
+		},
+		{
+			// produce v9: flexible version
+			input: newKafkaRequest(0, 9, 9, "producer").
+				uvarint(0).
+				compactStr("").i16(1).i32(1000).
+				uvarint(2).compactStr("orders").
+				bytes(),
+			expected: &pb.Kafka{
+				ApiKey:        0,
+				Api:           "Produce",
+				ApiVersion:    9,
+				CorrelationId: 9,
+				ClientId:      "producer",
+				Topics:        []string{"orders"},
+			},
+		},
+		{
+			// fetch v11 with two topics
+			input: newKafkaRequest(1, 11, 10, "consumer").
+				i32(-1).i32(500).i32(1).i32(1024).i8(0).i32(0).i32(-1).
+				i32(2).
+				str("orders").i32(1).i32(0).i32(0).i64(100).i64(0).i32(1024).
+				str("payments").i32(1).i32(0).i32(0).i64(100).i64(0).i32(1024).
+				bytes(),
+			expected: &pb.Kafka{
+				ApiKey:        1,
+				Api:           "Fetch",
+				ApiVersion:    11,
+				CorrelationId: 10,
+				ClientId:      "consumer",
+				Topics:        []string{"orders", "payments"},
+			},
+		},
+		{
+			// fetch v12: flexible version
+			input: newKafkaRequest(1, 12, 11, "consumer").
+				uvarint(0).
+				i32(-1).i32(500).i32(1).i32(1024).i8(0).i32(0).i32(-1).
+				uvarint(2).compactStr("orders").
+				bytes(),
+			expected: &pb.Kafka{
+				ApiKey:        1,
+				Api:           "Fetch",
+				ApiVersion:    12,
+				CorrelationId: 11,
+				ClientId:      "consumer",
+				Topics:        []string{"orders"},
+			},
+		},
+	} {
+		kafka, err := parseKafka(row.input)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, kafka); diff != "" {
+			t.Errorf("[%d] unexpected kafka record: %s", i, diff)
+		}
+	}
+}
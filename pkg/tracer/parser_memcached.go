@@ -0,0 +1,66 @@
+package tracer
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+const protocolMemcached = "memcached"
+
+// ErrNoMemcached indicates that the payload is
+// not a memcached text protocol message
+var ErrNoMemcached = fmt.Errorf("not a memcached message")
+
+// memcachedCommands maps the commands to the
+// position of their key, zero if there is none
+var memcachedCommands = map[string]int{
+	"get": 1, "gets": 1, "gat": 2, "gats": 2, "touch": 1,
+	"set": 1, "add": 1, "replace": 1, "append": 1, "prepend": 1, "cas": 1,
+	"delete": 1, "incr": 1, "decr": 1,
+	"flush_all": 0, "version": 0, "stats": 0, "verbosity": 0, "quit": 0,
+}
+
+// memcachedReplies are the first words of replies. The value is true for errors
+var memcachedReplies = map[string]bool{
+	"VALUE": false, "END": false, "STORED": false, "NOT_STORED": false,
+	"EXISTS": false, "NOT_FOUND": false, "DELETED": false, "TOUCHED": false,
+	"OK": false, "VERSION": false, "STAT": false,
+	"ERROR": true, "CLIENT_ERROR": true, "SERVER_ERROR": true,
+}
+
+// parseMemcached decodes the first line of a memcached text protocol
+// command or reply
+func parseMemcached(payload []byte, keys KeyMode) (*pb.Cache, error) {
+	line, _, ok := readLine(payload)
+	if !ok || !isPrintable(string(line)) {
+		return nil, ErrNoMemcached
+	}
+	fields := strings.Fields(string(line))
+	if len(fields) == 0 {
+		return nil, ErrNoMemcached
+	}
+	out := &pb.Cache{
+		Protocol: protocolMemcached,
+	}
+	if pos, ok := memcachedCommands[fields[0]]; ok {
+		out.Command = fields[0]
+		if pos > 0 && pos < len(fields) {
+			out.Key = keys.apply(fields[pos])
+		}
+		return out, nil
+	}
+	isError, ok := memcachedReplies[fields[0]]
+	if !ok {
+		return nil, ErrNoMemcached
+	}
+	out.Reply = fields[0]
+	if isError {
+		out.Error = strings.TrimSpace(strings.TrimPrefix(string(line), fields[0]))
+	}
+	if fields[0] == "VALUE" && len(fields) > 1 {
+		out.Key = keys.apply(fields[1])
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package tracer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+func TestParseMemcached(t *testing.T) {
+	for i, row := range []struct {
+		input    string
+		expected *pb.Cache
+		err      bool
+	}{
+		{
+			input: "",
+			err:   true,
+		},
+		{
+			input: "GET /foo HTTP/1.1\r\n\r\n",
+			err:   true,
+		},
+		{
+			// missing CRLF
+			input: "get user:42",
+			err:   true,
+		},
+		{
+			input: "get user:42\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Command:  "get",
+				Key:      "user:42",
+			},
+		},
+		{
+			input: "set user:42 0 3600 5\r\nhello\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Command:  "set",
+				Key:      "user:42",
+			},
+		},
+		{
+			input: "gat 3600 user:42\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Command:  "gat",
+				Key:      "user:42",
+			},
+		},
+		{
+			input: "version\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Command:  "version",
+			},
+		},
+		{
+			input: "VALUE user:42 0 5\r\nhello\r\nEND\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Reply:    "VALUE",
+				Key:      "user:42",
+			},
+		},
+		{
+			input: "STORED\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Reply:    "STORED",
+			},
+		},
+		{
+			input: "SERVER_ERROR out of memory storing object\r\n",
+			expected: &pb.Cache{
+				Protocol: "memcached",
+				Reply:    "SERVER_ERROR",
+				Error:    "out of memory storing object",
+			},
+		},
+	} {
+		cache, err := parseMemcached([]byte(row.input), KeyModePlain)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, cache); diff != "" {
+			t.Errorf("[%d] unexpected cache record: %s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	protocolMySQL = "mysql"
+
+	mysqlComInitDB      = 0x02
+	mysqlComQuery       = 0x03
+	mysqlComStmtPrepare = 0x16
+	mysqlErrPacket      = 0xff
+
+	mysqlClientConnectWithDB        = 0x00000008
+	mysqlClientProtocol41           = 0x00000200
+	mysqlClientSecureConnection     = 0x00008000
+	mysqlClientPluginAuthLenencData = 0x00200000
+
+	// capability flags, max packet size, character set and filler
+	mysqlHandshakeResponseLen = 4 + 4 + 1 + 23
+)
+
+// ErrNoMySQL indicates that the payload is not a mysql packet
+var ErrNoMySQL = fmt.Errorf("not a mysql packet")
+
+// parseMySQL decodes the first packet of the payload. It supports
+// the handshake response, queries, prepared statements and errors.
+func parseMySQL(payload []byte) (*pb.SQL, error) {
+	if len(payload) < 5 {
+		return nil, ErrNoMySQL
+	}
+	length := int(payload[0]) | int(payload[1])<<8 | int(payload[2])<<16
+	seq := payload[3]
+	body := payload[4:]
+	if length == 0 {
+		return nil, ErrNoMySQL
+	}
+	if len(body) > length {
+		body = body[:length]
+	}
+	var out *pb.SQL
+	switch {
+	case seq == 0 && (body[0] == mysqlComQuery || body[0] == mysqlComStmtPrepare):
+		out = newSQLQuery(protocolMySQL, string(body[1:]), true)
+		if out == nil && len(body) > 3 && body[0] == mysqlComQuery && body[1] == 0 && body[2] == 1 {
+			// CLIENT_QUERY_ATTRIBUTES without attributes
+			out = newSQLQuery(protocolMySQL, string(body[3:]), true)
+		}
+	case seq == 0 && body[0] == mysqlComInitDB:
+		database := string(body[1:])
+		if database != "" && isPrintable(database) {
+			out = &pb.SQL{
+				Protocol:  protocolMySQL,
+				Statement: "USE",
+				Database:  database,
+			}
+		}
+	case seq > 0 && body[0] == mysqlErrPacket:
+		out = parseMySQLError(body)
+	case seq == 1:
+		out = parseMySQLHandshakeResponse(body)
+	}
+	if out == nil {
+		return nil, ErrNoMySQL
+	}
+	return out, nil
+}
+
+// parseMySQLError reads the error number and message of an ERR packet
+func parseMySQLError(body []byte) *pb.SQL {
+	if len(body) < 3 {
+		return nil
+	}
+	code := binary.LittleEndian.Uint16(body[1:3])
+	message := body[3:]
+	if len(message) >= 6 && message[0] == '#' {
+		// sql state
+		message = message[6:]
+	}
+	if !isQueryText(string(message)) {
+		return nil
+	}
+	return &pb.SQL{
+		Protocol:     protocolMySQL,
+		ErrorCode:    strconv.Itoa(int(code)),
+		ErrorMessage: string(message),
+	}
+}
+
+// parseMySQLHandshakeResponse reads the user and database of a
+// HandshakeResponse41. The SSLRequest has no user and is ignored.
+func parseMySQLHandshakeResponse(body []byte) *pb.SQL {
+	if len(body) <= mysqlHandshakeResponseLen {
+		return nil
+	}
+	caps := binary.LittleEndian.Uint32(body[0:4])
+	if caps&mysqlClientProtocol41 == 0 {
+		return nil
+	}
+	for _, b := range body[9:mysqlHandshakeResponseLen] {
+		if b != 0 {
+			return nil
+		}
+	}
+	user, rest := cstring(body[mysqlHandshakeResponseLen:])
+	if len(user) == 0 || !isPrintable(string(user)) {
+		return nil
+	}
+	out := &pb.SQL{
+		Protocol: protocolMySQL,
+		User:     string(user),
+	}
+	if caps&mysqlClientConnectWithDB == 0 {
+		return out
+	}
+	// skip the auth response
+	switch {
+	case caps&mysqlClientPluginAuthLenencData != 0:
+		n, size := lenencInt(rest)
+		if size == 0 || uint64(len(rest)-size) < n {
+			return out
+		}
+		rest = rest[uint64(size)+n:]
+	case caps&mysqlClientSecureConnection != 0:
+		if len(rest) == 0 || len(rest) < 1+int(rest[0]) {
+			return out
+		}
+		rest = rest[1+int(rest[0]):]
+	default:
+		_, rest = cstring(rest)
+	}
+	if database, _ := cstring(rest); isPrintable(string(database)) {
+		out.Database = string(database)
+	}
+	return out
+}
+
+// lenencInt reads a length encoded integer.
+// It returns the value and the number of bytes read, zero on error.
+func lenencInt(data []byte) (uint64, int) {
+	if len(data) == 0 {
+		return 0, 0
+	}
+	size := 1
+	switch data[0] {
+	case 0xfc:
+		size = 3
+	case 0xfd:
+		size = 4
+	case 0xfe:
+		size = 9
+	case 0xfb, 0xff:
+		return 0, 0
+	default:
+		return uint64(data[0]), 1
+	}
+	if len(data) < size {
+		return 0, 0
+	}
+	var v uint64
+	for i := size - 1; i >= 1; i-- {
+		v = v<<8 | uint64(data[i])
+	}
+	return v, size
+}
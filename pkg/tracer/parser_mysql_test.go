@@ -0,0 +1,113 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+// mysqlPacket builds a packet with length and sequence id
+func mysqlPacket(seq byte, body string) []byte {
+	n := len(body)
+	return append([]byte{byte(n), byte(n >> 8), byte(n >> 16), seq}, body...)
+}
+
+func mysqlHandshakeResponse(caps uint32, user, auth, database string) []byte {
+	body := make([]byte, mysqlHandshakeResponseLen)
+	binary.LittleEndian.PutUint32(body, caps)
+	body = append(body, user...)
+	body = append(body, 0, byte(len(auth)))
+	body = append(body, auth...)
+	body = append(body, database...)
+	body = append(body, 0)
+	return mysqlPacket(1, string(body))
+}
+
+func TestParseMySQL(t *testing.T) {
+	for i, row := range []struct {
+		input    []byte
+		expected *pb.SQL
+		err      bool
+	}{
+		{
+			input: []byte(""),
+			err:   true,
+		},
+		{
+			input: []byte("GET /foo HTTP/1.1\r\n\r\n"),
+			err:   true,
+		},
+		{
+			input: mysqlPacket(0, "\x03SELECT name FROM users WHERE id = 1"),
+			expected: &pb.SQL{
+				Protocol:    "mysql",
+				Statement:   "SELECT",
+				Fingerprint: "SELECT name FROM users WHERE id = ?",
+			},
+		},
+		{
+			// query attributes
+			input: mysqlPacket(0, "\x03\x00\x01INSERT INTO logs VALUES (\"x\", 2)"),
+			expected: &pb.SQL{
+				Protocol:    "mysql",
+				Statement:   "INSERT",
+				Fingerprint: "INSERT INTO logs VALUES (?)",
+			},
+		},
+		{
+			input: mysqlPacket(0, "\x16UPDATE users SET name = ? WHERE id = ?"),
+			expected: &pb.SQL{
+				Protocol:    "mysql",
+				Statement:   "UPDATE",
+				Fingerprint: "UPDATE users SET name = ? WHERE id = ?",
+			},
+		},
+		{
+			// not a statement
+			input: mysqlPacket(0, "\x03hello"),
+			err:   true,
+		},
+		{
+			input: mysqlPacket(0, "\x02shop"),
+			expected: &pb.SQL{
+				Protocol:  "mysql",
+				Statement: "USE",
+				Database:  "shop",
+			},
+		},
+		{
+			input: mysqlPacket(1, "\xff\x7a\x04#42S02Table 'shop.foo' doesn't exist"),
+			expected: &pb.SQL{
+				Protocol:     "mysql",
+				ErrorCode:    "1146",
+				ErrorMessage: "Table 'shop.foo' doesn't exist",
+			},
+		},
+		{
+			input: mysqlHandshakeResponse(
+				mysqlClientProtocol41|mysqlClientSecureConnection|mysqlClientConnectWithDB,
+				"app", "\x01\x02\x03\x04", "shop"),
+			expected: &pb.SQL{
+				Protocol: "mysql",
+				Database: "shop",
+				User:     "app",
+			},
+		},
+		{
+			// SSLRequest
+			input: mysqlPacket(1, string(make([]byte, mysqlHandshakeResponseLen))),
+			err:   true,
+		},
+	} {
+		sql, err := parseMySQL(row.input)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, sql); diff != "" {
+			t.Errorf("[%d] unexpected sql record: %s", i, diff)
+		}
+	}
+}
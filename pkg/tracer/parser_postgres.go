@@ -0,0 +1,123 @@
+package tracer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	protocolPostgres = "postgres"
+	// protocol version 3.0 of the startup message
+	postgresProtocolVersion = 196608
+	// the server rejects larger startup messages
+	postgresMaxStartupLen = 10000
+	// the server rejects larger messages
+	postgresMaxMessageLen = 1 << 30
+)
+
+// ErrNoPostgres indicates that the payload is not a postgres message
+var ErrNoPostgres = fmt.Errorf("not a postgres message")
+
+// parsePostgres decodes the first message of the payload. It supports
+// the startup message, simple and extended queries and errors.
+func parsePostgres(payload []byte) (*pb.SQL, error) {
+	if len(payload) >= 8 && payload[0] == 0 {
+		return parsePostgresStartup(payload)
+	}
+	if len(payload) < 5 {
+		return nil, ErrNoPostgres
+	}
+	length := binary.BigEndian.Uint32(payload[1:5])
+	if length < 4 || length > postgresMaxMessageLen {
+		return nil, ErrNoPostgres
+	}
+	body := payload[5:]
+	if uint32(len(body)) > length-4 {
+		body = body[:length-4]
+	}
+	var out *pb.SQL
+	switch payload[0] {
+	case 'Q':
+		query, _ := cstring(body)
+		out = newSQLQuery(protocolPostgres, string(query), false)
+	case 'P':
+		_, rest := cstring(body) // statement name
+		query, _ := cstring(rest)
+		out = newSQLQuery(protocolPostgres, string(query), false)
+	case 'E':
+		// Execute has the same type as ErrorResponse but starts with
+		// the portal name, which is usually empty
+		out = parsePostgresError(body)
+	}
+	if out == nil {
+		return nil, ErrNoPostgres
+	}
+	return out, nil
+}
+
+// parsePostgresStartup reads the user and database of a startup message
+func parsePostgresStartup(payload []byte) (*pb.SQL, error) {
+	length := binary.BigEndian.Uint32(payload[0:4])
+	version := binary.BigEndian.Uint32(payload[4:8])
+	if version != postgresProtocolVersion || length < 8 || length > postgresMaxStartupLen {
+		return nil, ErrNoPostgres
+	}
+	out := &pb.SQL{
+		Protocol: protocolPostgres,
+	}
+	params := payload[8:]
+	for len(params) > 0 && params[0] != 0 {
+		var key, value []byte
+		key, params = cstring(params)
+		value, params = cstring(params)
+		switch string(key) {
+		case "user":
+			out.User = string(value)
+		case "database":
+			out.Database = string(value)
+		}
+	}
+	if out.User == "" || !isPrintable(out.User) || !isPrintable(out.Database) {
+		return nil, ErrNoPostgres
+	}
+	if out.Database == "" {
+		// the server defaults to the user name
+		out.Database = out.User
+	}
+	return out, nil
+}
+
+// parsePostgresError reads the SQLSTATE and message of an ErrorResponse
+func parsePostgresError(body []byte) *pb.SQL {
+	out := &pb.SQL{
+		Protocol: protocolPostgres,
+	}
+	for len(body) > 0 && body[0] != 0 {
+		field := body[0]
+		var value []byte
+		value, body = cstring(body[1:])
+		switch field {
+		case 'C':
+			out.ErrorCode = string(value)
+		case 'M':
+			out.ErrorMessage = string(value)
+		}
+	}
+	if len(out.ErrorCode) != 5 || !isPrintable(out.ErrorCode) || !isQueryText(out.ErrorMessage) {
+		return nil
+	}
+	return out
+}
+
+// cstring returns the data until the next NUL byte and the data after it.
+// The whole data is returned if it is truncated.
+func cstring(data []byte) ([]byte, []byte) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return data, nil
+	}
+	return data[:i], data[i+1:]
+}
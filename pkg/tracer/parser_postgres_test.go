@@ -0,0 +1,109 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+// postgresMessage builds a message with type and length
+func postgresMessage(typ byte, body string) []byte {
+	out := []byte{typ, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(out[1:], uint32(len(body)+4))
+	return append(out, body...)
+}
+
+func postgresStartup(params string) []byte {
+	out := make([]byte, 8)
+	binary.BigEndian.PutUint32(out, uint32(len(params)+8))
+	binary.BigEndian.PutUint32(out[4:], postgresProtocolVersion)
+	return append(out, params...)
+}
+
+func TestParsePostgres(t *testing.T) {
+	for i, row := range []struct {
+		input    []byte
+		expected *pb.SQL
+		err      bool
+	}{
+		{
+			input: []byte(""),
+			err:   true,
+		},
+		{
+			input: []byte("GET /foo HTTP/1.1\r\n\r\n"),
+			err:   true,
+		},
+		{
+			input: postgresStartup("user\x00app\x00database\x00shop\x00application_name\x00psql\x00\x00"),
+			expected: &pb.SQL{
+				Protocol: "postgres",
+				Database: "shop",
+				User:     "app",
+			},
+		},
+		{
+			// database defaults to the user
+			input: postgresStartup("user\x00app\x00\x00"),
+			expected: &pb.SQL{
+				Protocol: "postgres",
+				Database: "app",
+				User:     "app",
+			},
+		},
+		{
+			// SSLRequest
+			input: []byte{0, 0, 0, 8, 0x04, 0xd2, 0x16, 0x2f},
+			err:   true,
+		},
+		{
+			input: postgresMessage('Q', "SELECT * FROM orders WHERE id = 7\x00"),
+			expected: &pb.SQL{
+				Protocol:    "postgres",
+				Statement:   "SELECT",
+				Fingerprint: "SELECT * FROM orders WHERE id = ?",
+			},
+		},
+		{
+			// truncated sample
+			input: postgresMessage('Q', "SELECT * FROM orders WHERE id = 7\x00")[:25],
+			expected: &pb.SQL{
+				Protocol:    "postgres",
+				Statement:   "SELECT",
+				Fingerprint: "SELECT * FROM orders",
+			},
+		},
+		{
+			input: postgresMessage('P', "stmt1\x00DELETE FROM carts WHERE user_id = $1\x00\x00\x00"),
+			expected: &pb.SQL{
+				Protocol:    "postgres",
+				Statement:   "DELETE",
+				Fingerprint: "DELETE FROM carts WHERE user_id = $1",
+			},
+		},
+		{
+			input: postgresMessage('E', "SERROR\x00VERROR\x00C42P01\x00Mrelation \"foo\" does not exist\x00\x00"),
+			expected: &pb.SQL{
+				Protocol:     "postgres",
+				ErrorCode:    "42P01",
+				ErrorMessage: `relation "foo" does not exist`,
+			},
+		},
+		{
+			// Execute of the unnamed portal
+			input: postgresMessage('E', "\x00\x00\x00\x00\x00"),
+			err:   true,
+		},
+	} {
+		sql, err := parsePostgres(row.input)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, sql); diff != "" {
+			t.Errorf("[%d] unexpected sql record: %s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package tracer
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+const protocolRedis = "redis"
+
+// ErrNoRedis indicates that the payload is not a RESP message
+var ErrNoRedis = fmt.Errorf("not a redis message")
+
+var crlf = []byte("\r\n")
+
+// redisCommands are the known commands. The value is false
+// for commands without a key as first argument.
+var redisCommands = map[string]bool{
+	// keys and strings
+	"APPEND": true, "DECR": true, "DECRBY": true, "DEL": true, "DUMP": true,
+	"EXISTS": true, "EXPIRE": true, "EXPIREAT": true, "GET": true, "GETBIT": true,
+	"GETRANGE": true, "GETSET": true, "INCR": true, "INCRBY": true,
+	"INCRBYFLOAT": true, "MGET": true, "MSET": true, "MSETNX": true,
+	"PERSIST": true, "PEXPIRE": true, "PEXPIREAT": true, "PSETEX": true,
+	"PTTL": true, "RENAME": true, "RENAMENX": true, "RESTORE": true, "SET": true,
+	"SETBIT": true, "SETEX": true, "SETNX": true, "SETRANGE": true, "STRLEN": true,
+	"TOUCH": true, "TTL": true, "TYPE": true, "UNLINK": true, "BITCOUNT": true,
+	"BITPOS": true, "BITFIELD": true, "BITOP": false,
+	// hashes
+	"HDEL": true, "HEXISTS": true, "HGET": true, "HGETALL": true, "HINCRBY": true,
+	"HINCRBYFLOAT": true, "HKEYS": true, "HLEN": true, "HMGET": true,
+	"HMSET": true, "HSCAN": true, "HSET": true, "HSETNX": true, "HSTRLEN": true,
+	"HVALS": true,
+	// lists
+	"BLPOP": true, "BRPOP": true, "BRPOPLPUSH": true, "LINDEX": true,
+	"LINSERT": true, "LLEN": true, "LPOP": true, "LPOS": true, "LPUSH": true,
+	"LPUSHX": true, "LRANGE": true, "LREM": true, "LSET": true, "LTRIM": true,
+	"RPOP": true, "RPOPLPUSH": true, "RPUSH": true, "RPUSHX": true,
+	// sets
+	"SADD": true, "SCARD": true, "SDIFF": true, "SDIFFSTORE": true,
+	"SINTER": true, "SINTERSTORE": true, "SISMEMBER": true, "SMEMBERS": true,
+	"SMOVE": true, "SPOP": true, "SRANDMEMBER": true, "SREM": true, "SSCAN": true,
+	"SUNION": true, "SUNIONSTORE": true,
+	// sorted sets
+	"BZPOPMAX": true, "BZPOPMIN": true, "ZADD": true, "ZCARD": true,
+	"ZCOUNT": true, "ZINCRBY": true, "ZLEXCOUNT": true, "ZPOPMAX": true,
+	"ZPOPMIN": true, "ZRANGE": true, "ZRANGEBYLEX": true, "ZRANGEBYSCORE": true,
+	"ZRANK": true, "ZREM": true, "ZREMRANGEBYLEX": true, "ZREMRANGEBYRANK": true,
+	"ZREMRANGEBYSCORE": true, "ZREVRANGE": true, "ZREVRANGEBYLEX": true,
+	"ZREVRANGEBYSCORE": true, "ZREVRANK": true, "ZSCAN": true, "ZSCORE": true,
+	"ZINTERSTORE": true, "ZUNIONSTORE": true,
+	// hyperloglog, geo and streams
+	"PFADD": true, "PFCOUNT": true, "PFMERGE": true, "GEOADD": true,
+	"GEODIST": true, "GEOHASH": true, "GEOPOS": true, "GEORADIUS": true,
+	"GEORADIUSBYMEMBER": true, "XACK": true, "XADD": true, "XCLAIM": true,
+	"XDEL": true, "XLEN": true, "XPENDING": true, "XRANGE": true,
+	"XREVRANGE": true, "XTRIM": true, "XINFO": false, "XGROUP": false,
+	"XREAD": false, "XREADGROUP": false,
+	// pub/sub, scripting, transactions and server
+	"PUBLISH": false, "SUBSCRIBE": false, "PSUBSCRIBE": false,
+	"UNSUBSCRIBE": false, "PUNSUBSCRIBE": false, "PUBSUB": false,
+	"EVAL": false, "EVALSHA": false, "SCRIPT": false, "MULTI": false,
+	"EXEC": false, "DISCARD": false, "WATCH": true, "UNWATCH": false,
+	"AUTH": false, "HELLO": false, "ECHO": false, "PING": false, "QUIT": false,
+	"SELECT": false, "SWAPDB": false, "CLIENT": false, "CLUSTER": false,
+	"COMMAND": false, "CONFIG": false, "DBSIZE": false, "FLUSHALL": false,
+	"FLUSHDB": false, "INFO": false, "KEYS": false, "LASTSAVE": false,
+	"MEMORY": false, "MONITOR": false, "OBJECT": false, "RANDOMKEY": false,
+	"READONLY": false, "READWRITE": false, "ROLE": false, "SAVE": false,
+	"BGSAVE": false, "SCAN": false, "SLOWLOG": false, "TIME": false, "WAIT": false,
+}
+
+// parseRedis decodes a RESP command or the type of a RESP reply.
+// Arrays which do not start with a known command are replies.
+func parseRedis(payload []byte, keys KeyMode) (*pb.Cache, error) {
+	line, rest, ok := readLine(payload)
+	if !ok || len(line) < 2 || !isPrintable(string(line)) {
+		return nil, ErrNoRedis
+	}
+	out := &pb.Cache{
+		Protocol: protocolRedis,
+	}
+	value := string(line[1:])
+	switch line[0] {
+	case '+':
+		out.Reply = "simple_string"
+	case '-':
+		out.Reply = "error"
+		out.Error = value
+	case ':':
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, ErrNoRedis
+		}
+		out.Reply = "integer"
+	case '$':
+		n, err := strconv.Atoi(value)
+		if err != nil || n < -1 {
+			return nil, ErrNoRedis
+		}
+		out.Reply = "bulk_string"
+		if n == -1 {
+			out.Reply = "null"
+		}
+	case '*':
+		n, err := strconv.Atoi(value)
+		if err != nil || n < -1 {
+			return nil, ErrNoRedis
+		}
+		out.Reply = "array"
+		if n == -1 {
+			out.Reply = "null"
+		}
+		if n < 1 {
+			return out, nil
+		}
+		verb, rest, ok := readBulkString(rest)
+		if !ok {
+			return out, nil
+		}
+		command := strings.ToUpper(verb)
+		hasKey, known := redisCommands[command]
+		if !known {
+			return out, nil
+		}
+		out.Reply = ""
+		out.Command = command
+		if hasKey && n > 1 {
+			if key, _, ok := readBulkString(rest); ok {
+				out.Key = keys.apply(key)
+			}
+		}
+	default:
+		return nil, ErrNoRedis
+	}
+	return out, nil
+}
+
+// readLine returns the data until the next CRLF
+func readLine(data []byte) ([]byte, []byte, bool) {
+	i := bytes.Index(data, crlf)
+	if i < 0 {
+		return nil, nil, false
+	}
+	return data[:i], data[i+2:], true
+}
+
+// readBulkString reads a complete "$<len>\r\n<data>\r\n"
+func readBulkString(data []byte) (string, []byte, bool) {
+	line, rest, ok := readLine(data)
+	if !ok || len(line) < 2 || line[0] != '$' {
+		return "", nil, false
+	}
+	n, err := strconv.Atoi(string(line[1:]))
+	// n+2 overflows for huge lengths
+	if err != nil || n < 0 || n > len(rest)-2 || !bytes.Equal(rest[n:n+2], crlf) {
+		return "", nil, false
+	}
+	return string(rest[:n]), rest[n+2:], true
+}
@@ -0,0 +1,150 @@
+package tracer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+func TestParseRedis(t *testing.T) {
+	for i, row := range []struct {
+		input    string
+		keys     KeyMode
+		expected *pb.Cache
+		err      bool
+	}{
+		{
+			input: "",
+			err:   true,
+		},
+		{
+			input: "GET /foo HTTP/1.1\r\n\r\n",
+			err:   true,
+		},
+		{
+			// missing CRLF
+			input: "+OK",
+			err:   true,
+		},
+		{
+			input: ":abc\r\n",
+			err:   true,
+		},
+		{
+			input: "*3\r\n$3\r\nSET\r\n$7\r\nuser:42\r\n$5\r\nhello\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Command:  "SET",
+				Key:      "user:42",
+			},
+		},
+		{
+			// lower case verb, truncated value
+			input: "*2\r\n$3\r\nget\r\n$7\r\nuser:42\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Command:  "GET",
+				Key:      "user:42",
+			},
+		},
+		{
+			// truncated key
+			input: "*2\r\n$3\r\nGET\r\n$7\r\nuse",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Command:  "GET",
+			},
+		},
+		{
+			input: "*1\r\n$4\r\nPING\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Command:  "PING",
+			},
+		},
+		{
+			input: "*2\r\n$3\r\nGET\r\n$7\r\nuser:42\r\n",
+			keys:  KeyModeHash,
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Command:  "GET",
+				Key:      "sha256:ea3fd43be1e57d62",
+			},
+		},
+		{
+			input: "*2\r\n$3\r\nGET\r\n$7\r\nuser:42\r\n",
+			keys:  KeyModeRedact,
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Command:  "GET",
+				Key:      "[redacted]",
+			},
+		},
+		{
+			input: "+OK\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "simple_string",
+			},
+		},
+		{
+			input: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "error",
+				Error:    "WRONGTYPE Operation against a key holding the wrong kind of value",
+			},
+		},
+		{
+			input: ":1000\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "integer",
+			},
+		},
+		{
+			input: "$5\r\nhello\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "bulk_string",
+			},
+		},
+		{
+			input: "$-1\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "null",
+			},
+		},
+		{
+			// the length of the bulk string overflows
+			input: "*1\r\n$9223372036854775807\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "array",
+			},
+		},
+		{
+			// array reply which does not start with a command
+			input: "*2\r\n$3\r\nfoo\r\n$3\r\nbar\r\n",
+			expected: &pb.Cache{
+				Protocol: "redis",
+				Reply:    "array",
+			},
+		},
+	} {
+		keys := row.keys
+		if keys == "" {
+			keys = KeyModePlain
+		}
+		cache, err := parseRedis([]byte(row.input), keys)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, cache); diff != "" {
+			t.Errorf("[%d] unexpected cache record: %s", i, diff)
+		}
+	}
+}
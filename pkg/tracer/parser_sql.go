@@ -0,0 +1,259 @@
+package tracer
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+// sqlIdleTimeout is the time after which the
+// session of an idle connection is dropped
+const sqlIdleTimeout = time.Hour
+
+// sqlStatements are the keywords which start a statement
+var sqlStatements = map[string]bool{
+	"SELECT": true, "INSERT": true, "UPDATE": true, "DELETE": true, "WITH": true,
+	"REPLACE": true, "MERGE": true, "UPSERT": true, "VALUES": true, "TABLE": true,
+	"BEGIN": true, "START": true, "COMMIT": true, "ROLLBACK": true, "END": true,
+	"SAVEPOINT": true, "RELEASE": true, "CREATE": true, "ALTER": true, "DROP": true,
+	"TRUNCATE": true, "RENAME": true, "GRANT": true, "REVOKE": true, "SET": true,
+	"SHOW": true, "USE": true, "DESCRIBE": true, "DESC": true, "EXPLAIN": true,
+	"ANALYZE": true, "VACUUM": true, "COPY": true, "CALL": true, "DO": true,
+	"PREPARE": true, "EXECUTE": true, "DEALLOCATE": true, "LOCK": true,
+	"UNLOCK": true, "LISTEN": true, "NOTIFY": true, "UNLISTEN": true,
+	"DISCARD": true, "RESET": true, "DECLARE": true, "FETCH": true, "CLOSE": true,
+	"MOVE": true, "CHECKPOINT": true, "REINDEX": true, "CLUSTER": true,
+	"COMMENT": true, "LOAD": true, "OPTIMIZE": true, "KILL": true, "FLUSH": true,
+	"HANDLER": true, "XA": true,
+}
+
+// sqlValueList matches a list of placeholders, e.g. IN (?, ?, ?)
+var sqlValueList = regexp.MustCompile(`\(\?(?:\s*,\s*\?)+\)`)
+
+// statementType returns the first keyword of a query
+// or an empty string if it is not a known statement
+func statementType(query string) string {
+	query = skipSQLNoise(query)
+	end := strings.IndexFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if end < 0 {
+		end = len(query)
+	}
+	keyword := strings.ToUpper(query[:end])
+	if !sqlStatements[keyword] {
+		return ""
+	}
+	return keyword
+}
+
+// skipSQLNoise skips leading whitespace, comments and parentheses
+func skipSQLNoise(query string) string {
+	for {
+		query = strings.TrimLeftFunc(query, func(r rune) bool {
+			return unicode.IsSpace(r) || r == '('
+		})
+		switch {
+		case strings.HasPrefix(query, "--"), strings.HasPrefix(query, "#"):
+			i := strings.IndexByte(query, '\n')
+			if i < 0 {
+				return ""
+			}
+			query = query[i+1:]
+		case strings.HasPrefix(query, "/*"):
+			i := strings.Index(query[2:], "*/")
+			if i < 0 {
+				return ""
+			}
+			query = query[i+4:]
+		default:
+			return query
+		}
+	}
+}
+
+// fingerprint strips the literals and comments of a query and collapses
+// whitespace. Double quotes enclose string literals in mysql and
+// identifiers in postgres. Truncated queries are fingerprinted as far
+// as they are contained in the sample.
+func fingerprint(query string, doubleQuotedLiterals bool) string {
+	var b strings.Builder
+	space := false
+	var last byte
+	write := func(s string) {
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteString(s)
+		last = s[len(s)-1]
+	}
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+			i++
+		case strings.HasPrefix(query[i:], "--") || (c == '#' && doubleQuotedLiterals):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			space = true
+			i += end
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				end = len(query) - i
+			} else {
+				end += 4
+			}
+			space = true
+			i += end
+		case c == '\'' || (c == '"' && doubleQuotedLiterals):
+			i = skipSQLString(query, i)
+			write("?")
+		case c >= '0' && c <= '9' && (space || !isIdentByte(last)):
+			for i < len(query) && (isIdentByte(query[i]) || query[i] == '.') {
+				i++
+			}
+			write("?")
+		default:
+			write(query[i : i+1])
+			i++
+		}
+	}
+	return sqlValueList.ReplaceAllString(b.String(), "(?)")
+}
+
+// skipSQLString returns the index after the string literal starting at i
+func skipSQLString(query string, i int) int {
+	quote := query[i]
+	for i++; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// isQueryText reports whether s is valid UTF-8
+// without control characters except whitespace
+func isQueryText(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// newSQLQuery returns the record of a query
+// or nil if the query is not plausible
+func newSQLQuery(protocol, query string, doubleQuotedLiterals bool) *pb.SQL {
+	statement := statementType(query)
+	if statement == "" || !isQueryText(query) {
+		return nil
+	}
+	return &pb.SQL{
+		Protocol:    protocol,
+		Statement:   statement,
+		Fingerprint: fingerprint(query, doubleQuotedLiterals),
+	}
+}
+
+// sqlSession is the database and user of a connection
+type sqlSession struct {
+	database string
+	user     string
+	lastSeen time.Time
+}
+
+// sqlTracker remembers the database and user of connections.
+// They are only sent in the startup message, later messages
+// of the connection are annotated with them.
+type sqlTracker struct {
+	mu         sync.Mutex
+	timeout    time.Duration
+	lastExpire time.Time
+	// sessions are keyed by the client to server direction
+	sessions map[flowKey]*sqlSession
+}
+
+func newSQLTracker(timeout time.Duration) *sqlTracker {
+	return &sqlTracker{
+		timeout:  timeout,
+		sessions: make(map[flowKey]*sqlSession),
+	}
+}
+
+// track annotates the record with the session of the connection.
+// The record may be nil, e.g. for segments without a SQL message.
+func (t *sqlTracker) track(key flowKey, now time.Time, record *pb.SQL, closed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now.Sub(t.lastExpire) > t.timeout {
+		t.expire(now)
+		t.lastExpire = now
+	}
+	if closed {
+		delete(t.sessions, key)
+		delete(t.sessions, key.reverse())
+		return
+	}
+	if record == nil {
+		return
+	}
+	if record.User != "" {
+		// startup message
+		t.sessions[key] = &sqlSession{
+			database: record.Database,
+			user:     record.User,
+			lastSeen: now,
+		}
+		return
+	}
+	session, ok := t.sessions[key]
+	if !ok {
+		// server to client
+		session, ok = t.sessions[key.reverse()]
+	}
+	if !ok {
+		return
+	}
+	session.lastSeen = now
+	if record.Statement == "USE" && record.Database != "" {
+		session.database = record.Database
+	}
+	record.Database = session.database
+	record.User = session.user
+}
+
+// expire drops idle sessions
+func (t *sqlTracker) expire(now time.Time) {
+	for key, session := range t.sessions {
+		if now.Sub(session.lastSeen) > t.timeout {
+			delete(t.sessions, key)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package tracer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+func TestFingerprint(t *testing.T) {
+	for i, row := range []struct {
+		query     string
+		mysql     bool
+		statement string
+		expected  string
+	}{
+		{
+			query:     "SELECT * FROM users WHERE id = 42",
+			statement: "SELECT",
+			expected:  "SELECT * FROM users WHERE id = ?",
+		},
+		{
+			query:     "  select name\n\tfrom users where email = 'a@b.c' and age > 18.5",
+			statement: "SELECT",
+			expected:  "select name from users where email = ? and age > ?",
+		},
+		{
+			query:     "/* app:web */ UPDATE t1 SET v = 'it''s' WHERE id IN (1, 2, 3) -- retry",
+			statement: "UPDATE",
+			expected:  "UPDATE t1 SET v = ? WHERE id IN (?)",
+		},
+		{
+			// identifiers in postgres, placeholders are kept
+			query:     `INSERT INTO "Users" (name) VALUES ($1)`,
+			statement: "INSERT",
+			expected:  `INSERT INTO "Users" (name) VALUES ($1)`,
+		},
+		{
+			query:     `INSERT INTO users (name, note) VALUES ("bob", 'x\'y')`,
+			mysql:     true,
+			statement: "INSERT",
+			expected:  "INSERT INTO users (name, note) VALUES (?)",
+		},
+		{
+			// truncated sample
+			query:     "(SELECT 1) UNION (SELECT 'unterminated",
+			statement: "SELECT",
+			expected:  "(SELECT ?) UNION (SELECT ?",
+		},
+		{
+			query:    "hello world",
+			expected: "hello world",
+		},
+	} {
+		if statement := statementType(row.query); statement != row.statement {
+			t.Errorf("[%d] unexpected statement. expected %q, got %q", i, row.statement, statement)
+		}
+		if fp := fingerprint(row.query, row.mysql); fp != row.expected {
+			t.Errorf("[%d] unexpected fingerprint. expected %q, got %q", i, row.expected, fp)
+		}
+	}
+}
+
+func TestSQLTracker(t *testing.T) {
+	tracker := newSQLTracker(time.Minute)
+	now := time.Now()
+	client := flowKey{srcIP: "10.0.0.1", dstIP: "10.0.0.2", srcPort: 40000, dstPort: 5432}
+
+	query := &pb.SQL{Protocol: "postgres", Statement: "SELECT"}
+	tracker.track(client, now, query, false)
+	if query.Database != "" || query.User != "" {
+		t.Errorf("unexpected session without startup message: %v", query)
+	}
+
+	tracker.track(client, now, &pb.SQL{Protocol: "postgres", Database: "shop", User: "app"}, false)
+	query = &pb.SQL{Protocol: "postgres", Statement: "SELECT"}
+	tracker.track(client, now, query, false)
+	errResp := &pb.SQL{Protocol: "postgres", ErrorCode: "42P01"}
+	tracker.track(client.reverse(), now, errResp, false)
+	expected := []*pb.SQL{
+		{Protocol: "postgres", Statement: "SELECT", Database: "shop", User: "app"},
+		{Protocol: "postgres", ErrorCode: "42P01", Database: "shop", User: "app"},
+	}
+	if diff := cmp.Diff(expected, []*pb.SQL{query, errResp}); diff != "" {
+		t.Errorf("unexpected records: %s", diff)
+	}
+
+	tracker.track(client, now, nil, true)
+	query = &pb.SQL{Protocol: "postgres", Statement: "SELECT"}
+	tracker.track(client, now, query, false)
+	if query.Database != "" {
+		t.Errorf("unexpected session after close: %v", query)
+	}
+
+	tracker.track(client, now, &pb.SQL{Protocol: "postgres", Database: "shop", User: "app"}, false)
+	query = &pb.SQL{Protocol: "postgres", Statement: "SELECT"}
+	tracker.track(client, now.Add(2*time.Minute), query, false)
+	if query.Database != "" {
+		t.Errorf("unexpected session after expiry: %v", query)
+	}
+}
@@ -0,0 +1,197 @@
+package tracer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	tlsRecordHandshake   = 0x16
+	tlsClientHello       = 1
+	tlsServerHello       = 2
+	tlsRecordHeaderLen   = 5
+	tlsHandshakeLen      = 4
+	tlsRandomLen         = 32
+	tlsExtServerName     = 0
+	tlsExtALPN           = 16
+	tlsExtSupportedVers  = 43
+	tlsServerNameHost    = 0
+	tlsMaxRecordLen      = 1<<14 + 2048
+	tlsVersionMin        = 0x0300
+	tlsVersionMax        = 0x0304
+	tlsHelloRetryRequest = "\xcf\x21\xad\x74\xe5\x9a\x61\x11\xbe\x1d\x8c\x02\x1e\x65\xb8\x91\xc2\xa2\x11\x16\x7a\xbb\x8c\x5e\x07\x9e\x09\xe2\xc8\xa8\x33\x9c"
+)
+
+// ErrNoTLS indicates that the payload is not a ClientHello or ServerHello
+var ErrNoTLS = fmt.Errorf("not a tls hello")
+
+var tlsVersions = map[uint16]string{
+	0x0300: "SSL 3.0",
+	0x0301: "TLS 1.0",
+	0x0302: "TLS 1.1",
+	0x0303: "TLS 1.2",
+	0x0304: "TLS 1.3",
+}
+
+// tlsCipherSuites are the names of common cipher suites,
+// others are recorded as hex value
+var tlsCipherSuites = map[uint16]string{
+	0x0005: "TLS_RSA_WITH_RC4_128_SHA",
+	0x000a: "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+	0x002f: "TLS_RSA_WITH_AES_128_CBC_SHA",
+	0x0035: "TLS_RSA_WITH_AES_256_CBC_SHA",
+	0x003c: "TLS_RSA_WITH_AES_128_CBC_SHA256",
+	0x009c: "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	0x009d: "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	0x1301: "TLS_AES_128_GCM_SHA256",
+	0x1302: "TLS_AES_256_GCM_SHA384",
+	0x1303: "TLS_CHACHA20_POLY1305_SHA256",
+	0xc007: "TLS_ECDHE_ECDSA_WITH_RC4_128_SHA",
+	0xc009: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	0xc00a: "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	0xc011: "TLS_ECDHE_RSA_WITH_RC4_128_SHA",
+	0xc012: "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA",
+	0xc013: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	0xc014: "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	0xc023: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	0xc027: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	0xc02b: "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	0xc02c: "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	0xc02f: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	0xc030: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	0xcca8: "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	0xcca9: "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+}
+
+// parseTLS decodes the ClientHello or ServerHello in the first record
+// of the payload. Extensions which are not contained in the sample
+// are missing from the record.
+func parseTLS(payload []byte) (*pb.TLS, error) {
+	if len(payload) < tlsRecordHeaderLen+tlsHandshakeLen ||
+		payload[0] != tlsRecordHandshake || payload[1] != 0x03 {
+		return nil, ErrNoTLS
+	}
+	recordLen := int(binary.BigEndian.Uint16(payload[3:5]))
+	if recordLen < tlsHandshakeLen || recordLen > tlsMaxRecordLen {
+		return nil, ErrNoTLS
+	}
+	msg := payload[tlsRecordHeaderLen:]
+	if len(msg) > recordLen {
+		msg = msg[:recordLen]
+	}
+	typ := msg[0]
+	r := &byteReader{data: msg[tlsHandshakeLen:]}
+	version := r.uint16()
+	if version < tlsVersionMin || version > tlsVersionMax {
+		return nil, ErrNoTLS
+	}
+	random := r.next(tlsRandomLen)
+	r.vector(1) // session id
+	out := &pb.TLS{
+		Version: tlsVersions[version],
+	}
+	switch typ {
+	case tlsClientHello:
+		out.Handshake = pb.TLSHandshakeClientHello
+		r.vector(2) // cipher suites
+		r.vector(1) // compression methods
+	case tlsServerHello:
+		if string(random) == tlsHelloRetryRequest {
+			return nil, ErrNoTLS
+		}
+		out.Handshake = pb.TLSHandshakeServerHello
+		out.CipherSuite = cipherSuiteName(r.uint16())
+		r.next(1) // compression method
+	default:
+		return nil, ErrNoTLS
+	}
+	if random == nil {
+		return nil, ErrNoTLS
+	}
+	if r.err != nil || len(r.data) < 2 {
+		// truncated before the extensions
+		return out, nil
+	}
+	// the extensions may be truncated
+	extensions := r.data[2:]
+	if n := int(binary.BigEndian.Uint16(r.data)); n < len(extensions) {
+		extensions = extensions[:n]
+	}
+	ext := &byteReader{data: extensions}
+	for ext.err == nil && len(ext.data) >= 4 {
+		extType := ext.uint16()
+		data := ext.vector(2)
+		if data == nil {
+			break
+		}
+		switch extType {
+		case tlsExtServerName:
+			out.ServerName = parseServerName(data)
+		case tlsExtALPN:
+			out.Alpn = parseALPN(data)
+		case tlsExtSupportedVers:
+			if v := parseSupportedVersions(data, typ == tlsClientHello); v != "" {
+				out.Version = v
+			}
+		}
+	}
+	return out, nil
+}
+
+// parseServerName returns the host name of a server_name extension
+func parseServerName(data []byte) string {
+	r := &byteReader{data: data}
+	list := &byteReader{data: r.vector(2)}
+	for list.err == nil && len(list.data) > 0 {
+		typ := list.next(1)
+		name := list.vector(2)
+		if typ != nil && typ[0] == tlsServerNameHost && name != nil && isPrintable(string(name)) {
+			return string(name)
+		}
+	}
+	return ""
+}
+
+// parseALPN returns the protocols of an ALPN extension
+func parseALPN(data []byte) []string {
+	r := &byteReader{data: data}
+	list := &byteReader{data: r.vector(2)}
+	var out []string
+	for list.err == nil && len(list.data) > 0 {
+		proto := list.vector(1)
+		if proto == nil {
+			break
+		}
+		if isPrintable(string(proto)) {
+			out = append(out, string(proto))
+		}
+	}
+	return out
+}
+
+// parseSupportedVersions returns the highest version
+// offered by the client or the version selected by the server
+func parseSupportedVersions(data []byte, client bool) string {
+	r := &byteReader{data: data}
+	if !client {
+		return tlsVersions[r.uint16()]
+	}
+	var highest uint16
+	list := &byteReader{data: r.vector(1)}
+	for list.err == nil && len(list.data) >= 2 {
+		// unknown versions include GREASE values
+		if v := list.uint16(); tlsVersions[v] != "" && v > highest {
+			highest = v
+		}
+	}
+	return tlsVersions[highest]
+}
+
+func cipherSuiteName(id uint16) string {
+	if name, ok := tlsCipherSuites[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04X", id)
+}
@@ -0,0 +1,132 @@
+package tracer
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	pb "github.com/moolen/juno/proto"
+)
+
+// tlsMessage builds length prefixed tls structures
+type tlsMessage []byte
+
+func (m tlsMessage) u8(v byte) tlsMessage                { return append(m, v) }
+func (m tlsMessage) u16(v int) tlsMessage                { return append(m, byte(v>>8), byte(v)) }
+func (m tlsMessage) bytes(b []byte) tlsMessage           { return append(m, b...) }
+func (m tlsMessage) vec8(b []byte) tlsMessage            { return append(m.u8(byte(len(b))), b...) }
+func (m tlsMessage) vec16(b []byte) tlsMessage           { return append(m.u16(len(b)), b...) }
+func (m tlsMessage) ext(typ int, data []byte) tlsMessage { return m.u16(typ).vec16(data) }
+
+// record wraps the handshake message into a record
+func (m tlsMessage) record(typ byte) []byte {
+	n := len(m)
+	return tlsMessage{tlsRecordHandshake, 0x03, 0x01}.
+		u16(n + 4).u8(typ).u8(byte(n >> 16)).u16(n).bytes(m)
+}
+
+func serverHello(version, cipher int, extensions []byte) []byte {
+	return tlsMessage{}.u16(version).bytes(make([]byte, tlsRandomLen)).
+		vec8(nil).u16(cipher).u8(0).vec16(extensions).record(tlsServerHello)
+}
+
+// captureClientHello returns the ClientHello written by crypto/tls
+func captureClientHello(t *testing.T, config *tls.Config) []byte {
+	client, server := net.Pipe()
+	defer server.Close()
+	go func() {
+		tls.Client(client, config).Handshake()
+		client.Close()
+	}()
+	buf := make([]byte, 4096)
+	n, err := server.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf[:n]
+}
+
+func TestParseTLS(t *testing.T) {
+	clientHello := captureClientHello(t, &tls.Config{
+		ServerName: "api.stripe.com",
+		NextProtos: []string{"h2", "http/1.1"},
+	})
+	for i, row := range []struct {
+		input    []byte
+		expected *pb.TLS
+		err      bool
+	}{
+		{
+			input: []byte(""),
+			err:   true,
+		},
+		{
+			input: []byte("GET /foo HTTP/1.1\r\n\r\n"),
+			err:   true,
+		},
+		{
+			// application data
+			input: []byte{0x17, 0x03, 0x03, 0x00, 0x20, 0x01, 0x02, 0x03, 0x04, 0x05},
+			err:   true,
+		},
+		{
+			input: clientHello,
+			expected: &pb.TLS{
+				Handshake:  "client_hello",
+				ServerName: "api.stripe.com",
+				Version:    "TLS 1.3",
+				Alpn:       []string{"h2", "http/1.1"},
+			},
+		},
+		{
+			// truncated before the extensions
+			input: clientHello[:60],
+			expected: &pb.TLS{
+				Handshake: "client_hello",
+				Version:   "TLS 1.2",
+			},
+		},
+		{
+			// truncated random
+			input: clientHello[:30],
+			err:   true,
+		},
+		{
+			input: serverHello(0x0303, 0x1301, tlsMessage{}.
+				ext(tlsExtSupportedVers, tlsMessage{}.u16(0x0304)).
+				ext(tlsExtALPN, tlsMessage{}.vec16(tlsMessage{}.vec8([]byte("h2"))))),
+			expected: &pb.TLS{
+				Handshake:   "server_hello",
+				Version:     "TLS 1.3",
+				Alpn:        []string{"h2"},
+				CipherSuite: "TLS_AES_128_GCM_SHA256",
+			},
+		},
+		{
+			input: serverHello(0x0303, 0xc02f, nil),
+			expected: &pb.TLS{
+				Handshake:   "server_hello",
+				Version:     "TLS 1.2",
+				CipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+			},
+		},
+		{
+			input: serverHello(0x0303, 0x1234, nil),
+			expected: &pb.TLS{
+				Handshake:   "server_hello",
+				Version:     "TLS 1.2",
+				CipherSuite: "0x1234",
+			},
+		},
+	} {
+		record, err := parseTLS(row.input)
+		if (err != nil && !row.err) || (err == nil && row.err) {
+			t.Errorf("[%d] unexpected err result. expected %v, got %v", i, row.err, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, record); diff != "" {
+			t.Errorf("[%d] unexpected tls record: %s", i, diff)
+		}
+	}
+}
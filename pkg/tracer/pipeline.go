@@ -0,0 +1,151 @@
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+
+	pb "github.com/moolen/juno/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// sampleQueueSize is the number of samples
+	// which are buffered per worker
+	sampleQueueSize = 1024
+	// traceQueueSize is the number of decoded traces
+	// which are buffered until they are read
+	traceQueueSize = 4096
+	ethHeaderLen   = 14
+)
+
+// pipeline reads the samples and decodes them with a pool of workers.
+// The samples are sharded by flow: both directions of a connection are
+// decoded in order by the same worker, which keeps the state of the
+// connection. The readers drain all available samples of a wakeup
+// without further syscalls, so reads are batched by the kernel.
+// The traces of different flows are not ordered by time, the ring
+// queries tolerate a delay of up to ring.MaxTraceDelay.
+type pipeline struct {
+	reader eventReader
+	queues []chan []byte
+	procs  []*sampleProcessor
+	out    chan *pb.Trace
+}
+
+func newPipeline(reader eventReader, workers int, parsers ParserOptions) *pipeline {
+	if workers < 1 {
+		workers = 1
+	}
+	if parsers.Reassembly.MaxBufferedPages == 0 {
+		parsers.Reassembly.MaxBufferedPages = defaultMaxBufferedPages
+	}
+	if parsers.Reassembly.MaxStreams == 0 {
+		parsers.Reassembly.MaxStreams = defaultMaxStreams
+	}
+	// the limits apply to all workers
+	parsers.Reassembly.MaxBufferedPages = (parsers.Reassembly.MaxBufferedPages + workers - 1) / workers
+	parsers.Reassembly.MaxStreams = (parsers.Reassembly.MaxStreams + workers - 1) / workers
+	p := &pipeline{
+		reader: reader,
+		out:    make(chan *pb.Trace, traceQueueSize),
+	}
+	for i := 0; i < workers; i++ {
+		p.queues = append(p.queues, make(chan []byte, sampleQueueSize))
+		p.procs = append(p.procs, newSampleProcessor(parsers))
+	}
+	return p
+}
+
+// run starts the reader and the workers.
+// They return if ctx is cancelled or the reader is closed.
+func (p *pipeline) run(ctx context.Context) {
+	for i := range p.queues {
+		go p.decode(ctx, p.procs[i], p.queues[i])
+	}
+	go p.read(ctx)
+}
+
+func (p *pipeline) read(ctx context.Context) {
+	defer func() {
+		for _, queue := range p.queues {
+			close(queue)
+		}
+	}()
+	for {
+		sample, err := p.reader.Read()
+		if ctx.Err() != nil {
+			return
+		}
+		if err == errReaderClosed {
+			return
+		} else if err != nil {
+			log.Error(err)
+			continue
+		}
+		queue := p.queues[flowHash(sample)%uint32(len(p.queues))]
+		select {
+		case queue <- sample:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *pipeline) decode(ctx context.Context, proc *sampleProcessor, queue <-chan []byte) {
+	for sample := range queue {
+		trace, err := proc.processSample(sample)
+		if err == ErrSkipPkg || err == ErrInvalidDataLen {
+			continue
+		} else if err != nil {
+			log.Error(err)
+			continue
+		}
+		select {
+		case p.out <- trace:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// flowHash returns the same hash for both directions of a flow.
+// Samples which are not IP return 0.
+func flowHash(data []byte) uint32 {
+	if len(data) < metadataLen+ethHeaderLen {
+		return 0
+	}
+	pkt := data[metadataLen:]
+	etherType := binary.BigEndian.Uint16(pkt[12:14])
+	pkt = pkt[ethHeaderLen:]
+	var src, dst, l4 []byte
+	var proto byte
+	switch {
+	case etherType == 0x0800 && len(pkt) >= 20:
+		ihl := int(pkt[0]&0x0f) * 4
+		if ihl < 20 || len(pkt) < ihl {
+			return 0
+		}
+		proto, src, dst, l4 = pkt[9], pkt[12:16], pkt[16:20], pkt[ihl:]
+	case etherType == 0x86dd && len(pkt) >= 40:
+		proto, src, dst, l4 = pkt[6], pkt[8:24], pkt[24:40], pkt[40:]
+	default:
+		return 0
+	}
+	var srcPort, dstPort []byte
+	if (proto == 6 || proto == 17) && len(l4) >= 4 {
+		srcPort, dstPort = l4[0:2], l4[2:4]
+	}
+	// the sum does not depend on the direction
+	return fnv32a(src, srcPort) + fnv32a(dst, dstPort)
+}
+
+func fnv32a(parts ...[]byte) uint32 {
+	h := uint32(2166136261)
+	for _, part := range parts {
+		for _, c := range part {
+			h ^= uint32(c)
+			h *= 16777619
+		}
+	}
+	return h
+}
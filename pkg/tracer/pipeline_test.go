@@ -0,0 +1,174 @@
+// +build linux
+
+package tracer
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// fakeReader returns the samples in a loop until n samples are read.
+// It paces them to rate samples per second, 0 is unlimited.
+type fakeReader struct {
+	samples [][]byte
+	n       int
+	rate    int
+	read    int
+	start   time.Time
+	closed  chan struct{}
+}
+
+func newFakeReader(samples [][]byte, n, rate int) *fakeReader {
+	return &fakeReader{
+		samples: samples,
+		n:       n,
+		rate:    rate,
+		closed:  make(chan struct{}),
+	}
+}
+
+func (r *fakeReader) Read() ([]byte, error) {
+	if r.read >= r.n {
+		<-r.closed
+		return nil, errReaderClosed
+	}
+	if r.read == 0 {
+		r.start = time.Now()
+	}
+	if r.rate > 0 {
+		due := r.start.Add(time.Duration(r.read) * time.Second / time.Duration(r.rate))
+		if wait := time.Until(due); wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+	sample := r.samples[r.read%len(r.samples)]
+	r.read++
+	return sample, nil
+}
+
+func (r *fakeReader) Close() error {
+	close(r.closed)
+	return nil
+}
+
+// httpSamples returns the request and response segments of the given
+// number of exchanges on each flow. The flows are interleaved and the
+// index of a sample is stored in its timestamp.
+func httpSamples(t testing.TB, flows, exchanges int) [][]byte {
+	var samples [][]byte
+	request := "GET /foo HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	response := "HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n"
+	for e := 0; e < exchanges; e++ {
+		for i := 0; i < flows; i++ {
+			client := net.IPv4(10, 0, byte(i>>8), byte(i))
+			server := net.IPv4(10, 1, 0, 1)
+			port := layers.TCPPort(30000 + i)
+			samples = append(samples,
+				httpSample(t, client, server, port, 80, uint32(e*len(request)), request, len(samples)),
+				httpSample(t, server, client, 80, port, uint32(e*len(response)), response, len(samples)+1),
+			)
+		}
+	}
+	return samples
+}
+
+func httpSample(t testing.TB, src, dst net.IP, srcPort, dstPort layers.TCPPort, seq uint32, payload string, index int) []byte {
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    src,
+		DstIP:    dst,
+	}
+	tcp := &layers.TCP{
+		SrcPort: srcPort,
+		DstPort: dstPort,
+		Seq:     seq,
+		ACK:     true,
+		PSH:     true,
+	}
+	tcp.SetNetworkLayerForChecksum(ip)
+	sample := newSample(t,
+		&layers.Ethernet{EthernetType: layers.EthernetTypeIPv4, SrcMAC: testMAC, DstMAC: testMAC},
+		ip, tcp, gopacket.Payload(payload),
+	)
+	binary.LittleEndian.PutUint64(sample[6:14], uint64(index))
+	return sample
+}
+
+func TestFlowHash(t *testing.T) {
+	samples := httpSamples(t, 2, 1)
+	if flowHash(samples[0]) != flowHash(samples[1]) {
+		t.Errorf("expected the same hash for both directions")
+	}
+	if flowHash(samples[0]) == flowHash(samples[2]) {
+		t.Errorf("expected different hashes for different flows")
+	}
+	if hash := flowHash(make([]byte, metadataLen+ethHeaderLen)); hash != 0 {
+		t.Errorf("expected 0 for non ip samples, got %d", hash)
+	}
+	if hash := flowHash(samples[0][:metadataLen+ethHeaderLen+10]); hash != 0 {
+		t.Errorf("expected 0 for truncated samples, got %d", hash)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	samples := httpSamples(t, 50, 10)
+	reader := newFakeReader(samples, len(samples), 0)
+	p := newPipeline(reader, 4, ParserOptions{Headers: NewHeaderFilter(nil, nil)})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p.run(ctx)
+	last := map[string]time.Time{}
+	for i := 0; i < len(samples); i++ {
+		select {
+		case trace := <-p.out:
+			key := trace.IP.Source + trace.IP.Destination
+			ts, _ := ptypes.Timestamp(trace.Time)
+			if !ts.After(last[key]) {
+				t.Errorf("trace %s of %s is out of order", ts, key)
+			}
+			last[key] = ts
+			if trace.L7.GetHttp() == nil {
+				t.Errorf("expected http record: %v", trace.L7)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout after %d traces", i)
+		}
+	}
+	reader.Close()
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	samples := httpSamples(b, 256, 1)
+	for _, workers := range []int{1, 4} {
+		for _, rate := range []int{10000, 100000, 1000000, 0} {
+			name := fmt.Sprintf("workers=%d/rate=%d", workers, rate)
+			if rate == 0 {
+				name = fmt.Sprintf("workers=%d/rate=unlimited", workers)
+			}
+			b.Run(name, func(b *testing.B) {
+				reader := newFakeReader(samples, b.N, rate)
+				p := newPipeline(reader, workers, ParserOptions{Headers: NewHeaderFilter(nil, nil)})
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				b.ResetTimer()
+				start := time.Now()
+				p.run(ctx)
+				for i := 0; i < b.N; i++ {
+					<-p.out
+				}
+				b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "events/s")
+				reader.Close()
+			})
+		}
+	}
+}
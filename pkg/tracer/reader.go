@@ -0,0 +1,103 @@
+package tracer
+
+import (
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/perf"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// defaults of the buffer options
+	defaultPerfBufferSize     = 64 * 1024
+	defaultRingBufferSize     = 4 * 1024 * 1024
+	ringbufProgramSuffix      = "_ringbuf"
+	perfMapName               = "EVENTS_MAP"
+	ringbufMapName            = "EVENTS_RINGBUF"
+	ringbufLostSamplesMapName = "RINGBUF_LOST"
+)
+
+// BufferOptions configures the buffers which pass
+// the samples of the eBPF programs to the agent
+type BufferOptions struct {
+	// RingBuffer uses a BPF ring buffer shared by all CPUs if the kernel
+	// supports it (linux 5.8). Otherwise a perf buffer per CPU is used.
+	RingBuffer bool
+	// RingBufferSize is the size of the ring buffer in bytes. It is
+	// rounded up to a power of two multiple of the page size.
+	RingBufferSize int
+	// PerfBufferSize is the size of the perf buffer of each CPU in bytes
+	PerfBufferSize int
+}
+
+// eventReader reads the samples of the eBPF programs
+type eventReader interface {
+	// Read blocks until the next sample is available
+	Read() ([]byte, error)
+	Close() error
+}
+
+// perfEventReader counts the lost samples of a perf reader
+type perfEventReader struct {
+	*perf.Reader
+}
+
+func (r perfEventReader) Read() ([]byte, error) {
+	for {
+		rec, err := r.Reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec.LostSamples > 0 {
+			lostSamples.Add(float64(rec.LostSamples))
+			continue
+		}
+		return rec.RawSample, nil
+	}
+}
+
+// selectOutput removes the programs and maps of the output which is not used.
+// The ring buffer is used if it is requested and supported.
+func selectOutput(spec *ebpf.CollectionSpec, opts BufferOptions) {
+	ringbuf := opts.RingBuffer
+	if ringbuf && spec.Maps[ringbufMapName] == nil {
+		log.Warnf("the eBPF object has no ring buffer, falling back to perf buffers")
+		ringbuf = false
+	} else if ringbuf {
+		if err := ringbufSupported(); err != nil {
+			log.Warnf("ring buffers are not supported, falling back to perf buffers: %s", err)
+			ringbuf = false
+		}
+	}
+	for hook := range hooks {
+		if ringbuf {
+			spec.Programs[hook] = spec.Programs[hook+ringbufProgramSuffix]
+		}
+		delete(spec.Programs, hook+ringbufProgramSuffix)
+	}
+	if ringbuf {
+		spec.Maps[ringbufMapName].MaxEntries = ringbufSize(opts.RingBufferSize)
+		delete(spec.Maps, perfMapName)
+		return
+	}
+	delete(spec.Maps, ringbufMapName)
+	delete(spec.Maps, ringbufLostSamplesMapName)
+}
+
+// newEventReader returns a reader for the output of the collection
+func newEventReader(coll *ebpf.Collection, opts BufferOptions) (eventReader, error) {
+	if m := coll.Maps[ringbufMapName]; m != nil {
+		log.Infof("reading samples from a %d bytes ring buffer", m.ABI().MaxEntries)
+		return newRingbufReader(m, coll.Maps[ringbufLostSamplesMapName])
+	}
+	perfMap := coll.Maps[perfMapName]
+	if perfMap == nil {
+		return nil, errors.New("missing events map")
+	}
+	log.Infof("reading samples from %d bytes perf buffers", opts.PerfBufferSize)
+	pr, err := perf.NewReader(perfMap, opts.PerfBufferSize)
+	if err != nil {
+		return nil, err
+	}
+	return perfEventReader{pr}, nil
+}
@@ -0,0 +1,291 @@
+package tracer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/tcpassembly"
+	pb "github.com/moolen/juno/proto"
+)
+
+const (
+	// maxStreamBuffer limits the bytes of an incomplete
+	// message which are buffered per direction
+	maxStreamBuffer = 16 * 1024
+	// defaults of the reassembly options
+	defaultMaxBufferedPages              = 4096
+	defaultMaxBufferedPagesPerConnection = 4
+	defaultMaxStreams                    = 8192
+	defaultReassemblyIdleTimeout         = 2 * time.Minute
+)
+
+// ReassemblyOptions configures the TCP stream reassembly
+type ReassemblyOptions struct {
+	// Enabled passes ordered byte streams instead
+	// of single segments to the L7 parsers
+	Enabled bool
+	// MaxBufferedPages limits the memory used for out of order segments
+	// of all connections. A page holds up to 1900 bytes.
+	MaxBufferedPages int
+	// MaxBufferedPagesPerConnection limits the out of order segments
+	// of a connection. Once it is reached the missing data is skipped.
+	MaxBufferedPagesPerConnection int
+	// MaxStreams limits the number of reassembled streams, one per
+	// direction of a connection. A stream buffers up to 16KiB of an
+	// incomplete message. The segments of further streams are not parsed.
+	MaxStreams int
+	// IdleTimeout is the time after which idle connections are evicted
+	IdleTimeout time.Duration
+}
+
+// streamReassembler reorders the segments of TCP connections and
+// parses the reassembled byte streams. It only sees the sampled bytes:
+// the payload of samples which are truncated by the eBPF program is
+// padded to its length, so the following segments do not wait for the
+// missing bytes. The stream parses the sampled part of the message.
+// The connections are kept apart by interface: packets which pass the
+// hooks of two interfaces are reassembled once per interface.
+type streamReassembler struct {
+	mu        sync.Mutex
+	opts      ReassemblyOptions
+	assembler *tcpassembly.Assembler
+	ifaces    map[string]*ifaceFlows
+	lastIface int64
+	streams   int
+	lastFlush time.Time
+	parse     func(buf []byte, dns bool) *pb.Layer7
+	// record completed by the segment which is being assembled
+	record *pb.Layer7
+	// padded payload of the segment which is being assembled
+	// and the number of padding bytes at its end
+	padded  []byte
+	padding int
+}
+
+func newStreamReassembler(opts ReassemblyOptions, parse func(buf []byte, dns bool) *pb.Layer7) *streamReassembler {
+	if opts.MaxBufferedPages == 0 {
+		opts.MaxBufferedPages = defaultMaxBufferedPages
+	}
+	if opts.MaxBufferedPagesPerConnection == 0 {
+		opts.MaxBufferedPagesPerConnection = defaultMaxBufferedPagesPerConnection
+	}
+	if opts.MaxStreams == 0 {
+		opts.MaxStreams = defaultMaxStreams
+	}
+	if opts.IdleTimeout == 0 {
+		opts.IdleTimeout = defaultReassemblyIdleTimeout
+	}
+	r := &streamReassembler{
+		opts:   opts,
+		ifaces: make(map[string]*ifaceFlows),
+		parse:  parse,
+	}
+	r.assembler = tcpassembly.NewAssembler(tcpassembly.NewStreamPool(r))
+	r.assembler.MaxBufferedPagesTotal = opts.MaxBufferedPages
+	r.assembler.MaxBufferedPagesPerConnection = opts.MaxBufferedPagesPerConnection
+	return r
+}
+
+// ifaceFlows identifies the flows of an interface
+type ifaceFlows struct {
+	id       int64
+	lastSeen time.Time
+}
+
+// flow returns the network flow of the packet captured by the interface.
+// The id of the interface is part of the endpoint type, so the assembler
+// keeps the connections of the interfaces apart.
+func (r *streamReassembler) flow(ifname string, netFlow gopacket.Flow, now time.Time) gopacket.Flow {
+	i, ok := r.ifaces[ifname]
+	if !ok {
+		r.lastIface++
+		i = &ifaceFlows{id: r.lastIface}
+		r.ifaces[ifname] = i
+	}
+	i.lastSeen = now
+	src, dst := netFlow.Endpoints()
+	typ := gopacket.EndpointType(i.id<<16 | int64(netFlow.EndpointType()))
+	return gopacket.NewFlow(typ, src.Raw(), dst.Raw())
+}
+
+// flush evicts the idle connections and
+// forgets the ids of idle interfaces
+func (r *streamReassembler) flush(now time.Time) {
+	_, closed := r.assembler.FlushOlderThan(now.Add(-r.opts.IdleTimeout))
+	reassemblyEvictions.Add(float64(closed))
+	for ifname, i := range r.ifaces {
+		if now.Sub(i.lastSeen) > r.opts.IdleTimeout {
+			delete(r.ifaces, ifname)
+		}
+	}
+}
+
+// New implements tcpassembly.StreamFactory
+func (r *streamReassembler) New(netFlow, tcpFlow gopacket.Flow) tcpassembly.Stream {
+	if r.streams >= r.opts.MaxStreams {
+		reassemblyUntracked.Inc()
+		return discardStream{}
+	}
+	r.streams++
+	reassemblyStreams.Inc()
+	src, dst := tcpFlow.Endpoints()
+	return &l7Stream{
+		r:   r,
+		dns: src == layers.NewTCPPortEndpoint(dnsPort) || dst == layers.NewTCPPortEndpoint(dnsPort),
+	}
+}
+
+// assemble adds a segment which was captured by the interface to its
+// stream. It returns the record of the message which is completed by
+// the segment. missing is the number of payload bytes which were not sampled.
+func (r *streamReassembler) assemble(ifname string, netFlow gopacket.Flow, tcp *layers.TCP, missing int, now time.Time) *pb.Layer7 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Sub(r.lastFlush) > r.opts.IdleTimeout/2 {
+		r.flush(now)
+		r.lastFlush = now
+	}
+	r.record = nil
+	if missing > 0 {
+		reassemblyTruncations.Inc()
+		r.padded = make([]byte, len(tcp.Payload)+missing)
+		copy(r.padded, tcp.Payload)
+		r.padding = missing
+		tcp.Payload = r.padded
+	}
+	r.assembler.AssembleWithTimestamp(r.flow(ifname, netFlow, now), tcp, now)
+	r.padded, r.padding = nil, 0
+	return r.record
+}
+
+// missingPayload returns the number of bytes of the
+// packet which are not contained in the sample
+func missingPayload(network gopacket.NetworkLayer) int {
+	var missing int
+	switch ip := network.(type) {
+	case *layers.IPv4:
+		missing = int(ip.Length) - len(ip.Contents) - len(ip.Payload)
+	case *layers.IPv6:
+		// the payload length does not include the fixed header
+		missing = int(ip.Length) - len(ip.Payload)
+	}
+	if missing < 0 {
+		return 0
+	}
+	return missing
+}
+
+// unpad removes the padding from b if it is the end of the segment
+// which is being assembled. Segments which are buffered because they
+// arrived out of order are passed on with their padding.
+func (r *streamReassembler) unpad(b []byte) ([]byte, bool) {
+	if r.padding == 0 || len(b) == 0 || &b[len(b)-1] != &r.padded[len(r.padded)-1] {
+		return b, false
+	}
+	if len(b) < r.padding {
+		return nil, true
+	}
+	return b[:len(b)-r.padding], true
+}
+
+// l7Stream is one direction of a connection
+type l7Stream struct {
+	r   *streamReassembler
+	dns bool
+	// start of a message which is not complete yet
+	buf []byte
+}
+
+// Reassembled implements tcpassembly.Stream
+func (s *l7Stream) Reassembled(rs []tcpassembly.Reassembly) {
+	for _, r := range rs {
+		if r.Skip != 0 {
+			// the message can not be completed.
+			// Skip is negative if the start of the stream is unknown.
+			reassemblyGaps.Inc()
+			s.buf = s.buf[:0]
+		}
+		data, truncated := s.r.unpad(r.Bytes)
+		s.buf = append(s.buf, data...)
+		if truncated {
+			// the rest of the message was not sampled:
+			// parse what we have and start over
+			if len(s.buf) > 0 {
+				if record := s.r.parse(s.buf, s.dns); record != nil {
+					s.r.record = record
+				}
+			}
+			s.buf = s.buf[:0]
+			continue
+		}
+		if len(r.Bytes) == 0 {
+			continue
+		}
+		if incompleteMessage(s.buf, s.dns) {
+			if len(s.buf) > maxStreamBuffer {
+				reassemblyOverflows.Inc()
+				s.buf = s.buf[:0]
+			}
+			continue
+		}
+		if record := s.r.parse(s.buf, s.dns); record != nil {
+			s.r.record = record
+		}
+		s.buf = s.buf[:0]
+	}
+}
+
+// ReassemblyComplete implements tcpassembly.Stream
+func (s *l7Stream) ReassemblyComplete() {
+	s.r.streams--
+	reassemblyStreams.Dec()
+	s.buf = nil
+}
+
+// discardStream drops the data of the streams beyond MaxStreams
+type discardStream struct{}
+
+// Reassembled implements tcpassembly.Stream
+func (discardStream) Reassembled([]tcpassembly.Reassembly) {}
+
+// ReassemblyComplete implements tcpassembly.Stream
+func (discardStream) ReassemblyComplete() {}
+
+// httpStarts are the prefixes of HTTP/1.x messages
+var httpStarts = [][]byte{
+	[]byte("GET "), []byte("POST "), []byte("PUT "), []byte("DELETE "),
+	[]byte("HEAD "), []byte("OPTIONS "), []byte("PATCH "), []byte("CONNECT "),
+	[]byte("TRACE "), []byte("HTTP/1."),
+}
+
+// incompleteMessage returns true if buf is the start of a message
+// which needs more data to be parsed.
+func incompleteMessage(buf []byte, dns bool) bool {
+	if dns {
+		return len(buf) < 2 || len(buf)-2 < int(binary.BigEndian.Uint16(buf))
+	}
+	for _, prefix := range httpStarts {
+		if bytes.HasPrefix(buf, prefix) {
+			return !bytes.Contains(buf, []byte("\r\n\r\n"))
+		}
+	}
+	switch {
+	case len(buf) >= tlsRecordHeaderLen && buf[0] == tlsRecordHandshake && buf[1] == 0x03:
+		return len(buf)-tlsRecordHeaderLen < int(binary.BigEndian.Uint16(buf[3:5]))
+	case len(buf) >= 8 && buf[0] == 0 && binary.BigEndian.Uint32(buf[4:8]) == postgresProtocolVersion:
+		return uint32(len(buf)) < binary.BigEndian.Uint32(buf[0:4])
+	case len(buf) >= 5 && (buf[0] == 'Q' || buf[0] == 'P'):
+		length := binary.BigEndian.Uint32(buf[1:5])
+		return length < postgresMaxMessageLen && uint32(len(buf)-1) < length
+	case len(buf) >= 5 && buf[3] == 0 && (buf[4] == mysqlComQuery || buf[4] == mysqlComStmtPrepare):
+		length := int(buf[0]) | int(buf[1])<<8 | int(buf[2])<<16
+		return len(buf)-4 < length
+	case len(buf) > 0 && bytes.IndexByte([]byte("+-:$*"), buf[0]) >= 0:
+		return !bytes.Contains(buf, crlf)
+	}
+	return false
+}
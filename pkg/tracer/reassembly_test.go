@@ -0,0 +1,270 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	pb "github.com/moolen/juno/proto"
+)
+
+// newSegment returns a sample of a tcp segment from 10.0.0.1:40000 to 10.0.0.2:port
+func newSegment(t *testing.T, port layers.TCPPort, seq uint32, syn bool, payload string) []byte {
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP("10.0.0.1"),
+		DstIP:    net.ParseIP("10.0.0.2"),
+	}
+	tcp := &layers.TCP{
+		SrcPort: 40000,
+		DstPort: port,
+		Seq:     seq,
+		SYN:     syn,
+		ACK:     !syn,
+	}
+	tcp.SetNetworkLayerForChecksum(ip)
+	return newSample(t,
+		&layers.Ethernet{EthernetType: layers.EthernetTypeIPv4, SrcMAC: testMAC, DstMAC: testMAC},
+		ip, tcp, gopacket.Payload(payload),
+	)
+}
+
+func TestReassembly(t *testing.T) {
+	request := "GET /foo HTTP/1.1\r\nHost: example.com\r\nX-Request-Id: 1\r\n\r\n"
+	query := newDNSMessage(t, &layers.DNS{
+		ID: 1,
+		Questions: []layers.DNSQuestion{{
+			Name:  []byte("example.com"),
+			Type:  layers.DNSTypeA,
+			Class: layers.DNSClassIN,
+		}},
+	})
+	dns := string([]byte{0, byte(len(query))}) + string(query)
+	post := "POST /bar HTTP/1.1\r\nHost: example.com\r\nContent-Length: 100\r\n\r\n" + strings.Repeat("x", 100)
+	type segment struct {
+		seq     uint32
+		syn     bool
+		payload string
+		// truncate is the number of bytes which are not sampled
+		truncate int
+	}
+	for i, row := range []struct {
+		port     layers.TCPPort
+		segments []segment
+		expected []*pb.Layer7
+	}{
+		{
+			// header split across segments
+			port: 8080,
+			segments: []segment{
+				{seq: 100, syn: true},
+				{seq: 101, payload: request[:25]},
+				{seq: 126, payload: request[25:]},
+			},
+			expected: []*pb.Layer7{nil, nil, {
+				Record: &pb.Layer7_Http{
+					Http: &pb.HTTP{
+						Protocol: "HTTP/1.1",
+						Method:   "GET",
+						Url:      "/foo",
+						Host:     "example.com",
+						Headers: []*pb.HTTPHeader{
+							{Key: "Host", Value: "example.com"},
+							{Key: "X-Request-Id", Value: "1"},
+						},
+					},
+				},
+			}},
+		},
+		{
+			// out of order
+			port: 8080,
+			segments: []segment{
+				{seq: 100, syn: true},
+				{seq: 126, payload: request[25:]},
+				{seq: 101, payload: request[:25]},
+			},
+			expected: []*pb.Layer7{nil, nil, {
+				Record: &pb.Layer7_Http{
+					Http: &pb.HTTP{
+						Protocol: "HTTP/1.1",
+						Method:   "GET",
+						Url:      "/foo",
+						Host:     "example.com",
+						Headers: []*pb.HTTPHeader{
+							{Key: "Host", Value: "example.com"},
+							{Key: "X-Request-Id", Value: "1"},
+						},
+					},
+				},
+			}},
+		},
+		{
+			// the body is truncated by the sample size,
+			// the next request does not wait for it
+			port: 8080,
+			segments: []segment{
+				{seq: 100, syn: true},
+				{seq: 101, payload: post, truncate: 90},
+				{seq: 101 + uint32(len(post)), payload: request},
+			},
+			expected: []*pb.Layer7{nil, {
+				Record: &pb.Layer7_Http{
+					Http: &pb.HTTP{
+						Protocol: "HTTP/1.1",
+						Method:   "POST",
+						Url:      "/bar",
+						Host:     "example.com",
+						Headers: []*pb.HTTPHeader{
+							{Key: "Content-Length", Value: "100"},
+							{Key: "Host", Value: "example.com"},
+						},
+					},
+				},
+			}, {
+				Record: &pb.Layer7_Http{
+					Http: &pb.HTTP{
+						Protocol: "HTTP/1.1",
+						Method:   "GET",
+						Url:      "/foo",
+						Host:     "example.com",
+						Headers: []*pb.HTTPHeader{
+							{Key: "Host", Value: "example.com"},
+							{Key: "X-Request-Id", Value: "1"},
+						},
+					},
+				},
+			}},
+		},
+		{
+			// dns over tcp, length prefix in its own segment
+			port: 53,
+			segments: []segment{
+				{seq: 100, syn: true},
+				{seq: 101, payload: dns[:2]},
+				{seq: 103, payload: dns[2:]},
+			},
+			expected: []*pb.Layer7{nil, nil, {
+				Record: &pb.Layer7_Dns{
+					Dns: &pb.DNS{
+						Query:  "example.com.",
+						Qtypes: []string{"A"},
+					},
+				},
+			}},
+		},
+	} {
+		p := newSampleProcessor(ParserOptions{
+			Headers:    NewHeaderFilter(nil, nil),
+			Reassembly: ReassemblyOptions{Enabled: true},
+		})
+		var records []*pb.Layer7
+		for _, s := range row.segments {
+			sample := newSegment(t, row.port, s.seq, s.syn, s.payload)
+			trace, err := p.processSample(sample[:len(sample)-s.truncate])
+			if err != nil {
+				t.Fatal(err)
+			}
+			records = append(records, trace.L7)
+		}
+		if diff := cmp.Diff(row.expected, records); diff != "" {
+			t.Errorf("[%d] unexpected records: %s", i, diff)
+		}
+	}
+}
+
+func TestReassemblyMaxStreams(t *testing.T) {
+	request := "GET /foo HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	p := newSampleProcessor(ParserOptions{
+		Headers:    NewHeaderFilter(nil, nil),
+		Reassembly: ReassemblyOptions{Enabled: true, MaxStreams: 1},
+	})
+	for i, row := range []struct {
+		port   layers.TCPPort
+		parsed bool
+	}{
+		{port: 80, parsed: true},
+		// the stream limit is reached
+		{port: 81, parsed: false},
+	} {
+		var trace *pb.Trace
+		for _, sample := range [][]byte{
+			newSegment(t, row.port, 100, true, ""),
+			newSegment(t, row.port, 101, false, request),
+		} {
+			var err error
+			trace, err = p.processSample(sample)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if parsed := trace.L7.GetHttp() != nil; parsed != row.parsed {
+			t.Errorf("[%d] unexpected parse result. expected %t, found %t", i, row.parsed, parsed)
+		}
+	}
+}
+
+func TestReassemblyTwoInterfaces(t *testing.T) {
+	request := "GET /foo HTTP/1.1\r\nHost: example.com\r\n\r\n"
+	p := newSampleProcessor(ParserOptions{
+		Headers:    NewHeaderFilter(nil, nil),
+		Reassembly: ReassemblyOptions{Enabled: true},
+	})
+	for i, row := range []struct {
+		seq     uint32
+		syn     bool
+		payload string
+		parsed  bool
+	}{
+		{seq: 100, syn: true},
+		{seq: 101, payload: request, parsed: true},
+	} {
+		sample := newSegment(t, 80, row.seq, row.syn, row.payload)
+		// the segment passes the hooks of two interfaces
+		for _, ifindex := range []uint32{1, 2} {
+			binary.LittleEndian.PutUint32(sample[0:4], ifindex)
+			trace, err := p.processSample(sample)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed := trace.L7.GetHttp() != nil; parsed != row.parsed {
+				t.Errorf("[%d] unexpected parse result on interface %d. expected %t, found %t", i, ifindex, row.parsed, parsed)
+			}
+		}
+	}
+}
+
+func TestIncompleteMessage(t *testing.T) {
+	for i, row := range []struct {
+		input      string
+		dns        bool
+		incomplete bool
+	}{
+		{input: "GET /foo HTTP/1.1\r\nHost: a\r\n", incomplete: true},
+		{input: "GET /foo HTTP/1.1\r\nHost: a\r\n\r\n"},
+		{input: "HTTP/1.1 200 OK\r\n", incomplete: true},
+		{input: "\x16\x03\x01\x02\x00\x01", incomplete: true},
+		{input: "\x16\x03\x01\x00\x01\x01"},
+		{input: "Q\x00\x00\x00\x10SELECT", incomplete: true},
+		{input: "Q\x00\x00\x00\x0bSELECT\x00"},
+		{input: "\x10\x00\x00\x00\x03SELECT", incomplete: true},
+		{input: "*2\r\n$3\r\nGET"},
+		{input: "*2", incomplete: true},
+		{input: "\x00\x10abc", dns: true, incomplete: true},
+		{input: "\x00\x03abc", dns: true},
+		{input: "\x00", dns: true, incomplete: true},
+		{input: "random binary data"},
+	} {
+		if incompleteMessage([]byte(row.input), row.dns) != row.incomplete {
+			t.Errorf("[%d] unexpected result for %q. expected %v", i, row.input, row.incomplete)
+		}
+	}
+}
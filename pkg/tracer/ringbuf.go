@@ -0,0 +1,232 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/cilium/ebpf"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ringbufMapType is BPF_MAP_TYPE_RINGBUF which
+	// is not known to the vendored ebpf library
+	ringbufMapType = ebpf.MapType(27)
+	// every record starts with its length and page offset
+	ringbufHeaderLen  = 8
+	ringbufBusyBit    = 1 << 31
+	ringbufDiscardBit = 1 << 30
+	// lostSamplesInterval is the interval in
+	// which the lost samples are read from the kernel
+	lostSamplesInterval = 5 * time.Second
+)
+
+// errReaderClosed is returned by Read after Close
+var errReaderClosed = fmt.Errorf("reader closed")
+
+// ringbuf is the memory of a BPF ring buffer. The data pages are mapped
+// twice in a row, so records which wrap around are contiguous.
+type ringbuf struct {
+	consumerPos *uint64
+	producerPos *uint64
+	data        []byte
+	mask        uint64
+}
+
+// next returns a copy of the next record. It returns false if the
+// ring is empty or the next record is not committed yet.
+// Discarded records are skipped.
+func (r *ringbuf) next() ([]byte, bool) {
+	for {
+		cons := atomic.LoadUint64(r.consumerPos)
+		prod := atomic.LoadUint64(r.producerPos)
+		if cons >= prod {
+			return nil, false
+		}
+		start := cons & r.mask
+		header := atomic.LoadUint32((*uint32)(unsafe.Pointer(&r.data[start])))
+		if header&ringbufBusyBit != 0 {
+			return nil, false
+		}
+		n := uint64(header &^ (ringbufBusyBit | ringbufDiscardBit))
+		var sample []byte
+		if header&ringbufDiscardBit == 0 {
+			sample = make([]byte, n)
+			copy(sample, r.data[start+ringbufHeaderLen:])
+		}
+		// records are 8 byte aligned
+		atomic.StoreUint64(r.consumerPos, cons+(ringbufHeaderLen+n+7)&^7)
+		if sample != nil {
+			return sample, true
+		}
+	}
+}
+
+// ringbufReader reads the records of a BPF ring buffer.
+// The vendored ebpf library only provides a reader for perf event arrays.
+type ringbufReader struct {
+	mu        sync.Mutex
+	closeOnce sync.Once
+	ring      *ringbuf
+	consumer  []byte
+	producer  []byte
+	epollFd   int
+	closeFd   int
+	events    []unix.EpollEvent
+	// lost counts the records which the eBPF programs could not reserve
+	lost      *ebpf.Map
+	lastLost  uint64
+	lastCheck time.Time
+}
+
+func newRingbufReader(m, lost *ebpf.Map) (r *ringbufReader, err error) {
+	fd := m.FD()
+	size := int(m.ABI().MaxEntries)
+	pageSize := os.Getpagesize()
+	r = &ringbufReader{
+		lost:    lost,
+		epollFd: -1,
+		closeFd: -1,
+		events:  make([]unix.EpollEvent, 2),
+	}
+	defer func() {
+		if err != nil {
+			r.release()
+		}
+	}()
+	r.consumer, err = unix.Mmap(fd, 0, pageSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED)
+	if err != nil {
+		return nil, fmt.Errorf("can't mmap consumer page: %s", err)
+	}
+	r.producer, err = unix.Mmap(fd, int64(pageSize), pageSize+2*size, unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		return nil, fmt.Errorf("can't mmap ring buffer: %s", err)
+	}
+	r.ring = &ringbuf{
+		consumerPos: (*uint64)(unsafe.Pointer(&r.consumer[0])),
+		producerPos: (*uint64)(unsafe.Pointer(&r.producer[0])),
+		data:        r.producer[pageSize:],
+		mask:        uint64(size - 1),
+	}
+	r.epollFd, err = unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("can't create epoll fd: %s", err)
+	}
+	r.closeFd, err = unix.Eventfd(0, unix.O_CLOEXEC|unix.O_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("can't create event fd: %s", err)
+	}
+	for _, fd := range []int{fd, r.closeFd} {
+		err = unix.EpollCtl(r.epollFd, unix.EPOLL_CTL_ADD, fd, &unix.EpollEvent{
+			Events: unix.EPOLLIN,
+			Fd:     int32(fd),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("can't add fd to epoll: %s", err)
+		}
+	}
+	return r, nil
+}
+
+// Read blocks until the next record is available.
+// Calling Close interrupts the function.
+func (r *ringbufReader) Read() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.epollFd == -1 {
+		return nil, errReaderClosed
+	}
+	for {
+		if time.Since(r.lastCheck) > lostSamplesInterval {
+			r.countLost()
+		}
+		if sample, ok := r.ring.next(); ok {
+			return sample, nil
+		}
+		n, err := unix.EpollWait(r.epollFd, r.events, int(lostSamplesInterval/time.Millisecond))
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range r.events[:n] {
+			if int(event.Fd) == r.closeFd {
+				return nil, errReaderClosed
+			}
+		}
+	}
+}
+
+// countLost adds the records which were lost since the last check
+func (r *ringbufReader) countLost() {
+	r.lastCheck = time.Now()
+	if r.lost == nil {
+		return
+	}
+	var lost uint64
+	if err := r.lost.Lookup(uint32(0), &lost); err != nil {
+		return
+	}
+	lostSamples.Add(float64(lost - r.lastLost))
+	r.lastLost = lost
+}
+
+// Close interrupts Read and frees the resources of the reader
+func (r *ringbufReader) Close() error {
+	var err error
+	r.closeOnce.Do(func() {
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint64(value, 1)
+		if _, err = unix.Write(r.closeFd, value); err != nil {
+			return
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.release()
+	})
+	return err
+}
+
+func (r *ringbufReader) release() {
+	for _, fd := range []int{r.epollFd, r.closeFd} {
+		if fd != -1 {
+			unix.Close(fd)
+		}
+	}
+	r.epollFd, r.closeFd = -1, -1
+	for _, mem := range [][]byte{r.consumer, r.producer} {
+		if mem != nil {
+			unix.Munmap(mem)
+		}
+	}
+	r.consumer, r.producer, r.ring = nil, nil, nil
+}
+
+// ringbufSupported reports whether the kernel supports ring buffers
+func ringbufSupported() error {
+	m, err := ebpf.NewMap(&ebpf.MapSpec{
+		Type:       ringbufMapType,
+		MaxEntries: uint32(os.Getpagesize()),
+	})
+	if err != nil {
+		return err
+	}
+	return m.Close()
+}
+
+// ringbufSize rounds size up to a power of two multiple of the page size
+func ringbufSize(size int) uint32 {
+	n := os.Getpagesize()
+	for n < size {
+		n *= 2
+	}
+	return uint32(n)
+}
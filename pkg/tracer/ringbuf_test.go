@@ -0,0 +1,97 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeRingbuf emulates the double mapping of the data pages
+type fakeRingbuf struct {
+	*ringbuf
+	size uint64
+}
+
+func newFakeRingbuf(size uint64) *fakeRingbuf {
+	return &fakeRingbuf{
+		ringbuf: &ringbuf{
+			consumerPos: new(uint64),
+			producerPos: new(uint64),
+			data:        make([]byte, 2*size),
+			mask:        size - 1,
+		},
+		size: size,
+	}
+}
+
+// write commits a record with the given header flags
+func (f *fakeRingbuf) write(sample []byte, flags uint32) {
+	pos := *f.producerPos
+	record := make([]byte, ringbufHeaderLen+len(sample))
+	binary.LittleEndian.PutUint32(record, uint32(len(sample))|flags)
+	copy(record[ringbufHeaderLen:], sample)
+	for i, b := range record {
+		offset := (pos + uint64(i)) & f.mask
+		f.data[offset] = b
+		f.data[offset+f.size] = b
+	}
+	*f.producerPos = pos + (uint64(len(record))+7)&^7
+}
+
+func TestRingbufNext(t *testing.T) {
+	r := newFakeRingbuf(64)
+	if _, ok := r.next(); ok {
+		t.Errorf("expected empty ring")
+	}
+	r.write([]byte("first"), 0)
+	r.write([]byte("discarded"), ringbufDiscardBit)
+	r.write([]byte("second"), 0)
+	var samples []string
+	for {
+		sample, ok := r.next()
+		if !ok {
+			break
+		}
+		samples = append(samples, string(sample))
+	}
+	if diff := cmp.Diff([]string{"first", "second"}, samples); diff != "" {
+		t.Errorf("unexpected samples: %s", diff)
+	}
+	if *r.consumerPos != *r.producerPos {
+		t.Errorf("consumer %d did not catch up with producer %d", *r.consumerPos, *r.producerPos)
+	}
+
+	// the record wraps around the end of the ring
+	r.write([]byte("wrapped around"), 0)
+	sample, ok := r.next()
+	if !ok || string(sample) != "wrapped around" {
+		t.Errorf("unexpected sample: %q", sample)
+	}
+
+	// the record is not committed yet
+	r.write([]byte("busy"), ringbufBusyBit)
+	if _, ok := r.next(); ok {
+		t.Errorf("expected busy record to be skipped")
+	}
+}
+
+func TestRingbufSize(t *testing.T) {
+	page := os.Getpagesize()
+	for i, row := range []struct {
+		size     int
+		expected int
+	}{
+		{size: 0, expected: page},
+		{size: page, expected: page},
+		{size: page + 1, expected: 2 * page},
+		{size: 5 * page, expected: 8 * page},
+	} {
+		if size := ringbufSize(row.size); int(size) != row.expected {
+			t.Errorf("[%d] unexpected size: %d", i, size)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package tracer
+
+import (
+	"fmt"
+	"time"
+)
+
+// samplingMapName is the map of the per flow sampling state
+const samplingMapName = "SAMPLING"
+
+// SamplingOptions limits the samples of the eBPF programs. The first
+// packet with payload of each flow is always sampled for the L7 parsers,
+// TCP SYN, FIN and RST segments are always sampled as well.
+// A trace carries the number of packets it represents in SampleRate.
+type SamplingOptions struct {
+	// Rate samples 1 in Rate packets. 0 and 1 sample all packets.
+	Rate int
+	// FlowRate limits the samples per second of each flow.
+	// 0 disables the limit.
+	FlowRate int
+	// FlowBurst is the number of samples a flow may send
+	// at once after it was idle. It defaults to FlowRate.
+	FlowBurst int
+}
+
+func (o SamplingOptions) enabled() bool {
+	return o.Rate > 1 || o.FlowRate > 0
+}
+
+// configure sets the sampling of the config
+func (o SamplingOptions) configure(config *tracerConfig) error {
+	if o.Rate < 0 || o.FlowRate < 0 || o.FlowBurst < 0 {
+		return fmt.Errorf("invalid sampling options: %+v", o)
+	}
+	if o.Rate > 1 {
+		config.SampleRate = uint32(o.Rate)
+	}
+	if o.FlowRate == 0 {
+		return nil
+	}
+	if o.FlowBurst == 0 {
+		o.FlowBurst = o.FlowRate
+	}
+	token := uint64(time.Second) / uint64(o.FlowRate)
+	if token == 0 {
+		token = 1
+	}
+	config.FlowTokenNs = token
+	config.FlowBurstNs = token * uint64(o.FlowBurst)
+	return nil
+}
@@ -0,0 +1,60 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSamplingConfigure(t *testing.T) {
+	for i, row := range []struct {
+		opts     SamplingOptions
+		expected tracerConfig
+		err      bool
+	}{
+		{
+			opts:     SamplingOptions{},
+			expected: tracerConfig{},
+		},
+		{
+			opts:     SamplingOptions{Rate: 1},
+			expected: tracerConfig{},
+		},
+		{
+			opts:     SamplingOptions{Rate: 100},
+			expected: tracerConfig{SampleRate: 100},
+		},
+		{
+			opts:     SamplingOptions{FlowRate: 10},
+			expected: tracerConfig{FlowTokenNs: 100000000, FlowBurstNs: 1000000000},
+		},
+		{
+			opts:     SamplingOptions{Rate: 10, FlowRate: 1000, FlowBurst: 5},
+			expected: tracerConfig{SampleRate: 10, FlowTokenNs: 1000000, FlowBurstNs: 5000000},
+		},
+		{
+			opts: SamplingOptions{Rate: -1},
+			err:  true,
+		},
+	} {
+		var config tracerConfig
+		err := row.opts.configure(&config)
+		if (err != nil) != row.err {
+			t.Errorf("[%d] unexpected error: %v", i, err)
+			continue
+		}
+		if diff := cmp.Diff(row.expected, config); diff != "" {
+			t.Errorf("[%d] unexpected config: %s", i, diff)
+		}
+	}
+}
+
+func TestTracerConfigSize(t *testing.T) {
+	// sizeof(struct tracer_config)
+	if size := binary.Size(tracerConfig{}); size != 40 {
+		t.Errorf("unexpected size of the config: %d", size)
+	}
+}
@@ -1,12 +1,9 @@
 package tracer
 
 import (
-	"bufio"
 	"fmt"
-	"strings"
-	"time"
 
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
 	pb "github.com/moolen/juno/proto"
@@ -16,17 +13,60 @@ import (
 // ErrSkipPkg indicates that this packet should be skipped
 var ErrSkipPkg = fmt.Errorf("skipped packet")
 
-func processSample(data []byte) (*pb.Trace, error) {
-	trace := &pb.Trace{
-		Time: &timestamp.Timestamp{
-			Seconds: int64(time.Now().Second()),
-		},
+// ParserOptions configures the L7 parsers
+type ParserOptions struct {
+	// Headers decides which HTTP headers are recorded
+	Headers *HeaderFilter
+	// CacheKeys decides how redis and memcached keys are recorded
+	CacheKeys KeyMode
+	// Reassembly configures the optional TCP stream reassembly
+	Reassembly ReassemblyOptions
+}
+
+// sampleProcessor decodes perf samples into traces.
+// It keeps the state of the L7 protocols.
+type sampleProcessor struct {
+	headers *HeaderFilter
+	keys    KeyMode
+	http    *httpTracker
+	http2   *http2Tracker
+	sql     *sqlTracker
+	conns   *connTracker
+	// streams is nil if reassembly is disabled
+	streams *streamReassembler
+}
+
+// newSampleProcessor returns a processor which
+// uses the given parser options
+func newSampleProcessor(opts ParserOptions) *sampleProcessor {
+	p := &sampleProcessor{
+		headers: opts.Headers,
+		keys:    opts.CacheKeys,
+		http:    newHTTPTracker(httpRequestTimeout),
+		http2:   newHTTP2Tracker(http2IdleTimeout),
+		sql:     newSQLTracker(sqlIdleTimeout),
+		conns:   newConnTracker(connIdleTimeout),
+	}
+	if opts.Reassembly.Enabled {
+		p.streams = newStreamReassembler(opts.Reassembly, p.parseStream)
+	}
+	return p
+}
+
+func (p *sampleProcessor) processSample(data []byte) (*pb.Trace, error) {
+	md, skb, err := perfEventToGo(data)
+	if err != nil {
+		return nil, err
 	}
-	var err error
-	_, skb, err := perfEventToGo(data)
+	ts, err := ptypes.TimestampProto(md.Time)
 	if err != nil {
 		return nil, err
 	}
+	trace := &pb.Trace{
+		Time:             ts,
+		TrafficDirection: md.Direction,
+		SampleRate:       md.SampleRate,
+	}
 	packet := gopacket.NewPacket(skb, layers.LayerTypeEthernet, gopacket.Default)
 
 	if ipLayer := packet.Layer(layers.LayerTypeIPv4); ipLayer != nil {
@@ -40,6 +80,17 @@ func processSample(data []byte) (*pb.Trace, error) {
 			Destination: ip.DstIP.String(),
 			IpVersion:   pb.IPVersion_IPv4,
 		}
+	} else if ipLayer := packet.Layer(layers.LayerTypeIPv6); ipLayer != nil {
+		ip, _ := ipLayer.(*layers.IPv6)
+		if len(ip.SrcIP) == 0 || len(ip.DstIP) == 0 {
+			log.Debugf("skipping empty ip address")
+			return nil, ErrSkipPkg
+		}
+		trace.IP = &pb.IP{
+			Source:      ip.SrcIP.String(),
+			Destination: ip.DstIP.String(),
+			IpVersion:   pb.IPVersion_IPv6,
+		}
 	} else {
 		log.Debugf("skipping non-ip")
 		return nil, ErrSkipPkg
@@ -66,23 +117,30 @@ func processSample(data []byte) (*pb.Trace, error) {
 			},
 		}
 		appLayer := packet.ApplicationLayer()
-		if appLayer != nil {
-			rd := strings.NewReader(string(appLayer.Payload()) + "\r\n")
-			// TODO: implement header parser
-			method, uri, proto, code, err := parseHTTPMetadata(bufio.NewReader(rd))
-			if err == nil {
-				trace.L7 = &pb.Layer7{
-					Record: &pb.Layer7_Http{
-						Http: &pb.HTTP{
-							Code:     code,
-							Method:   method,
-							Url:      uri,
-							Protocol: proto,
-						},
-					},
-				}
+		key := flowKey{
+			ifname:  md.Ifname,
+			srcIP:   trace.IP.Source,
+			dstIP:   trace.IP.Destination,
+			srcPort: uint32(tcp.SrcPort),
+			dstPort: uint32(tcp.DstPort),
+		}
+		closed := tcp.FIN || tcp.RST
+		trace.IsReply = p.conns.trackTCP(key, md.Time, tcp.SYN, tcp.ACK, tcp.RST)
+		if http2 := p.http2.track(key, md.Time, tcp.LayerPayload(), closed); http2 != nil {
+			trace.L7 = &pb.Layer7{
+				Record: &pb.Layer7_Http2{
+					Http2: http2,
+				},
 			}
+		} else if p.streams != nil {
+			network := packet.NetworkLayer()
+			trace.L7 = p.streams.assemble(md.Ifname, network.NetworkFlow(), tcp, missingPayload(network), md.Time)
+		} else if tcp.SrcPort == dnsPort || tcp.DstPort == dnsPort {
+			trace.L7 = parseDNSPayload(tcp.LayerPayload())
+		} else if appLayer != nil {
+			trace.L7 = p.parsePayload(appLayer.Payload())
 		}
+		p.sql.track(key, md.Time, trace.L7.GetSql(), closed)
 	} else if udpLayer := packet.Layer(layers.LayerTypeUDP); udpLayer != nil {
 		udp, _ := udpLayer.(*layers.UDP)
 		trace.L4 = &pb.Layer4{
@@ -93,16 +151,115 @@ func processSample(data []byte) (*pb.Trace, error) {
 				},
 			},
 		}
+		trace.IsReply = p.conns.trackUDP(flowKey{
+			ifname:  md.Ifname,
+			srcIP:   trace.IP.Source,
+			dstIP:   trace.IP.Destination,
+			srcPort: uint32(udp.SrcPort),
+			dstPort: uint32(udp.DstPort),
+		}, md.Time)
 
 		if dnsLayer := packet.Layer(layers.LayerTypeDNS); dnsLayer != nil {
-			//dns, _ := dnsLayer.(*layers.DNS)
+			dns, _ := dnsLayer.(*layers.DNS)
 			trace.L7 = &pb.Layer7{
 				Record: &pb.Layer7_Dns{
-					Dns: &pb.DNS{},
+					Dns: parseDNS(dns),
 				},
 			}
 		}
 
+	} else if icmpLayer := packet.Layer(layers.LayerTypeICMPv4); icmpLayer != nil {
+		icmp, _ := icmpLayer.(*layers.ICMPv4)
+		trace.L4 = &pb.Layer4{
+			Protocol: &pb.Layer4_ICMPv4{
+				ICMPv4: parseICMPv4(icmp),
+			},
+		}
+	} else if icmpLayer := packet.Layer(layers.LayerTypeICMPv6); icmpLayer != nil {
+		icmp, _ := icmpLayer.(*layers.ICMPv6)
+		trace.L4 = &pb.Layer4{
+			Protocol: &pb.Layer4_ICMPv6{
+				ICMPv6: parseICMPv6(icmp),
+			},
+		}
 	}
+	p.http.track(md.Ifname, trace)
 	return trace, nil
 }
+
+// parseStream parses the start of a reassembled stream
+func (p *sampleProcessor) parseStream(buf []byte, dns bool) *pb.Layer7 {
+	if dns {
+		return parseDNSPayload(buf)
+	}
+	return p.parsePayload(buf)
+}
+
+// parseDNSPayload parses a dns over tcp message.
+// gopacket does not handle its length prefix.
+func parseDNSPayload(payload []byte) *pb.Layer7 {
+	dns, err := parseDNSOverTCP(payload)
+	if err != nil {
+		return nil
+	}
+	return &pb.Layer7{
+		Record: &pb.Layer7_Dns{
+			Dns: dns,
+		},
+	}
+}
+
+// parsePayload tries the parsers of the TCP based protocols.
+// It returns nil if no parser matches.
+func (p *sampleProcessor) parsePayload(payload []byte) *pb.Layer7 {
+	if tls, err := parseTLS(payload); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Tls{
+				Tls: tls,
+			},
+		}
+	}
+	if http, err := parseHTTPMetadata(newHTTPReader(payload), p.headers); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Http{
+				Http: http,
+			},
+		}
+	}
+	if kafka, err := parseKafka(payload); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Kafka{
+				Kafka: kafka,
+			},
+		}
+	}
+	if sql, err := parsePostgres(payload); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Sql{
+				Sql: sql,
+			},
+		}
+	}
+	if sql, err := parseMySQL(payload); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Sql{
+				Sql: sql,
+			},
+		}
+	}
+	if cache, err := parseRedis(payload, p.keys); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Cache{
+				Cache: cache,
+			},
+		}
+	}
+	if cache, err := parseMemcached(payload, p.keys); err == nil {
+		return &pb.Layer7{
+			Record: &pb.Layer7_Cache{
+				Cache: cache,
+			},
+		}
+	}
+	return nil
+}
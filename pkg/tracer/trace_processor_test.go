@@ -0,0 +1,109 @@
+// +build linux
+
+package tracer
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	pb "github.com/moolen/juno/proto"
+)
+
+// newSample serializes the layers and prepends the trace metadata
+func newSample(t testing.TB, l ...gopacket.SerializableLayer) []byte {
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}, l...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata := make([]byte, metadataLen)
+	binary.LittleEndian.PutUint16(metadata[15:17], uint16(len(buf.Bytes())))
+	return append(metadata, buf.Bytes()...)
+}
+
+func TestProcessSampleIPv6(t *testing.T) {
+	ip := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolTCP,
+		SrcIP:      net.ParseIP("fd00::10"),
+		DstIP:      net.ParseIP("fd00::20"),
+	}
+	tcp := &layers.TCP{
+		SrcPort: 39198,
+		DstPort: 8080,
+		SYN:     true,
+	}
+	tcp.SetNetworkLayerForChecksum(ip)
+	data := newSample(t,
+		&layers.Ethernet{EthernetType: layers.EthernetTypeIPv6, SrcMAC: testMAC, DstMAC: testMAC},
+		ip, tcp,
+	)
+	trace, err := newSampleProcessor(ParserOptions{}).processSample(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trace.IP.IpVersion != pb.IPVersion_IPv6 {
+		t.Errorf("unexpected ip version: %s", trace.IP.IpVersion)
+	}
+	if trace.IP.Source != "fd00::10" || trace.IP.Destination != "fd00::20" {
+		t.Errorf("unexpected ip: %#v", trace.IP)
+	}
+	if trace.L4.GetTCP().GetDestinationPort() != 8080 || !trace.L4.GetTCP().GetFlags().GetSYN() {
+		t.Errorf("unexpected l4: %#v", trace.L4)
+	}
+}
+
+// TestProcessSampleTwoHooks processes the samples of a connection
+// between pods of the same node: the packets pass the ingress hook
+// of the client's veth and the egress hook of the server's veth.
+func TestProcessSampleTwoHooks(t *testing.T) {
+	p := newSampleProcessor(ParserOptions{})
+	w := newHTTP2Writer(t)
+	request := func(streamID uint32, path string) []byte {
+		return w.headers(streamID, false,
+			":method", "POST",
+			":scheme", "http",
+			":path", path,
+			":authority", "juno-agent:3000",
+		)
+	}
+	preface := append(append([]byte(nil), http2Preface...), settingsFrame(t)...)
+	seq := uint32(101)
+	for i, row := range []struct {
+		payload []byte
+		path    string
+	}{
+		{payload: preface},
+		{payload: request(1, "/a"), path: "/a"},
+		// decoded with the dynamic table of the first request
+		{payload: request(3, "/b"), path: "/b"},
+	} {
+		sample := newSegment(t, 9090, seq, false, string(row.payload))
+		seq += uint32(len(row.payload))
+		for _, hook := range []struct {
+			ifindex   uint32
+			direction byte
+		}{{1, 0}, {2, 1}} {
+			binary.LittleEndian.PutUint32(sample[0:4], hook.ifindex)
+			sample[14] = hook.direction
+			trace, err := p.processSample(sample)
+			if err != nil {
+				t.Fatal(err)
+			}
+			http2 := trace.GetL7().GetHttp2()
+			if http2.GetPath() != row.path {
+				t.Errorf("[%d] unexpected path on interface %d. expected %q, found %q", i, hook.ifindex, row.path, http2.GetPath())
+			}
+			if row.path != "" && http2.GetAuthority() != "juno-agent:3000" {
+				t.Errorf("[%d] unexpected authority on interface %d: %q", i, hook.ifindex, http2.GetAuthority())
+			}
+		}
+	}
+}
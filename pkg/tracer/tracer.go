@@ -1,123 +1,309 @@
 package tracer
 
 import (
-	"os"
+	"context"
+	"regexp"
+	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cilium/ebpf"
-	"github.com/cilium/ebpf/perf"
 	"github.com/pkg/errors"
 
 	pb "github.com/moolen/juno/proto"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
 )
 
+// resubscribeDelay is the time to wait before
+// a failed link subscription is renewed
+const resubscribeDelay = 5 * time.Second
+
 // Tracer contains all the information to manage
 // a eBPF trace program
 type Tracer struct {
-	coll         *ebpf.Collection
-	perfReader   *perf.Reader
-	outChan      chan pb.Trace
-	pollInterval time.Duration
+	coll     *ebpf.Collection
+	reader   eventReader
+	pipeline *pipeline
+	// flows is nil if the aggregation is disabled
+	flows        *flowAggregator
+	flowInterval time.Duration
 	syncInterval time.Duration
 	ifacePrefix  string
-	stopChan     chan struct{}
+	ctx          context.Context
+	cancel       context.CancelFunc
+	// instance identifies the tc filters of this tracer
+	instance string
+	// datapathMu serializes the attachment of the programs
+	datapathMu sync.Mutex
+	// addedQdiscs are the links whose clsact qdisc was added
+	// by the tracer, guarded by datapathMu
+	addedQdiscs map[int]bool
+	// config is the content of the config map
+	config   tracerConfig
+	configMu sync.Mutex
 }
 
-// NewTracer prepares a eBPF program and a perf event reader
-func NewTracer(ifacePrefix string, perfPollInterval, syncInterval time.Duration) (*Tracer, error) {
+// NewTracer prepares a eBPF program and a reader for its samples.
+// The samples are decoded by the given number of workers, filters and
+// sampling select the sampled packets and parsers configures the L7 parsers.
+func NewTracer(ifacePrefix string, syncInterval time.Duration, workers int, buffers BufferOptions, aggregation AggregationOptions, filters FilterOptions, sampling SamplingOptions, parsers ParserOptions) (*Tracer, error) {
 	log.Info("loading tracer")
-	coll, err := compileAndLoad()
+	if buffers.PerfBufferSize == 0 {
+		buffers.PerfBufferSize = defaultPerfBufferSize
+	}
+	if buffers.RingBufferSize == 0 {
+		buffers.RingBufferSize = defaultRingBufferSize
+	}
+	if aggregation.Interval == 0 {
+		aggregation.Interval = defaultAggregationInterval
+	}
+	if aggregation.MaxFlows == 0 {
+		aggregation.MaxFlows = defaultMaxFlows
+	}
+	var config tracerConfig
+	if err := sampling.configure(&config); err != nil {
+		return nil, err
+	}
+	coll, err := compileAndLoad(buffers, aggregation, sampling)
 	if err != nil {
 		return nil, errors.Wrap(err, "error compiling and loading eBPF")
 	}
-	perfMap := coll.Maps["EVENTS_MAP"]
-	if perfMap == nil {
-		return nil, errors.Wrap(err, "missing events map")
+	if sampling.enabled() && coll.Maps[samplingMapName] == nil {
+		log.Warnf("the eBPF object does not support sampling")
+		config.SampleRate, config.FlowTokenNs, config.FlowBurstNs = 0, 0, 0
+	}
+	var flows *flowAggregator
+	if aggregation.Enabled {
+		if m := coll.Maps[flowsMapName]; m != nil {
+			config.Aggregate = 1
+			flows = newFlowAggregator(m, aggregation.Interval)
+		} else {
+			log.Warnf("the eBPF object does not support flow aggregation")
+		}
 	}
-	pr, err := perf.NewReader(perfMap, os.Getpagesize())
+	config, err = writeFilters(coll, config, filters)
 	if err != nil {
+		coll.Close()
+		return nil, errors.Wrap(err, "error writing eBPF filters")
+	}
+	err = writeConfig(coll, config)
+	if err != nil {
+		coll.Close()
+		return nil, errors.Wrap(err, "error writing eBPF config")
+	}
+	reader, err := newEventReader(coll, buffers)
+	if err != nil {
+		coll.Close()
 		return nil, errors.Wrap(err, "error creating event reader")
 	}
+	ctx, cancel := context.WithCancel(context.Background())
 	return &Tracer{
 		coll:         coll,
-		perfReader:   pr,
-		outChan:      make(chan pb.Trace),
-		stopChan:     make(chan struct{}),
-		pollInterval: perfPollInterval,
+		reader:       reader,
+		pipeline:     newPipeline(reader, workers, parsers),
+		flows:        flows,
+		flowInterval: aggregation.Interval,
+		config:       config,
+		ctx:          ctx,
+		cancel:       cancel,
 		syncInterval: syncInterval,
 		ifacePrefix:  ifacePrefix,
+		instance:     strconv.FormatInt(time.Now().UnixNano(), 36),
+		addedQdiscs:  make(map[int]bool),
 	}, nil
 }
 
-func (s *Tracer) pollPerfMap() {
-	log.Debugf("reading from perfReader channel")
+// SetFilters replaces the filters of the eBPF programs.
+// The programs are not reloaded, the maps are updated in place.
+func (s *Tracer) SetFilters(filters FilterOptions) error {
+	s.configMu.Lock()
+	defer s.configMu.Unlock()
+	config, err := writeFilters(s.coll, s.config, filters)
+	if err != nil {
+		return err
+	}
+	err = writeConfig(s.coll, config)
+	if err != nil {
+		return err
+	}
+	s.config = config
+	log.Infof("updated the eBPF filters")
+	return nil
+}
+
+// pollFlows sends the summaries of the aggregated flows
+func (s *Tracer) pollFlows() {
+	log.Debugf("starting flow aggregation")
 	for {
 		select {
-		case <-s.stopChan:
+		case <-s.ctx.Done():
 			return
-		default:
-			var flow *pb.Trace
-			rec, err := s.perfReader.Read()
+		case now := <-time.After(s.flowInterval):
+			traces, err := s.flows.drain(now)
 			if err != nil {
-				log.Error(err)
+				log.Errorf("error draining flows: %s", err)
 				continue
 			}
-			flow, err = processSample(rec.RawSample)
-			if err == ErrSkipPkg || err == ErrInvalidDataLen {
-				continue
-			} else if err != nil {
-				log.Error(err)
-				continue
+			for _, trace := range traces {
+				select {
+				case s.pipeline.out <- trace:
+				case <-s.ctx.Done():
+					return
+				}
 			}
-			s.outChan <- *flow
-			<-time.After(s.pollInterval)
 		}
 	}
 }
 
+// pollReplaceDatapath attaches the programs periodically.
+// It reconciles links which were missed by the link subscription.
 func (s *Tracer) pollReplaceDatapath() {
 	log.Debugf("starting datapath replacer")
 	for {
 		select {
-		case <-s.stopChan:
+		case <-s.ctx.Done():
 			return
-		default:
-			err := replaceDatapath(s.coll, s.ifacePrefix)
+		case <-time.After(s.syncInterval):
+			err := s.replaceDatapath()
 			if err != nil {
 				log.Error(err)
 			}
-			<-time.After(s.syncInterval)
 		}
 	}
 }
 
-// Read returns a channel which outputs trace events
-func (s *Tracer) Read() <-chan pb.Trace {
-	return s.outChan
+func (s *Tracer) replaceDatapath() error {
+	s.datapathMu.Lock()
+	defer s.datapathMu.Unlock()
+	select {
+	case <-s.ctx.Done():
+		return nil
+	default:
+	}
+	return replaceDatapath(s.coll, s.ifacePrefix, s.instance, s.addedQdiscs)
 }
 
-// Start starts reading from the perf event buffer,
-// processes the packets and forwards them to outChan
+// watchLinks attaches the programs to links as soon as they appear.
+// A failed subscription is renewed, the existing links are
+// listed again to catch up with the updates which were missed.
+func (s *Tracer) watchLinks() {
+	log.Debugf("starting link subscription")
+	for {
+		updates := make(chan netlink.LinkUpdate)
+		done := make(chan struct{})
+		err := netlink.LinkSubscribeWithOptions(updates, done, netlink.LinkSubscribeOptions{
+			ListExisting: true,
+			ErrorCallback: func(err error) {
+				log.Errorf("link subscription failed: %s", err)
+			},
+		})
+		if err != nil {
+			log.Errorf("error subscribing to link updates: %s", err)
+		} else {
+			s.handleLinkUpdates(updates)
+		}
+		close(done)
+		select {
+		case <-s.ctx.Done():
+			// the subscription may be blocked on sending an update
+			go func() {
+				for range updates {
+				}
+			}()
+			return
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+// handleLinkUpdates returns if the subscription
+// is closed or the tracer is stopped
+func (s *Tracer) handleLinkUpdates(updates <-chan netlink.LinkUpdate) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			s.handleLinkUpdate(update)
+		}
+	}
+}
+
+func (s *Tracer) handleLinkUpdate(update netlink.LinkUpdate) {
+	attrs := update.Link.Attrs()
+	switch update.Header.Type {
+	case syscall.RTM_DELLINK:
+		// the kernel removes the qdisc and filters of the link
+		deleteIfname(update.Link)
+		s.datapathMu.Lock()
+		delete(s.addedQdiscs, attrs.Index)
+		s.datapathMu.Unlock()
+		log.Debugf("link %s removed", attrs.Name)
+		return
+	case syscall.RTM_NEWLINK:
+		setIfname(update.Link)
+	default:
+		return
+	}
+	matched, err := regexp.MatchString(s.ifacePrefix, attrs.Name)
+	if err != nil || !matched {
+		return
+	}
+	s.datapathMu.Lock()
+	defer s.datapathMu.Unlock()
+	select {
+	case <-s.ctx.Done():
+		return
+	default:
+	}
+	err = attachLink(s.coll, update.Link, s.instance, s.addedQdiscs)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// Read returns a bounded channel which outputs trace events.
+// The workers block if it is full.
+func (s *Tracer) Read() <-chan *pb.Trace {
+	return s.pipeline.out
+}
+
+// Start starts reading from the event buffer,
+// decodes the packets and forwards them to the channel returned by Read.
 // Start should be called only once
 func (s *Tracer) Start() error {
 	log.Debug("starting tracer")
-	go s.pollPerfMap()
-	go s.pollReplaceDatapath()
-	err := replaceDatapath(s.coll, s.ifacePrefix)
+	s.pipeline.run(s.ctx)
+	err := s.replaceDatapath()
 	if err != nil {
 		return err
 	}
-	return err
+	go s.watchLinks()
+	go s.pollReplaceDatapath()
+	if s.flows != nil {
+		go s.pollFlows()
+	}
+	return nil
 }
 
-// Stop stops the internal goroutine for reading from perf event buffer
+// Stop cancels the goroutines which read and decode the samples
 // and resets the datapath eBPF programs
 func (s *Tracer) Stop() {
 	log.Debug("stopping tracer")
-	close(s.stopChan)
-	err := resetDatapath(s.coll, s.ifacePrefix)
+	s.cancel()
+	s.datapathMu.Lock()
+	defer s.datapathMu.Unlock()
+	err := resetDatapath(s.ifacePrefix, s.addedQdiscs)
+	if err != nil {
+		log.Error(err)
+	}
+	err = s.reader.Close()
 	if err != nil {
 		log.Error(err)
 	}
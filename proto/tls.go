@@ -0,0 +1,7 @@
+package tracer
+
+// values of TLS.Handshake
+const (
+	TLSHandshakeClientHello = "client_hello"
+	TLSHandshakeServerHello = "server_hello"
+)
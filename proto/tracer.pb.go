@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,6 +26,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// TrafficDirection is the direction of a packet
+// relative to the interface which captured it.
+// On the host side of a pod's veth INGRESS is
+// traffic sent by the pod.
+type TrafficDirection int32
+
+const (
+	TrafficDirection_TRAFFIC_DIRECTION_UNKNOWN TrafficDirection = 0
+	TrafficDirection_INGRESS                   TrafficDirection = 1
+	TrafficDirection_EGRESS                    TrafficDirection = 2
+)
+
+var TrafficDirection_name = map[int32]string{
+	0: "TRAFFIC_DIRECTION_UNKNOWN",
+	1: "INGRESS",
+	2: "EGRESS",
+}
+
+var TrafficDirection_value = map[string]int32{
+	"TRAFFIC_DIRECTION_UNKNOWN": 0,
+	"INGRESS":                   1,
+	"EGRESS":                    2,
+}
+
+func (x TrafficDirection) String() string {
+	return proto.EnumName(TrafficDirection_name, int32(x))
+}
+
+func (TrafficDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{0}
+}
+
 type IPVersion int32
 
 const (
@@ -50,10 +83,24 @@ func (x IPVersion) String() string {
 }
 
 func (IPVersion) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{0}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{1}
 }
 
 type GetTracesRequest struct {
+	// traces matching any of the allowlist filters are returned.
+	// An empty allowlist matches all traces.
+	Allowlist []*TraceFilter `protobuf:"bytes,1,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// traces matching any of the denylist filters are dropped
+	Denylist []*TraceFilter `protobuf:"bytes,2,rep,name=denylist,proto3" json:"denylist,omitempty"`
+	// number of past traces to return. If neither number nor since
+	// are set all traces in the buffer are returned
+	Number uint64 `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// only return traces which happened after since
+	Since *timestamp.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	// stop once a trace happened after until
+	Until *timestamp.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	// keep the stream open and send new traces as they arrive
+	Follow               bool     `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -84,6 +131,191 @@ func (m *GetTracesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetTracesRequest proto.InternalMessageInfo
 
+func (m *GetTracesRequest) GetAllowlist() []*TraceFilter {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *GetTracesRequest) GetDenylist() []*TraceFilter {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+func (m *GetTracesRequest) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *GetTracesRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *GetTracesRequest) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *GetTracesRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
+// TraceFilter matches a trace if all of its non-empty fields match.
+// A repeated field matches if any of its values matches.
+type TraceFilter struct {
+	// ip address or cidr, e.g. 10.0.0.1 or 10.0.0.0/8
+	SourceIp      []string `protobuf:"bytes,1,rep,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	DestinationIp []string `protobuf:"bytes,2,rep,name=destination_ip,json=destinationIp,proto3" json:"destination_ip,omitempty"`
+	// L4 protocol: TCP, UDP, ICMPv4 (alias ICMP), ICMPv6
+	Protocol        []string `protobuf:"bytes,3,rep,name=protocol,proto3" json:"protocol,omitempty"`
+	SourcePort      []uint32 `protobuf:"varint,4,rep,packed,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	DestinationPort []uint32 `protobuf:"varint,5,rep,packed,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	// matches if all flags which are set in the filter are set in the trace
+	TcpFlags []*TCPFlags `protobuf:"bytes,6,rep,name=tcp_flags,json=tcpFlags,proto3" json:"tcp_flags,omitempty"`
+	// L7 record type: dns, http, http2, kafka, cache, sql, tls
+	L7Type        []string `protobuf:"bytes,7,rep,name=l7_type,json=l7Type,proto3" json:"l7_type,omitempty"`
+	HttpMethod    []string `protobuf:"bytes,8,rep,name=http_method,json=httpMethod,proto3" json:"http_method,omitempty"`
+	HttpUrlPrefix []string `protobuf:"bytes,9,rep,name=http_url_prefix,json=httpUrlPrefix,proto3" json:"http_url_prefix,omitempty"`
+	// matches the dns query or any of its parent domains
+	DnsQuery []string `protobuf:"bytes,10,rep,name=dns_query,json=dnsQuery,proto3" json:"dns_query,omitempty"`
+	NodeName []string `protobuf:"bytes,11,rep,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	// namespaces are only known to juno server, see Trace.source
+	SourceNamespace      []string `protobuf:"bytes,12,rep,name=source_namespace,json=sourceNamespace,proto3" json:"source_namespace,omitempty"`
+	DestinationNamespace []string `protobuf:"bytes,13,rep,name=destination_namespace,json=destinationNamespace,proto3" json:"destination_namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceFilter) Reset()         { *m = TraceFilter{} }
+func (m *TraceFilter) String() string { return proto.CompactTextString(m) }
+func (*TraceFilter) ProtoMessage()    {}
+func (*TraceFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{1}
+}
+
+func (m *TraceFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceFilter.Unmarshal(m, b)
+}
+func (m *TraceFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceFilter.Marshal(b, m, deterministic)
+}
+func (m *TraceFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceFilter.Merge(m, src)
+}
+func (m *TraceFilter) XXX_Size() int {
+	return xxx_messageInfo_TraceFilter.Size(m)
+}
+func (m *TraceFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceFilter proto.InternalMessageInfo
+
+func (m *TraceFilter) GetSourceIp() []string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetDestinationIp() []string {
+	if m != nil {
+		return m.DestinationIp
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetProtocol() []string {
+	if m != nil {
+		return m.Protocol
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetSourcePort() []uint32 {
+	if m != nil {
+		return m.SourcePort
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetDestinationPort() []uint32 {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetTcpFlags() []*TCPFlags {
+	if m != nil {
+		return m.TcpFlags
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetL7Type() []string {
+	if m != nil {
+		return m.L7Type
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetHttpMethod() []string {
+	if m != nil {
+		return m.HttpMethod
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetHttpUrlPrefix() []string {
+	if m != nil {
+		return m.HttpUrlPrefix
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetDnsQuery() []string {
+	if m != nil {
+		return m.DnsQuery
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetNodeName() []string {
+	if m != nil {
+		return m.NodeName
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetSourceNamespace() []string {
+	if m != nil {
+		return m.SourceNamespace
+	}
+	return nil
+}
+
+func (m *TraceFilter) GetDestinationNamespace() []string {
+	if m != nil {
+		return m.DestinationNamespace
+	}
+	return nil
+}
+
 type GetTracesResponse struct {
 	Trace                *Trace   `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -95,7 +327,7 @@ func (m *GetTracesResponse) Reset()         { *m = GetTracesResponse{} }
 func (m *GetTracesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTracesResponse) ProtoMessage()    {}
 func (*GetTracesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{1}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{2}
 }
 
 func (m *GetTracesResponse) XXX_Unmarshal(b []byte) error {
@@ -124,23 +356,33 @@ func (m *GetTracesResponse) GetTrace() *Trace {
 }
 
 type Trace struct {
-	Time                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	IP                   *IP                  `protobuf:"bytes,5,opt,name=IP,proto3" json:"IP,omitempty"`
-	L4                   *Layer4              `protobuf:"bytes,6,opt,name=l4,proto3" json:"l4,omitempty"`
-	L7                   *Layer7              `protobuf:"bytes,15,opt,name=l7,proto3" json:"l7,omitempty"`
-	Source               *Endpoint            `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
-	Destination          *Endpoint            `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty"`
-	NodeName             string               `protobuf:"bytes,11,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Time             *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	IP               *IP                  `protobuf:"bytes,5,opt,name=IP,proto3" json:"IP,omitempty"`
+	L4               *Layer4              `protobuf:"bytes,6,opt,name=l4,proto3" json:"l4,omitempty"`
+	L7               *Layer7              `protobuf:"bytes,15,opt,name=l7,proto3" json:"l7,omitempty"`
+	Source           *Endpoint            `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	Destination      *Endpoint            `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty"`
+	NodeName         string               `protobuf:"bytes,11,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	TrafficDirection TrafficDirection     `protobuf:"varint,16,opt,name=traffic_direction,json=trafficDirection,proto3,enum=tracer.TrafficDirection" json:"traffic_direction,omitempty"`
+	// true if the packet was sent by the server of the connection.
+	// Unset if the start of the connection has not been observed.
+	IsReply *wrappers.BoolValue `protobuf:"bytes,17,opt,name=is_reply,json=isReply,proto3" json:"is_reply,omitempty"`
+	// set if the trace summarizes the packets of a flow
+	// which were aggregated by the eBPF program
+	FlowSummary *FlowSummary `protobuf:"bytes,18,opt,name=flow_summary,json=flowSummary,proto3" json:"flow_summary,omitempty"`
+	// number of packets of the flow which the sampled packet represents:
+	// multiply by it to extrapolate counts. Unset for flow summaries.
+	SampleRate           uint32   `protobuf:"varint,19,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Trace) Reset()         { *m = Trace{} }
 func (m *Trace) String() string { return proto.CompactTextString(m) }
 func (*Trace) ProtoMessage()    {}
 func (*Trace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{2}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{3}
 }
 
 func (m *Trace) XXX_Unmarshal(b []byte) error {
@@ -210,6 +452,102 @@ func (m *Trace) GetNodeName() string {
 	return ""
 }
 
+func (m *Trace) GetTrafficDirection() TrafficDirection {
+	if m != nil {
+		return m.TrafficDirection
+	}
+	return TrafficDirection_TRAFFIC_DIRECTION_UNKNOWN
+}
+
+func (m *Trace) GetIsReply() *wrappers.BoolValue {
+	if m != nil {
+		return m.IsReply
+	}
+	return nil
+}
+
+func (m *Trace) GetFlowSummary() *FlowSummary {
+	if m != nil {
+		return m.FlowSummary
+	}
+	return nil
+}
+
+func (m *Trace) GetSampleRate() uint32 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+// FlowSummary counts the packets of one direction of a flow
+// on the capturing interface. The trace time is the last packet,
+// the TCP flags of the trace are the union of the flags of the flow.
+type FlowSummary struct {
+	// packets and bytes since the previous summary of the flow
+	Packets uint64 `protobuf:"varint,1,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes   uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// first packet of the flow
+	FirstSeen            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FlowSummary) Reset()         { *m = FlowSummary{} }
+func (m *FlowSummary) String() string { return proto.CompactTextString(m) }
+func (*FlowSummary) ProtoMessage()    {}
+func (*FlowSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{4}
+}
+
+func (m *FlowSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlowSummary.Unmarshal(m, b)
+}
+func (m *FlowSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlowSummary.Marshal(b, m, deterministic)
+}
+func (m *FlowSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowSummary.Merge(m, src)
+}
+func (m *FlowSummary) XXX_Size() int {
+	return xxx_messageInfo_FlowSummary.Size(m)
+}
+func (m *FlowSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowSummary proto.InternalMessageInfo
+
+func (m *FlowSummary) GetPackets() uint64 {
+	if m != nil {
+		return m.Packets
+	}
+	return 0
+}
+
+func (m *FlowSummary) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *FlowSummary) GetFirstSeen() *timestamp.Timestamp {
+	if m != nil {
+		return m.FirstSeen
+	}
+	return nil
+}
+
+func (m *FlowSummary) GetLastSeen() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeen
+	}
+	return nil
+}
+
 type Layer4 struct {
 	// Types that are valid to be assigned to Protocol:
 	//	*Layer4_TCP
@@ -226,7 +564,7 @@ func (m *Layer4) Reset()         { *m = Layer4{} }
 func (m *Layer4) String() string { return proto.CompactTextString(m) }
 func (*Layer4) ProtoMessage()    {}
 func (*Layer4) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{3}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{5}
 }
 
 func (m *Layer4) XXX_Unmarshal(b []byte) error {
@@ -324,6 +662,11 @@ type Layer7 struct {
 	// Types that are valid to be assigned to Record:
 	//	*Layer7_Dns
 	//	*Layer7_Http
+	//	*Layer7_Http2
+	//	*Layer7_Kafka
+	//	*Layer7_Cache
+	//	*Layer7_Sql
+	//	*Layer7_Tls
 	Record               isLayer7_Record `protobuf_oneof:"record"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -334,7 +677,7 @@ func (m *Layer7) Reset()         { *m = Layer7{} }
 func (m *Layer7) String() string { return proto.CompactTextString(m) }
 func (*Layer7) ProtoMessage()    {}
 func (*Layer7) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{4}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{6}
 }
 
 func (m *Layer7) XXX_Unmarshal(b []byte) error {
@@ -367,10 +710,40 @@ type Layer7_Http struct {
 	Http *HTTP `protobuf:"bytes,101,opt,name=http,proto3,oneof"`
 }
 
+type Layer7_Http2 struct {
+	Http2 *HTTP2 `protobuf:"bytes,102,opt,name=http2,proto3,oneof"`
+}
+
+type Layer7_Kafka struct {
+	Kafka *Kafka `protobuf:"bytes,103,opt,name=kafka,proto3,oneof"`
+}
+
+type Layer7_Cache struct {
+	Cache *Cache `protobuf:"bytes,104,opt,name=cache,proto3,oneof"`
+}
+
+type Layer7_Sql struct {
+	Sql *SQL `protobuf:"bytes,105,opt,name=sql,proto3,oneof"`
+}
+
+type Layer7_Tls struct {
+	Tls *TLS `protobuf:"bytes,106,opt,name=tls,proto3,oneof"`
+}
+
 func (*Layer7_Dns) isLayer7_Record() {}
 
 func (*Layer7_Http) isLayer7_Record() {}
 
+func (*Layer7_Http2) isLayer7_Record() {}
+
+func (*Layer7_Kafka) isLayer7_Record() {}
+
+func (*Layer7_Cache) isLayer7_Record() {}
+
+func (*Layer7_Sql) isLayer7_Record() {}
+
+func (*Layer7_Tls) isLayer7_Record() {}
+
 func (m *Layer7) GetRecord() isLayer7_Record {
 	if m != nil {
 		return m.Record
@@ -392,11 +765,51 @@ func (m *Layer7) GetHttp() *HTTP {
 	return nil
 }
 
+func (m *Layer7) GetHttp2() *HTTP2 {
+	if x, ok := m.GetRecord().(*Layer7_Http2); ok {
+		return x.Http2
+	}
+	return nil
+}
+
+func (m *Layer7) GetKafka() *Kafka {
+	if x, ok := m.GetRecord().(*Layer7_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (m *Layer7) GetCache() *Cache {
+	if x, ok := m.GetRecord().(*Layer7_Cache); ok {
+		return x.Cache
+	}
+	return nil
+}
+
+func (m *Layer7) GetSql() *SQL {
+	if x, ok := m.GetRecord().(*Layer7_Sql); ok {
+		return x.Sql
+	}
+	return nil
+}
+
+func (m *Layer7) GetTls() *TLS {
+	if x, ok := m.GetRecord().(*Layer7_Tls); ok {
+		return x.Tls
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Layer7) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Layer7_Dns)(nil),
 		(*Layer7_Http)(nil),
+		(*Layer7_Http2)(nil),
+		(*Layer7_Kafka)(nil),
+		(*Layer7_Cache)(nil),
+		(*Layer7_Sql)(nil),
+		(*Layer7_Tls)(nil),
 	}
 }
 
@@ -413,7 +826,7 @@ func (m *Endpoint) Reset()         { *m = Endpoint{} }
 func (m *Endpoint) String() string { return proto.CompactTextString(m) }
 func (*Endpoint) ProtoMessage()    {}
 func (*Endpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{5}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{7}
 }
 
 func (m *Endpoint) XXX_Unmarshal(b []byte) error {
@@ -468,7 +881,7 @@ func (m *IP) Reset()         { *m = IP{} }
 func (m *IP) String() string { return proto.CompactTextString(m) }
 func (*IP) ProtoMessage()    {}
 func (*IP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{6}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{8}
 }
 
 func (m *IP) XXX_Unmarshal(b []byte) error {
@@ -523,7 +936,7 @@ func (m *TCP) Reset()         { *m = TCP{} }
 func (m *TCP) String() string { return proto.CompactTextString(m) }
 func (*TCP) ProtoMessage()    {}
 func (*TCP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{7}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{9}
 }
 
 func (m *TCP) XXX_Unmarshal(b []byte) error {
@@ -584,7 +997,7 @@ func (m *TCPFlags) Reset()         { *m = TCPFlags{} }
 func (m *TCPFlags) String() string { return proto.CompactTextString(m) }
 func (*TCPFlags) ProtoMessage()    {}
 func (*TCPFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{8}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{10}
 }
 
 func (m *TCPFlags) XXX_Unmarshal(b []byte) error {
@@ -680,7 +1093,7 @@ func (m *UDP) Reset()         { *m = UDP{} }
 func (m *UDP) String() string { return proto.CompactTextString(m) }
 func (*UDP) ProtoMessage()    {}
 func (*UDP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{9}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{11}
 }
 
 func (m *UDP) XXX_Unmarshal(b []byte) error {
@@ -716,18 +1129,23 @@ func (m *UDP) GetDestinationPort() uint32 {
 }
 
 type ICMPv4 struct {
-	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Code                 uint32   `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// next-hop MTU of fragmentation needed messages
+	Mtu uint32 `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// flow of the packet which caused a destination
+	// unreachable or time exceeded message
+	Original             *ICMPOriginalFlow `protobuf:"bytes,4,opt,name=original,proto3" json:"original,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ICMPv4) Reset()         { *m = ICMPv4{} }
 func (m *ICMPv4) String() string { return proto.CompactTextString(m) }
 func (*ICMPv4) ProtoMessage()    {}
 func (*ICMPv4) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{10}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{12}
 }
 
 func (m *ICMPv4) XXX_Unmarshal(b []byte) error {
@@ -762,19 +1180,38 @@ func (m *ICMPv4) GetCode() uint32 {
 	return 0
 }
 
-type ICMPv6 struct {
-	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Code                 uint32   `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
+func (m *ICMPv4) GetMtu() uint32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *ICMPv4) GetOriginal() *ICMPOriginalFlow {
+	if m != nil {
+		return m.Original
+	}
+	return nil
+}
+
+type ICMPv6 struct {
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// MTU of packet too big messages
+	Mtu uint32 `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// flow of the packet which caused a destination
+	// unreachable, packet too big or time exceeded message
+	Original             *ICMPOriginalFlow `protobuf:"bytes,4,opt,name=original,proto3" json:"original,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
 func (m *ICMPv6) Reset()         { *m = ICMPv6{} }
 func (m *ICMPv6) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6) ProtoMessage()    {}
 func (*ICMPv6) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{11}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{13}
 }
 
 func (m *ICMPv6) XXX_Unmarshal(b []byte) error {
@@ -809,8 +1246,79 @@ func (m *ICMPv6) GetCode() uint32 {
 	return 0
 }
 
+func (m *ICMPv6) GetMtu() uint32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *ICMPv6) GetOriginal() *ICMPOriginalFlow {
+	if m != nil {
+		return m.Original
+	}
+	return nil
+}
+
+// ICMPOriginalFlow contains the ip and l4 header of the packet
+// quoted in an ICMP error message. Only the ports of TCP
+// and UDP are available.
+type ICMPOriginalFlow struct {
+	IP                   *IP      `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
+	L4                   *Layer4  `protobuf:"bytes,2,opt,name=l4,proto3" json:"l4,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ICMPOriginalFlow) Reset()         { *m = ICMPOriginalFlow{} }
+func (m *ICMPOriginalFlow) String() string { return proto.CompactTextString(m) }
+func (*ICMPOriginalFlow) ProtoMessage()    {}
+func (*ICMPOriginalFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{14}
+}
+
+func (m *ICMPOriginalFlow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ICMPOriginalFlow.Unmarshal(m, b)
+}
+func (m *ICMPOriginalFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ICMPOriginalFlow.Marshal(b, m, deterministic)
+}
+func (m *ICMPOriginalFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICMPOriginalFlow.Merge(m, src)
+}
+func (m *ICMPOriginalFlow) XXX_Size() int {
+	return xxx_messageInfo_ICMPOriginalFlow.Size(m)
+}
+func (m *ICMPOriginalFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICMPOriginalFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICMPOriginalFlow proto.InternalMessageInfo
+
+func (m *ICMPOriginalFlow) GetIP() *IP {
+	if m != nil {
+		return m.IP
+	}
+	return nil
+}
+
+func (m *ICMPOriginalFlow) GetL4() *Layer4 {
+	if m != nil {
+		return m.L4
+	}
+	return nil
+}
+
 type DNS struct {
+	// fully qualified name of the first question
 	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// addresses of the A and AAAA answers
+	Ips []string `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
+	// lowest TTL of the answers in seconds
+	Ttl uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// canonical names of the CNAME answers
+	Cnames []string `protobuf:"bytes,4,rep,name=cnames,proto3" json:"cnames,omitempty"`
 	// Return code of the DNS request defined in:
 	//   https://www.iana.org/assignments/dns-parameters/dns-parameters.xhtml#dns-parameters-6
 	Rcode uint32 `protobuf:"varint,6,opt,name=rcode,proto3" json:"rcode,omitempty"`
@@ -819,7 +1327,9 @@ type DNS struct {
 	Qtypes []string `protobuf:"bytes,7,rep,name=qtypes,proto3" json:"qtypes,omitempty"`
 	// String representation of rrtypes defined in:
 	// https://www.iana.org/assignments/dns-parameters/dns-parameters.xhtml#dns-parameters-4
-	Rrtypes              []string `protobuf:"bytes,8,rep,name=rrtypes,proto3" json:"rrtypes,omitempty"`
+	Rrtypes []string `protobuf:"bytes,8,rep,name=rrtypes,proto3" json:"rrtypes,omitempty"`
+	// true if the message is a response
+	Response             bool     `protobuf:"varint,9,opt,name=response,proto3" json:"response,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -829,7 +1339,7 @@ func (m *DNS) Reset()         { *m = DNS{} }
 func (m *DNS) String() string { return proto.CompactTextString(m) }
 func (*DNS) ProtoMessage()    {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{12}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{15}
 }
 
 func (m *DNS) XXX_Unmarshal(b []byte) error {
@@ -857,6 +1367,27 @@ func (m *DNS) GetQuery() string {
 	return ""
 }
 
+func (m *DNS) GetIps() []string {
+	if m != nil {
+		return m.Ips
+	}
+	return nil
+}
+
+func (m *DNS) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *DNS) GetCnames() []string {
+	if m != nil {
+		return m.Cnames
+	}
+	return nil
+}
+
 func (m *DNS) GetRcode() uint32 {
 	if m != nil {
 		return m.Rcode
@@ -878,6 +1409,455 @@ func (m *DNS) GetRrtypes() []string {
 	return nil
 }
 
+func (m *DNS) GetResponse() bool {
+	if m != nil {
+		return m.Response
+	}
+	return false
+}
+
+// HTTP2 is a HEADERS frame of a HTTP/2 stream. Responses carry
+// the path and the gRPC fields of the request of the stream.
+type HTTP2 struct {
+	StreamId uint32 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// pseudo header fields
+	Method    string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	Code      uint32 `protobuf:"varint,5,opt,name=code,proto3" json:"code,omitempty"`
+	// set if the stream is a gRPC call
+	GrpcService string `protobuf:"bytes,6,opt,name=grpc_service,json=grpcService,proto3" json:"grpc_service,omitempty"`
+	GrpcMethod  string `protobuf:"bytes,7,opt,name=grpc_method,json=grpcMethod,proto3" json:"grpc_method,omitempty"`
+	// grpc-status and grpc-message of the response trailers
+	GrpcStatus           *wrappers.UInt32Value `protobuf:"bytes,8,opt,name=grpc_status,json=grpcStatus,proto3" json:"grpc_status,omitempty"`
+	GrpcMessage          string                `protobuf:"bytes,9,opt,name=grpc_message,json=grpcMessage,proto3" json:"grpc_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HTTP2) Reset()         { *m = HTTP2{} }
+func (m *HTTP2) String() string { return proto.CompactTextString(m) }
+func (*HTTP2) ProtoMessage()    {}
+func (*HTTP2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{16}
+}
+
+func (m *HTTP2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTTP2.Unmarshal(m, b)
+}
+func (m *HTTP2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTTP2.Marshal(b, m, deterministic)
+}
+func (m *HTTP2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTP2.Merge(m, src)
+}
+func (m *HTTP2) XXX_Size() int {
+	return xxx_messageInfo_HTTP2.Size(m)
+}
+func (m *HTTP2) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTP2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTP2 proto.InternalMessageInfo
+
+func (m *HTTP2) GetStreamId() uint32 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *HTTP2) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *HTTP2) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HTTP2) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *HTTP2) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *HTTP2) GetGrpcService() string {
+	if m != nil {
+		return m.GrpcService
+	}
+	return ""
+}
+
+func (m *HTTP2) GetGrpcMethod() string {
+	if m != nil {
+		return m.GrpcMethod
+	}
+	return ""
+}
+
+func (m *HTTP2) GetGrpcStatus() *wrappers.UInt32Value {
+	if m != nil {
+		return m.GrpcStatus
+	}
+	return nil
+}
+
+func (m *HTTP2) GetGrpcMessage() string {
+	if m != nil {
+		return m.GrpcMessage
+	}
+	return ""
+}
+
+// Kafka is the header of a Kafka request
+type Kafka struct {
+	ApiKey uint32 `protobuf:"varint,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// name of the api key, e.g. "Produce"
+	Api           string `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
+	ApiVersion    uint32 `protobuf:"varint,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	CorrelationId int32  `protobuf:"varint,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ClientId      string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// topics of Produce and Fetch requests
+	// which are contained in the sample
+	Topics               []string `protobuf:"bytes,6,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Kafka) Reset()         { *m = Kafka{} }
+func (m *Kafka) String() string { return proto.CompactTextString(m) }
+func (*Kafka) ProtoMessage()    {}
+func (*Kafka) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{17}
+}
+
+func (m *Kafka) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Kafka.Unmarshal(m, b)
+}
+func (m *Kafka) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Kafka.Marshal(b, m, deterministic)
+}
+func (m *Kafka) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Kafka.Merge(m, src)
+}
+func (m *Kafka) XXX_Size() int {
+	return xxx_messageInfo_Kafka.Size(m)
+}
+func (m *Kafka) XXX_DiscardUnknown() {
+	xxx_messageInfo_Kafka.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Kafka proto.InternalMessageInfo
+
+func (m *Kafka) GetApiKey() uint32 {
+	if m != nil {
+		return m.ApiKey
+	}
+	return 0
+}
+
+func (m *Kafka) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *Kafka) GetApiVersion() uint32 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *Kafka) GetCorrelationId() int32 {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return 0
+}
+
+func (m *Kafka) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Kafka) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+// Cache is a redis or memcached command or reply
+type Cache struct {
+	// redis or memcached
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// verb of a command, e.g. GET
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// first key of a command or the key of a memcached VALUE reply.
+	// It may be hashed or redacted.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// type of a reply: the RESP type for redis, e.g. simple_string,
+	// or the first word of the reply for memcached, e.g. STORED
+	Reply string `protobuf:"bytes,4,opt,name=reply,proto3" json:"reply,omitempty"`
+	// message of an error reply
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cache) Reset()         { *m = Cache{} }
+func (m *Cache) String() string { return proto.CompactTextString(m) }
+func (*Cache) ProtoMessage()    {}
+func (*Cache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{18}
+}
+
+func (m *Cache) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cache.Unmarshal(m, b)
+}
+func (m *Cache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cache.Marshal(b, m, deterministic)
+}
+func (m *Cache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cache.Merge(m, src)
+}
+func (m *Cache) XXX_Size() int {
+	return xxx_messageInfo_Cache.Size(m)
+}
+func (m *Cache) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cache proto.InternalMessageInfo
+
+func (m *Cache) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *Cache) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *Cache) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Cache) GetReply() string {
+	if m != nil {
+		return m.Reply
+	}
+	return ""
+}
+
+func (m *Cache) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SQL is a PostgreSQL or MySQL message
+type SQL struct {
+	// postgres or mysql
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// first keyword of the query, e.g. SELECT
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	// query with literals replaced by ? and whitespace collapsed
+	Fingerprint string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// database and user of the connection, known
+	// if the startup message has been observed
+	Database string `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	User     string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// SQLSTATE for postgres, error number for mysql
+	ErrorCode            string   `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQL) Reset()         { *m = SQL{} }
+func (m *SQL) String() string { return proto.CompactTextString(m) }
+func (*SQL) ProtoMessage()    {}
+func (*SQL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{19}
+}
+
+func (m *SQL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SQL.Unmarshal(m, b)
+}
+func (m *SQL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SQL.Marshal(b, m, deterministic)
+}
+func (m *SQL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQL.Merge(m, src)
+}
+func (m *SQL) XXX_Size() int {
+	return xxx_messageInfo_SQL.Size(m)
+}
+func (m *SQL) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQL proto.InternalMessageInfo
+
+func (m *SQL) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *SQL) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+func (m *SQL) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *SQL) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *SQL) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SQL) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
+func (m *SQL) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+// TLS is a ClientHello or ServerHello handshake message
+type TLS struct {
+	// client_hello or server_hello
+	Handshake string `protobuf:"bytes,1,opt,name=handshake,proto3" json:"handshake,omitempty"`
+	// server name indication of the ClientHello
+	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// highest version offered by the client or
+	// version selected by the server, e.g. TLS 1.3
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// protocols offered by the client or selected by the server
+	Alpn []string `protobuf:"bytes,4,rep,name=alpn,proto3" json:"alpn,omitempty"`
+	// cipher suite selected by the server
+	CipherSuite          string   `protobuf:"bytes,5,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TLS) Reset()         { *m = TLS{} }
+func (m *TLS) String() string { return proto.CompactTextString(m) }
+func (*TLS) ProtoMessage()    {}
+func (*TLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{20}
+}
+
+func (m *TLS) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TLS.Unmarshal(m, b)
+}
+func (m *TLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TLS.Marshal(b, m, deterministic)
+}
+func (m *TLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLS.Merge(m, src)
+}
+func (m *TLS) XXX_Size() int {
+	return xxx_messageInfo_TLS.Size(m)
+}
+func (m *TLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLS proto.InternalMessageInfo
+
+func (m *TLS) GetHandshake() string {
+	if m != nil {
+		return m.Handshake
+	}
+	return ""
+}
+
+func (m *TLS) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *TLS) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *TLS) GetAlpn() []string {
+	if m != nil {
+		return m.Alpn
+	}
+	return nil
+}
+
+func (m *TLS) GetCipherSuite() string {
+	if m != nil {
+		return m.CipherSuite
+	}
+	return ""
+}
+
 type HTTPHeader struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -890,7 +1870,7 @@ func (m *HTTPHeader) Reset()         { *m = HTTPHeader{} }
 func (m *HTTPHeader) String() string { return proto.CompactTextString(m) }
 func (*HTTPHeader) ProtoMessage()    {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{13}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{21}
 }
 
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
@@ -926,21 +1906,29 @@ func (m *HTTPHeader) GetValue() string {
 }
 
 type HTTP struct {
-	Code                 uint32        `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Method               string        `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Url                  string        `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Protocol             string        `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Headers              []*HTTPHeader `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Code     uint32        `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Method   string        `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Url      string        `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Protocol string        `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Headers  []*HTTPHeader `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// value of the Host header of a request
+	Host string `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	// reason phrase of a response, e.g. "Not Found"
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// time between the request and the response in nanoseconds.
+	// Only set on responses which were paired with their request,
+	// method, url and host of the request are set as well.
+	LatencyNs            uint64   `protobuf:"varint,8,opt,name=latency_ns,json=latencyNs,proto3" json:"latency_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HTTP) Reset()         { *m = HTTP{} }
 func (m *HTTP) String() string { return proto.CompactTextString(m) }
 func (*HTTP) ProtoMessage()    {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{14}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{22}
 }
 
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
@@ -996,6 +1984,142 @@ func (m *HTTP) GetHeaders() []*HTTPHeader {
 	return nil
 }
 
+func (m *HTTP) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *HTTP) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *HTTP) GetLatencyNs() uint64 {
+	if m != nil {
+		return m.LatencyNs
+	}
+	return 0
+}
+
+type ListTracesRequest struct {
+	// maximum number of traces to return, defaults to 100
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response. If empty
+	// the oldest traces are returned. Tokens are positions
+	// in the trace buffer of the server, they are invalid
+	// after the server restarted
+	PageToken            string         `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Allowlist            []*TraceFilter `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	Denylist             []*TraceFilter `protobuf:"bytes,4,rep,name=denylist,proto3" json:"denylist,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListTracesRequest) Reset()         { *m = ListTracesRequest{} }
+func (m *ListTracesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTracesRequest) ProtoMessage()    {}
+func (*ListTracesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{23}
+}
+
+func (m *ListTracesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTracesRequest.Unmarshal(m, b)
+}
+func (m *ListTracesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTracesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTracesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTracesRequest.Merge(m, src)
+}
+func (m *ListTracesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTracesRequest.Size(m)
+}
+func (m *ListTracesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTracesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTracesRequest proto.InternalMessageInfo
+
+func (m *ListTracesRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListTracesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListTracesRequest) GetAllowlist() []*TraceFilter {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *ListTracesRequest) GetDenylist() []*TraceFilter {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+type ListTracesResponse struct {
+	Traces               []*Trace `protobuf:"bytes,1,rep,name=traces,proto3" json:"traces,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTracesResponse) Reset()         { *m = ListTracesResponse{} }
+func (m *ListTracesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTracesResponse) ProtoMessage()    {}
+func (*ListTracesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d422d7c66fbbd8f, []int{24}
+}
+
+func (m *ListTracesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTracesResponse.Unmarshal(m, b)
+}
+func (m *ListTracesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTracesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTracesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTracesResponse.Merge(m, src)
+}
+func (m *ListTracesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTracesResponse.Size(m)
+}
+func (m *ListTracesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTracesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTracesResponse proto.InternalMessageInfo
+
+func (m *ListTracesResponse) GetTraces() []*Trace {
+	if m != nil {
+		return m.Traces
+	}
+	return nil
+}
+
+func (m *ListTracesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type ServerStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1006,7 +2130,7 @@ func (m *ServerStatusRequest) Reset()         { *m = ServerStatusRequest{} }
 func (m *ServerStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ServerStatusRequest) ProtoMessage()    {}
 func (*ServerStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{15}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{25}
 }
 
 func (m *ServerStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -1028,8 +2152,10 @@ func (m *ServerStatusRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_ServerStatusRequest proto.InternalMessageInfo
 
 type ServerStatusResponse struct {
-	NumFlows             uint64   `protobuf:"varint,1,opt,name=num_flows,json=numFlows,proto3" json:"num_flows,omitempty"`
-	MaxFlows             uint64   `protobuf:"varint,2,opt,name=max_flows,json=maxFlows,proto3" json:"max_flows,omitempty"`
+	NumFlows uint64 `protobuf:"varint,1,opt,name=num_flows,json=numFlows,proto3" json:"num_flows,omitempty"`
+	MaxFlows uint64 `protobuf:"varint,2,opt,name=max_flows,json=maxFlows,proto3" json:"max_flows,omitempty"`
+	// number of agents the server is connected to
+	NumAgents            uint32   `protobuf:"varint,3,opt,name=num_agents,json=numAgents,proto3" json:"num_agents,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1039,7 +2165,7 @@ func (m *ServerStatusResponse) Reset()         { *m = ServerStatusResponse{} }
 func (m *ServerStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ServerStatusResponse) ProtoMessage()    {}
 func (*ServerStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d422d7c66fbbd8f, []int{16}
+	return fileDescriptor_6d422d7c66fbbd8f, []int{26}
 }
 
 func (m *ServerStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -1074,11 +2200,21 @@ func (m *ServerStatusResponse) GetMaxFlows() uint64 {
 	return 0
 }
 
+func (m *ServerStatusResponse) GetNumAgents() uint32 {
+	if m != nil {
+		return m.NumAgents
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("tracer.TrafficDirection", TrafficDirection_name, TrafficDirection_value)
 	proto.RegisterEnum("tracer.IPVersion", IPVersion_name, IPVersion_value)
 	proto.RegisterType((*GetTracesRequest)(nil), "tracer.GetTracesRequest")
+	proto.RegisterType((*TraceFilter)(nil), "tracer.TraceFilter")
 	proto.RegisterType((*GetTracesResponse)(nil), "tracer.GetTracesResponse")
 	proto.RegisterType((*Trace)(nil), "tracer.Trace")
+	proto.RegisterType((*FlowSummary)(nil), "tracer.FlowSummary")
 	proto.RegisterType((*Layer4)(nil), "tracer.Layer4")
 	proto.RegisterType((*Layer7)(nil), "tracer.Layer7")
 	proto.RegisterType((*Endpoint)(nil), "tracer.Endpoint")
@@ -1089,9 +2225,17 @@ func init() {
 	proto.RegisterType((*UDP)(nil), "tracer.UDP")
 	proto.RegisterType((*ICMPv4)(nil), "tracer.ICMPv4")
 	proto.RegisterType((*ICMPv6)(nil), "tracer.ICMPv6")
+	proto.RegisterType((*ICMPOriginalFlow)(nil), "tracer.ICMPOriginalFlow")
 	proto.RegisterType((*DNS)(nil), "tracer.DNS")
+	proto.RegisterType((*HTTP2)(nil), "tracer.HTTP2")
+	proto.RegisterType((*Kafka)(nil), "tracer.Kafka")
+	proto.RegisterType((*Cache)(nil), "tracer.Cache")
+	proto.RegisterType((*SQL)(nil), "tracer.SQL")
+	proto.RegisterType((*TLS)(nil), "tracer.TLS")
 	proto.RegisterType((*HTTPHeader)(nil), "tracer.HTTPHeader")
 	proto.RegisterType((*HTTP)(nil), "tracer.HTTP")
+	proto.RegisterType((*ListTracesRequest)(nil), "tracer.ListTracesRequest")
+	proto.RegisterType((*ListTracesResponse)(nil), "tracer.ListTracesResponse")
 	proto.RegisterType((*ServerStatusRequest)(nil), "tracer.ServerStatusRequest")
 	proto.RegisterType((*ServerStatusResponse)(nil), "tracer.ServerStatusResponse")
 }
@@ -1101,66 +2245,144 @@ func init() {
 }
 
 var fileDescriptor_6d422d7c66fbbd8f = []byte{
-	// 939 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xdb, 0x46,
-	0x13, 0x35, 0xa9, 0x1f, 0x93, 0x23, 0xc7, 0x56, 0xf6, 0xcb, 0x57, 0xb0, 0x8a, 0xd1, 0x08, 0x2c,
-	0x50, 0xb8, 0x45, 0x21, 0x1b, 0xaa, 0x20, 0xa7, 0xbd, 0x6b, 0x24, 0x39, 0x16, 0x92, 0xaa, 0xec,
-	0x52, 0x6a, 0xd0, 0x2b, 0x81, 0x16, 0xd7, 0xb6, 0x50, 0x8a, 0x94, 0x97, 0x94, 0x1b, 0xbd, 0x44,
-	0x5e, 0xa0, 0x6f, 0x50, 0xa0, 0xef, 0x58, 0xcc, 0x2c, 0x97, 0x92, 0x02, 0x15, 0xc8, 0x45, 0xef,
-	0x66, 0xcf, 0x39, 0xb3, 0x33, 0x1c, 0x9e, 0x21, 0xe1, 0x28, 0x93, 0xc1, 0x4c, 0xc8, 0xd6, 0x52,
-	0x26, 0x59, 0xc2, 0xaa, 0xea, 0xd4, 0x78, 0x71, 0x97, 0x24, 0x77, 0x91, 0x38, 0x27, 0xf4, 0x66,
-	0x75, 0x7b, 0x9e, 0xcd, 0x17, 0x22, 0xcd, 0x82, 0xc5, 0x52, 0x09, 0x5d, 0x06, 0xf5, 0xd7, 0x22,
-	0x1b, 0xa3, 0x3a, 0xe5, 0xe2, 0x61, 0x25, 0xd2, 0xcc, 0x7d, 0x09, 0x4f, 0xb7, 0xb0, 0x74, 0x99,
-	0xc4, 0xa9, 0x60, 0x5f, 0x42, 0x85, 0xee, 0x74, 0x8c, 0xa6, 0x71, 0x56, 0x6b, 0x3f, 0x69, 0xe5,
-	0xf5, 0x48, 0xc6, 0x15, 0xe7, 0x7e, 0x30, 0xa1, 0x42, 0x00, 0x6b, 0x41, 0x19, 0x4b, 0xe5, 0xea,
-	0x46, 0x4b, 0xf5, 0xd1, 0xd2, 0x7d, 0xb4, 0xc6, 0xba, 0x0f, 0x4e, 0x3a, 0xd6, 0x00, 0x73, 0xe8,
-	0x39, 0x15, 0x52, 0x83, 0xbe, 0x7b, 0xe8, 0x71, 0x73, 0xe8, 0xb1, 0x2f, 0xc0, 0x8c, 0x3a, 0x4e,
-	0x95, 0xb8, 0x63, 0xcd, 0xbd, 0x0d, 0xd6, 0x42, 0x76, 0xb8, 0x19, 0x75, 0x88, 0xbf, 0x74, 0x4e,
-	0xf6, 0xf0, 0x97, 0xdc, 0x8c, 0x2e, 0xd9, 0x19, 0x54, 0xd3, 0x64, 0x25, 0x67, 0xc2, 0xb1, 0x48,
-	0x53, 0xd7, 0x9a, 0x41, 0x1c, 0x2e, 0x93, 0x79, 0x9c, 0xf1, 0x9c, 0x67, 0x6d, 0xa8, 0x85, 0x22,
-	0xcd, 0xe6, 0x71, 0x90, 0xcd, 0x93, 0xd8, 0xb1, 0xff, 0x45, 0xbe, 0x2d, 0x62, 0xcf, 0xc1, 0x8e,
-	0x93, 0x50, 0x4c, 0xe3, 0x60, 0x21, 0x9c, 0x5a, 0xd3, 0x38, 0xb3, 0xb9, 0x85, 0xc0, 0x28, 0x58,
-	0x08, 0xf7, 0x2f, 0x03, 0xaa, 0xaa, 0x53, 0xf6, 0x02, 0x4a, 0xe3, 0x9e, 0x97, 0x0f, 0xa4, 0x56,
-	0x8c, 0xaf, 0xe7, 0x5d, 0x1f, 0x70, 0x64, 0x50, 0x30, 0xe9, 0x7b, 0x8e, 0xb9, 0x2b, 0x98, 0xf4,
-	0x49, 0x30, 0xe9, 0x7b, 0xf8, 0x1c, 0xc3, 0xde, 0x4f, 0xde, 0x63, 0xc7, 0x29, 0xed, 0x3e, 0xab,
-	0x42, 0xaf, 0x0f, 0x78, 0xce, 0x17, 0xca, 0xae, 0x53, 0xde, 0xa3, 0xec, 0x16, 0xca, 0xee, 0x2b,
-	0x00, 0x8b, 0xde, 0xc9, 0x2c, 0x89, 0xdc, 0x77, 0x79, 0xaf, 0x97, 0xd8, 0x4a, 0x18, 0xa7, 0x4e,
-	0xb8, 0xdb, 0x4a, 0x7f, 0xe4, 0x63, 0x2b, 0x61, 0x9c, 0x32, 0x17, 0xca, 0xf7, 0x59, 0xb6, 0x74,
-	0x04, 0x29, 0x8e, 0xb4, 0xe2, 0x7a, 0x3c, 0xc6, 0x6e, 0x89, 0x7b, 0x65, 0x41, 0x55, 0x8a, 0x59,
-	0x22, 0x43, 0xf7, 0x6f, 0x03, 0x2c, 0x3d, 0x3c, 0x76, 0x0a, 0x36, 0x8e, 0x2a, 0x5d, 0x6a, 0x33,
-	0xd9, 0x7c, 0x03, 0x30, 0x06, 0x65, 0x1a, 0xa4, 0x49, 0x04, 0xc5, 0xac, 0x03, 0xd5, 0x28, 0xb8,
-	0x11, 0x51, 0xea, 0x94, 0x9a, 0xa5, 0xb3, 0x5a, 0xfb, 0xf4, 0xe3, 0x17, 0xd2, 0x7a, 0x4b, 0xf4,
-	0x20, 0xce, 0xe4, 0x9a, 0xe7, 0xda, 0xc6, 0xf7, 0x50, 0xdb, 0x82, 0x59, 0x1d, 0x4a, 0xbf, 0x8b,
-	0x75, 0x5e, 0x10, 0x43, 0xf6, 0x0c, 0x2a, 0x8f, 0x41, 0xb4, 0xd2, 0xb5, 0xd4, 0xe1, 0x07, 0xf3,
-	0xa5, 0xe1, 0x26, 0x68, 0x46, 0xf6, 0x59, 0x61, 0x1b, 0x95, 0xa4, 0x4d, 0xd2, 0xdc, 0x35, 0x89,
-	0xca, 0xde, 0xb1, 0xc4, 0x39, 0xd8, 0xf3, 0xe5, 0xaf, 0x42, 0xa6, 0xc8, 0xe3, 0xbb, 0x3a, 0x6e,
-	0x3f, 0xdd, 0x78, 0x3a, 0x27, 0xf8, 0x46, 0xe3, 0xae, 0x21, 0x77, 0x40, 0x4d, 0xd5, 0x98, 0x2e,
-	0x13, 0x99, 0x51, 0xd9, 0x27, 0x1c, 0x14, 0xe4, 0x25, 0x32, 0x63, 0x5f, 0x43, 0x7d, 0xab, 0x8e,
-	0x52, 0x99, 0xa4, 0x3a, 0xd9, 0xc2, 0x49, 0xfa, 0x15, 0x54, 0x6e, 0xa3, 0xe0, 0x2e, 0xcd, 0xbd,
-	0x52, 0xdf, 0x32, 0xdc, 0x15, 0xe2, 0x5c, 0xd1, 0xe8, 0x50, 0x4b, 0x63, 0x38, 0xa4, 0xab, 0xe1,
-	0x88, 0x0a, 0x5b, 0x1c, 0x43, 0x44, 0xfc, 0xdf, 0x46, 0x54, 0xc4, 0xe2, 0x18, 0x22, 0xc2, 0xfd,
-	0x31, 0x5d, 0x6b, 0x71, 0x0c, 0x11, 0xf1, 0xfc, 0x6b, 0xb2, 0x9a, 0xc5, 0x31, 0x44, 0xe4, 0xc7,
-	0xde, 0x1b, 0x5a, 0x67, 0x8b, 0x63, 0x88, 0xc8, 0x84, 0xbf, 0xa6, 0x25, 0xb6, 0x38, 0x86, 0x88,
-	0x0c, 0x7a, 0x03, 0xe7, 0x50, 0x21, 0x83, 0xde, 0x00, 0x91, 0xde, 0x3b, 0x4e, 0x4b, 0x6a, 0x71,
-	0x0c, 0xd9, 0x31, 0x98, 0x23, 0x9f, 0xd6, 0xd0, 0xe2, 0xe6, 0xc8, 0x77, 0x7f, 0xa1, 0x15, 0xf9,
-	0x2f, 0xe7, 0xe4, 0x5e, 0xe8, 0xa5, 0x42, 0xeb, 0x65, 0xeb, 0xa5, 0xc8, 0xaf, 0xa3, 0x18, 0xb1,
-	0x59, 0x12, 0x8a, 0x3c, 0x99, 0xe2, 0x22, 0xa3, 0xfb, 0xc9, 0x19, 0x33, 0x28, 0xf5, 0x47, 0x3e,
-	0x1a, 0xee, 0x61, 0x25, 0xa4, 0x36, 0xa1, 0x3a, 0x20, 0x2a, 0x29, 0xa3, 0x4a, 0x19, 0xea, 0x80,
-	0xe6, 0x7b, 0xc0, 0xfb, 0x52, 0xe7, 0xb0, 0x59, 0x42, 0xf3, 0xa9, 0x13, 0x73, 0xe0, 0x50, 0x4a,
-	0x45, 0x58, 0x44, 0xe8, 0xa3, 0xdb, 0x01, 0xa0, 0xf5, 0x13, 0x41, 0x28, 0xe4, 0xa7, 0xda, 0xdd,
-	0xfd, 0x60, 0x40, 0x19, 0xd3, 0x8a, 0xbe, 0x8d, 0x4d, 0xdf, 0xd8, 0xc4, 0x42, 0x64, 0xf7, 0x49,
-	0x98, 0xe7, 0xe4, 0x27, 0xbc, 0x7c, 0x25, 0x23, 0xb2, 0x80, 0xcd, 0x31, 0x64, 0x8d, 0xcd, 0x67,
-	0x84, 0x7c, 0x60, 0xf3, 0xe2, 0xcc, 0xbe, 0x85, 0xc3, 0x7b, 0x6a, 0x2a, 0x75, 0x2a, 0xb4, 0xbf,
-	0x6c, 0xe7, 0x73, 0x41, 0x14, 0xd7, 0x12, 0xf7, 0xff, 0xf0, 0x3f, 0x5f, 0xc8, 0x47, 0x21, 0xfd,
-	0x2c, 0xc8, 0x56, 0xc5, 0x3f, 0xc9, 0x83, 0x67, 0xbb, 0x70, 0xfe, 0x5b, 0xc2, 0xaf, 0xef, 0x6a,
-	0x31, 0xbd, 0x8d, 0x92, 0x3f, 0x52, 0xea, 0xbd, 0xcc, 0xad, 0x78, 0xb5, 0xb8, 0xc2, 0x33, 0x92,
-	0x8b, 0xe0, 0x7d, 0x4e, 0x9a, 0x8a, 0x5c, 0x04, 0xef, 0x89, 0xfc, 0xe6, 0x02, 0xec, 0x62, 0x17,
-	0xd9, 0x09, 0xd4, 0x86, 0xde, 0x74, 0xf4, 0xf3, 0x78, 0x3a, 0xf1, 0x07, 0xfd, 0xfa, 0x01, 0xb3,
-	0xa0, 0x3c, 0xf4, 0x1e, 0x3b, 0x75, 0x23, 0x8f, 0xba, 0x75, 0xb3, 0xfd, 0xa7, 0x01, 0x55, 0xfa,
-	0xbb, 0x49, 0xd6, 0x07, 0xbb, 0xf8, 0x45, 0x32, 0x47, 0x3f, 0xcf, 0xc7, 0x7f, 0xd2, 0xc6, 0xe7,
-	0x7b, 0x18, 0xd5, 0xb8, 0x7b, 0x70, 0x61, 0xb0, 0x37, 0x70, 0xb4, 0xfd, 0x50, 0xec, 0xb9, 0x96,
-	0xef, 0x99, 0x40, 0xe3, 0x74, 0x3f, 0xa9, 0xaf, 0xbb, 0xa9, 0xd2, 0xc0, 0xbf, 0xfb, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x5a, 0x62, 0x42, 0xb3, 0x09, 0x08, 0x00, 0x00,
+	// 2187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6e, 0x1b, 0xb9,
+	0xf5, 0xf7, 0xe8, 0xcb, 0xa3, 0x23, 0x3b, 0x51, 0x98, 0xec, 0xff, 0x3f, 0xeb, 0x24, 0x1b, 0x75,
+	0x16, 0x59, 0xb8, 0x8b, 0xd6, 0x49, 0x15, 0xd7, 0xde, 0x2d, 0xd0, 0x8b, 0xc4, 0x96, 0x13, 0x35,
+	0x8e, 0xa2, 0x50, 0xf2, 0x2e, 0x7a, 0x35, 0xa0, 0x47, 0x94, 0x3d, 0xf5, 0x68, 0x66, 0x42, 0x52,
+	0x4e, 0xb4, 0x7d, 0x83, 0x5e, 0xf4, 0xae, 0x57, 0x7d, 0x81, 0xa2, 0x40, 0x51, 0x14, 0xbd, 0xec,
+	0x73, 0xf4, 0xa2, 0xf7, 0xdb, 0xf7, 0x28, 0x0e, 0xc9, 0x91, 0x46, 0x8e, 0x9d, 0x6c, 0x81, 0xa0,
+	0x57, 0xe2, 0xf9, 0x9d, 0x1f, 0x87, 0x87, 0xe4, 0xf9, 0xa2, 0x60, 0x4d, 0x09, 0x16, 0x72, 0xb1,
+	0x95, 0x89, 0x54, 0xa5, 0xa4, 0x66, 0xa4, 0x8d, 0x7b, 0x27, 0x69, 0x7a, 0x12, 0xf3, 0x07, 0x1a,
+	0x3d, 0x9e, 0x8e, 0x1f, 0xa8, 0x68, 0xc2, 0xa5, 0x62, 0x93, 0xcc, 0x10, 0x37, 0x3e, 0xbb, 0x48,
+	0x78, 0x23, 0x58, 0x96, 0x71, 0x21, 0x8d, 0xde, 0xff, 0x5d, 0x09, 0x9a, 0x4f, 0xb9, 0x1a, 0xe2,
+	0xe7, 0x24, 0xe5, 0xaf, 0xa7, 0x5c, 0x2a, 0xf2, 0x33, 0xa8, 0xb3, 0x38, 0x4e, 0xdf, 0xc4, 0x91,
+	0x54, 0x9e, 0xd3, 0x2a, 0x6f, 0x36, 0xda, 0x37, 0xb7, 0xec, 0xfa, 0x9a, 0x79, 0x10, 0xc5, 0x8a,
+	0x0b, 0xba, 0x60, 0x91, 0x07, 0xe0, 0x8e, 0x78, 0x32, 0xd3, 0x33, 0x4a, 0x57, 0xcf, 0x98, 0x93,
+	0xc8, 0xff, 0x41, 0x2d, 0x99, 0x4e, 0x8e, 0xb9, 0xf0, 0xca, 0x2d, 0x67, 0xb3, 0x42, 0xad, 0x44,
+	0x1e, 0x42, 0x55, 0x46, 0x49, 0xc8, 0xbd, 0x4a, 0xcb, 0xd9, 0x6c, 0xb4, 0x37, 0xb6, 0xcc, 0x06,
+	0xb6, 0xf2, 0x0d, 0x6c, 0x0d, 0xf3, 0x1d, 0x52, 0x43, 0xc4, 0x19, 0xd3, 0x44, 0x45, 0xb1, 0x57,
+	0xfd, 0xf0, 0x0c, 0x4d, 0xc4, 0xb5, 0xc7, 0x29, 0x9a, 0xee, 0xd5, 0x5a, 0xce, 0xa6, 0x4b, 0xad,
+	0xe4, 0x7f, 0x5f, 0x86, 0x46, 0xc1, 0x5a, 0x72, 0x1b, 0xea, 0x32, 0x9d, 0x8a, 0x90, 0x07, 0x51,
+	0xa6, 0xcf, 0xa1, 0x4e, 0x5d, 0x03, 0x74, 0x33, 0x72, 0x1f, 0xae, 0x8d, 0xb8, 0x54, 0x51, 0xc2,
+	0x54, 0x94, 0x26, 0xc8, 0x28, 0x69, 0xc6, 0x7a, 0x01, 0xed, 0x66, 0x64, 0x03, 0x5c, 0x6d, 0x48,
+	0x98, 0xc6, 0x5e, 0xd9, 0x7c, 0x22, 0x97, 0xc9, 0x3d, 0x68, 0xd8, 0xef, 0x67, 0xa9, 0x50, 0x5e,
+	0xa5, 0x55, 0xde, 0x5c, 0xa7, 0x60, 0xa0, 0x7e, 0x2a, 0x14, 0xf9, 0x31, 0x34, 0x8b, 0x6b, 0x68,
+	0x56, 0x55, 0xb3, 0xae, 0x17, 0x70, 0x4d, 0xfd, 0x29, 0xd4, 0x55, 0x98, 0x05, 0xe3, 0x98, 0x9d,
+	0x48, 0xaf, 0xa6, 0x6f, 0xa0, 0x39, 0xbf, 0x81, 0xbd, 0xfe, 0x01, 0xe2, 0xd4, 0x55, 0x61, 0xa6,
+	0x47, 0xe4, 0xff, 0x61, 0x35, 0xde, 0x0d, 0xd4, 0x2c, 0xe3, 0xde, 0xaa, 0xb6, 0xaa, 0x16, 0xef,
+	0x0e, 0x67, 0x19, 0x47, 0x9b, 0x4e, 0x95, 0xca, 0x82, 0x09, 0x57, 0xa7, 0xe9, 0xc8, 0x73, 0xb5,
+	0x12, 0x10, 0x7a, 0xa1, 0x11, 0xf2, 0x05, 0x5c, 0xd7, 0x84, 0xa9, 0x88, 0x83, 0x4c, 0xf0, 0x71,
+	0xf4, 0xd6, 0xab, 0x9b, 0x8d, 0x23, 0x7c, 0x24, 0xe2, 0xbe, 0x06, 0xf1, 0xf0, 0x46, 0x89, 0x0c,
+	0x5e, 0x4f, 0xb9, 0x98, 0x79, 0x60, 0x76, 0x3e, 0x4a, 0xe4, 0x2b, 0x94, 0x51, 0x99, 0xa4, 0x23,
+	0x1e, 0x24, 0x6c, 0xc2, 0xbd, 0x86, 0x51, 0x22, 0xd0, 0x63, 0x13, 0x8e, 0xbb, 0xb6, 0xc7, 0x82,
+	0x6a, 0x99, 0xb1, 0x90, 0x7b, 0x6b, 0x9a, 0x73, 0xdd, 0xe0, 0xbd, 0x1c, 0x26, 0x8f, 0xe0, 0x93,
+	0xe2, 0x01, 0x2d, 0xf8, 0xeb, 0x9a, 0x7f, 0xab, 0xa0, 0x9c, 0x4f, 0xf2, 0xbf, 0x82, 0x1b, 0x05,
+	0x97, 0x97, 0x59, 0x9a, 0x48, 0x4e, 0x3e, 0x87, 0xaa, 0x3e, 0x2d, 0xcf, 0xd1, 0x5e, 0xb4, 0xbe,
+	0xe4, 0xbd, 0xd4, 0xe8, 0xfc, 0x7f, 0x97, 0xa1, 0xaa, 0x01, 0xb2, 0x05, 0x15, 0x0c, 0x35, 0xcf,
+	0xf9, 0xa0, 0xcf, 0x69, 0x1e, 0xd9, 0x80, 0x52, 0xb7, 0x6f, 0x3d, 0x14, 0xf2, 0x6f, 0x77, 0xfb,
+	0xb4, 0xd4, 0xed, 0x93, 0xcf, 0xa0, 0x14, 0x6f, 0x6b, 0x57, 0x6c, 0xb4, 0xaf, 0xe5, 0xba, 0x43,
+	0x36, 0xe3, 0x62, 0x9b, 0x96, 0xe2, 0x6d, 0xad, 0xdf, 0xf5, 0xae, 0x5f, 0xa2, 0xdf, 0xa5, 0xa5,
+	0x78, 0x97, 0x6c, 0x42, 0xcd, 0x9c, 0x8b, 0xe7, 0xb6, 0x9c, 0xe2, 0xbd, 0x77, 0x92, 0x51, 0x96,
+	0x46, 0x89, 0xa2, 0x56, 0x4f, 0xda, 0xd0, 0x28, 0x9c, 0x88, 0x57, 0xbf, 0x82, 0x5e, 0x24, 0x5d,
+	0xbc, 0x2a, 0x67, 0xe9, 0xaa, 0x3a, 0x70, 0x43, 0x09, 0x36, 0x1e, 0x47, 0x61, 0x30, 0x8a, 0x04,
+	0x0f, 0xf5, 0x67, 0x9b, 0x2d, 0x67, 0xf3, 0x5a, 0xdb, 0x2b, 0x9c, 0x20, 0x12, 0xf6, 0x73, 0x3d,
+	0x6d, 0xaa, 0x0b, 0x08, 0xf9, 0x39, 0xb8, 0x91, 0x0c, 0x04, 0xcf, 0xe2, 0x99, 0x77, 0xe3, 0x8a,
+	0x13, 0x7d, 0x92, 0xa6, 0xf1, 0x37, 0x2c, 0x9e, 0x72, 0xba, 0x1a, 0x49, 0x8a, 0x54, 0xb2, 0x03,
+	0x6b, 0xe3, 0x38, 0x7d, 0x13, 0xc8, 0xe9, 0x64, 0xc2, 0xc4, 0xcc, 0x23, 0x2d, 0xa7, 0x98, 0x78,
+	0x0e, 0xe2, 0xf4, 0xcd, 0xc0, 0xa8, 0x68, 0x63, 0xbc, 0x10, 0x74, 0xdc, 0xb1, 0x49, 0x16, 0xf3,
+	0x40, 0x30, 0xc5, 0xbd, 0x9b, 0x2d, 0x47, 0xc7, 0x9d, 0x86, 0x28, 0x53, 0xdc, 0xff, 0x9b, 0x03,
+	0x8d, 0xc2, 0x6c, 0xe2, 0xc1, 0x6a, 0xc6, 0xc2, 0x33, 0xae, 0xa4, 0xbe, 0xf0, 0x0a, 0xcd, 0x45,
+	0x72, 0x0b, 0xaa, 0xc7, 0x33, 0xc5, 0xa5, 0x57, 0xd2, 0xb8, 0x11, 0xc8, 0xd7, 0x00, 0xe3, 0x48,
+	0x48, 0x15, 0x48, 0xce, 0x13, 0xaf, 0x7c, 0xc5, 0x8e, 0x16, 0x3e, 0x52, 0xd7, 0xec, 0x01, 0xe7,
+	0x09, 0xd9, 0x85, 0x7a, 0xcc, 0xf2, 0x99, 0x1f, 0xce, 0x81, 0x6e, 0xcc, 0xcc, 0x44, 0xff, 0xcf,
+	0x0e, 0xd4, 0x8c, 0xd3, 0x90, 0x7b, 0x50, 0x1e, 0xee, 0xf5, 0xad, 0x6f, 0x36, 0x0a, 0x59, 0xe0,
+	0xd9, 0x0a, 0x45, 0x0d, 0x12, 0x8e, 0xf6, 0xfb, 0x5e, 0x69, 0x99, 0x70, 0xb4, 0xaf, 0x09, 0x47,
+	0xfb, 0x7d, 0x74, 0xa9, 0xee, 0xde, 0x8b, 0xfe, 0xf9, 0xb6, 0x57, 0x5e, 0x76, 0x3b, 0x83, 0x3e,
+	0x5b, 0xa1, 0x56, 0x3f, 0x67, 0xee, 0x78, 0x95, 0x4b, 0x98, 0x3b, 0x73, 0xe6, 0xce, 0x13, 0x58,
+	0x64, 0x42, 0xff, 0xf7, 0x25, 0x6b, 0xec, 0x2e, 0xda, 0x32, 0x4a, 0xa4, 0x37, 0x5a, 0xb6, 0x65,
+	0xbf, 0x37, 0x40, 0x5b, 0x46, 0x89, 0x24, 0x3e, 0x54, 0x30, 0xb3, 0x78, 0x5c, 0x33, 0xd6, 0x72,
+	0xc6, 0xb3, 0xe1, 0x10, 0xcd, 0xd5, 0x3a, 0x72, 0x1f, 0xaa, 0xf8, 0xdb, 0xf6, 0xc6, 0xcb, 0xd1,
+	0x8b, 0xa4, 0xf6, 0xb3, 0x15, 0x6a, 0xb4, 0x48, 0x3b, 0x63, 0xe3, 0x33, 0xe6, 0x9d, 0x2c, 0xd3,
+	0x9e, 0x23, 0x88, 0x34, 0xad, 0x45, 0x5a, 0xc8, 0xc2, 0x53, 0xee, 0x9d, 0x2e, 0xd3, 0xf6, 0x10,
+	0x44, 0x9a, 0xd6, 0xa2, 0xe5, 0xf2, 0x75, 0xec, 0x45, 0xcb, 0x96, 0x0f, 0x5e, 0x1d, 0xa2, 0xe5,
+	0xf2, 0x35, 0xe6, 0xf7, 0xb2, 0x8a, 0xa5, 0xf7, 0x9b, 0x0b, 0xf7, 0x70, 0xa8, 0xb7, 0xa6, 0x62,
+	0xf9, 0xc4, 0x85, 0x9a, 0xe0, 0x61, 0x2a, 0x46, 0xfe, 0x5f, 0x1c, 0x70, 0xf3, 0xf8, 0x23, 0x77,
+	0xa0, 0xbe, 0xc8, 0x64, 0x8e, 0x0e, 0xb9, 0x05, 0x40, 0x08, 0x54, 0x50, 0xd0, 0xb7, 0x57, 0xa7,
+	0x7a, 0x4c, 0xb6, 0xa1, 0x16, 0xb3, 0x63, 0x1e, 0x4b, 0x5d, 0x63, 0x1a, 0xed, 0x3b, 0x17, 0x63,
+	0x7a, 0xeb, 0x50, 0xab, 0x3b, 0x89, 0x12, 0x33, 0x6a, 0xb9, 0x1b, 0x5f, 0x43, 0xa3, 0x00, 0x93,
+	0x26, 0x94, 0xcf, 0xf8, 0xcc, 0x2e, 0x88, 0x43, 0xf4, 0xee, 0x73, 0x0c, 0x39, 0xbb, 0x96, 0x11,
+	0x7e, 0x51, 0xfa, 0xca, 0xf1, 0x53, 0xcc, 0x67, 0x58, 0x48, 0x6d, 0xe6, 0x31, 0x93, 0xac, 0x44,
+	0x5a, 0xcb, 0x79, 0xc6, 0xcc, 0x2e, 0x42, 0xe4, 0x01, 0xd4, 0xa3, 0xec, 0x1b, 0x2e, 0x24, 0xea,
+	0xcb, 0x3a, 0x61, 0xdc, 0x58, 0xa4, 0x45, 0xab, 0xa0, 0x0b, 0x8e, 0x3f, 0x03, 0xeb, 0xb9, 0x4b,
+	0x25, 0xd3, 0xb1, 0xa1, 0xfb, 0xfe, 0x92, 0x59, 0x6a, 0x39, 0x97, 0x95, 0xcc, 0x2f, 0xa0, 0x6a,
+	0xca, 0x65, 0xb9, 0xe5, 0x5c, 0x5a, 0x2e, 0x8d, 0x1a, 0x23, 0xcb, 0xcd, 0x31, 0x3c, 0xa4, 0x83,
+	0x6e, 0x4f, 0x2f, 0xec, 0x52, 0x1c, 0x22, 0x32, 0xf8, 0x75, 0x4f, 0x2f, 0xe2, 0x52, 0x1c, 0x22,
+	0x42, 0x07, 0x43, 0xfd, 0x59, 0x97, 0xe2, 0x10, 0x91, 0xfe, 0xe0, 0x99, 0x0e, 0x11, 0x97, 0xe2,
+	0x10, 0x91, 0xc7, 0x7b, 0xcf, 0x75, 0x45, 0x70, 0x29, 0x0e, 0x11, 0x39, 0xa2, 0x4f, 0x6d, 0x4b,
+	0x82, 0x43, 0x44, 0x3a, 0x7b, 0x1d, 0x6f, 0xd5, 0x20, 0x9d, 0xbd, 0x0e, 0x22, 0x7b, 0xdf, 0x52,
+	0x9d, 0xe7, 0x5d, 0x8a, 0x43, 0x72, 0x0d, 0x4a, 0xbd, 0x81, 0xce, 0xe4, 0x2e, 0x2d, 0xf5, 0x06,
+	0xfe, 0x2b, 0x1d, 0xda, 0x1f, 0xf3, 0x9c, 0xfc, 0xb7, 0x79, 0x32, 0x40, 0xd7, 0xd3, 0x2d, 0x83,
+	0xf9, 0x9c, 0x1e, 0x23, 0x16, 0xa6, 0x23, 0x6e, 0x27, 0xeb, 0x31, 0x9a, 0x39, 0x51, 0x53, 0x7d,
+	0x00, 0xeb, 0x14, 0x87, 0x64, 0x1b, 0xdc, 0x54, 0x44, 0x27, 0x51, 0xc2, 0x62, 0x9b, 0x28, 0xbc,
+	0x62, 0xa2, 0x78, 0x69, 0x75, 0x98, 0x74, 0xe9, 0x9c, 0x39, 0x5f, 0x79, 0xe7, 0x7f, 0xbe, 0x72,
+	0x0f, 0x9a, 0x17, 0xb5, 0xb6, 0x86, 0x3b, 0xef, 0xa9, 0xe1, 0xa5, 0xab, 0x6a, 0xb8, 0xff, 0x0f,
+	0x07, 0xca, 0xfb, 0xbd, 0x01, 0x46, 0x94, 0xe9, 0x88, 0x4c, 0xc0, 0x18, 0x01, 0xad, 0x8e, 0x32,
+	0x69, 0x1b, 0x48, 0x1c, 0x22, 0xa2, 0x54, 0x9c, 0xef, 0x43, 0x29, 0xdd, 0xb4, 0x86, 0x3a, 0x09,
+	0xe8, 0x3e, 0xb1, 0x4e, 0xad, 0x84, 0x5f, 0x14, 0xfa, 0x18, 0x6a, 0x9a, 0x6b, 0x04, 0x64, 0xbf,
+	0xc6, 0x43, 0x92, 0x79, 0x7b, 0x67, 0x24, 0xac, 0x64, 0x42, 0x18, 0x85, 0x69, 0xed, 0x72, 0x11,
+	0x1b, 0x55, 0x61, 0x9b, 0x21, 0xeb, 0x4e, 0x73, 0xd9, 0xff, 0x53, 0x09, 0xaa, 0x3a, 0x95, 0xea,
+	0x96, 0x58, 0x09, 0xce, 0x26, 0x41, 0x34, 0xb2, 0x97, 0xe1, 0x1a, 0xa0, 0x3b, 0xc2, 0x45, 0x6d,
+	0xdb, 0x68, 0x22, 0xde, 0x4a, 0x78, 0x51, 0x19, 0x53, 0xa7, 0x7a, 0x37, 0x75, 0xaa, 0xc7, 0x98,
+	0xe3, 0xd8, 0x54, 0x9d, 0xa6, 0x22, 0x52, 0x33, 0x7d, 0x2f, 0x75, 0xba, 0x00, 0xe6, 0x57, 0x5b,
+	0x2d, 0x5c, 0xed, 0x8f, 0x60, 0xed, 0x44, 0x64, 0x61, 0x20, 0xb9, 0x38, 0x8f, 0x42, 0xb3, 0xdf,
+	0x3a, 0x6d, 0x20, 0x36, 0x30, 0x10, 0x7a, 0xbd, 0xa6, 0x58, 0x2b, 0x56, 0x35, 0x03, 0x10, 0xb2,
+	0xcd, 0xeb, 0x2f, 0x2d, 0x41, 0x2a, 0xa6, 0xa6, 0xd2, 0xf6, 0x4b, 0x77, 0xde, 0xa9, 0xaf, 0x47,
+	0xdd, 0x44, 0x3d, 0x6a, 0x9b, 0x6e, 0x43, 0x4f, 0x1f, 0x68, 0xfe, 0xdc, 0x84, 0x09, 0x97, 0x92,
+	0x9d, 0x98, 0x73, 0xb2, 0x26, 0xbc, 0x30, 0x90, 0xff, 0x57, 0x07, 0xaa, 0xba, 0x9c, 0x60, 0x8b,
+	0xcd, 0xb2, 0x28, 0xc8, 0x53, 0xea, 0x3a, 0xad, 0xb1, 0x2c, 0x7a, 0xce, 0xf5, 0x6d, 0xb3, 0x2c,
+	0xb2, 0x67, 0x84, 0x43, 0xb4, 0x1b, 0xa9, 0xe7, 0x85, 0x7c, 0xb8, 0x4e, 0x81, 0x65, 0x91, 0xcd,
+	0x7e, 0xf8, 0xd8, 0x08, 0x53, 0x21, 0x78, 0x6c, 0x1f, 0x1b, 0x23, 0x7d, 0x64, 0x55, 0xba, 0x5e,
+	0x40, 0xbb, 0x23, 0xbc, 0x9d, 0x30, 0x8e, 0x78, 0xa2, 0x90, 0x51, 0x35, 0xbd, 0x9a, 0x01, 0xcc,
+	0xed, 0xa8, 0x34, 0x8b, 0x42, 0xf3, 0x3c, 0xa8, 0x53, 0x2b, 0xf9, 0xbf, 0x85, 0xaa, 0x2e, 0x6c,
+	0x4b, 0x4f, 0x15, 0xe3, 0x9e, 0x73, 0x19, 0xfd, 0x26, 0x4c, 0x27, 0x13, 0x96, 0xe4, 0x77, 0x9b,
+	0x8b, 0x79, 0xd5, 0x28, 0x2f, 0x55, 0x0d, 0xd3, 0xca, 0x99, 0x6b, 0x35, 0x02, 0xa2, 0x5c, 0x88,
+	0x54, 0x58, 0xbb, 0x8c, 0xe0, 0xff, 0xd3, 0x81, 0xf2, 0xe0, 0xd5, 0xe1, 0x7b, 0xd7, 0xbe, 0x83,
+	0x3e, 0xc7, 0x14, 0x9f, 0xf0, 0x44, 0xd9, 0xd5, 0x17, 0x00, 0xd6, 0x9a, 0x71, 0x94, 0x9c, 0x70,
+	0x91, 0x89, 0x28, 0x51, 0xd6, 0x8e, 0x22, 0x84, 0xdf, 0x1e, 0x31, 0xc5, 0x8e, 0x99, 0xe4, 0xd6,
+	0xa4, 0xb9, 0x8c, 0x8e, 0x36, 0x95, 0x3c, 0x37, 0x4a, 0x8f, 0xc9, 0x5d, 0x00, 0x6d, 0x5c, 0x30,
+	0x0f, 0xab, 0x3a, 0xad, 0x6b, 0x64, 0x0f, 0xfd, 0xf0, 0x73, 0x58, 0x37, 0xea, 0xdc, 0x0b, 0x8c,
+	0x9b, 0xad, 0x69, 0x30, 0x77, 0x83, 0x3f, 0x38, 0x50, 0x1e, 0x1e, 0x0e, 0xd0, 0xf6, 0x53, 0x96,
+	0x8c, 0xe4, 0x29, 0x3b, 0x9b, 0x97, 0xf2, 0x39, 0xa0, 0xb3, 0x34, 0x17, 0xe7, 0x5c, 0x04, 0x85,
+	0x8a, 0x0e, 0x06, 0xd2, 0xfd, 0xb5, 0x07, 0xab, 0x45, 0xa7, 0xa8, 0xd3, 0x5c, 0x44, 0xc3, 0x59,
+	0x9c, 0x25, 0x36, 0x19, 0xe8, 0x31, 0xba, 0x67, 0x18, 0x65, 0xa7, 0x5c, 0x04, 0x72, 0x1a, 0x29,
+	0x6e, 0x37, 0xd5, 0x30, 0xd8, 0x00, 0x21, 0x7f, 0x1b, 0x40, 0x37, 0x4e, 0x9c, 0x8d, 0xb8, 0xf8,
+	0xa1, 0x15, 0xdf, 0xff, 0x97, 0x03, 0x15, 0x9c, 0x36, 0x8f, 0x4b, 0xa7, 0x10, 0x97, 0x57, 0x45,
+	0x7d, 0x13, 0xca, 0x53, 0x11, 0xe7, 0x8e, 0x31, 0x15, 0xf1, 0xd2, 0x25, 0x57, 0x2e, 0x5c, 0xf2,
+	0x4f, 0x60, 0xf5, 0x54, 0x1b, 0x25, 0xf5, 0x0b, 0xb7, 0xd1, 0x26, 0x4b, 0x8d, 0x9e, 0x56, 0xd1,
+	0x9c, 0x82, 0x76, 0x9c, 0xa6, 0x52, 0xd9, 0xcb, 0xd1, 0x63, 0xdd, 0x8c, 0x98, 0xb0, 0x5e, 0xb5,
+	0xcd, 0x88, 0x96, 0xf0, 0x3a, 0x63, 0xa6, 0x78, 0x12, 0xce, 0x82, 0xc4, 0x84, 0x7c, 0x85, 0xd6,
+	0x2d, 0xd2, 0x93, 0xfe, 0xdf, 0x1d, 0xb8, 0x71, 0x18, 0xc9, 0x0b, 0x7f, 0x81, 0xdc, 0x86, 0x7a,
+	0xc6, 0x4e, 0x78, 0x20, 0xa3, 0xef, 0xf2, 0xdd, 0xba, 0x08, 0x0c, 0xa2, 0xef, 0x38, 0x7e, 0x51,
+	0x2b, 0x55, 0x7a, 0xc6, 0xf3, 0xee, 0x46, 0xd3, 0x87, 0x08, 0x2c, 0xff, 0x7d, 0x52, 0xfe, 0xaf,
+	0xff, 0x3e, 0xa9, 0xfc, 0x80, 0xbf, 0x4f, 0xfc, 0x10, 0x48, 0xd1, 0x68, 0xfb, 0x88, 0xbd, 0x0f,
+	0xe6, 0x8f, 0x21, 0x69, 0xff, 0xb5, 0xb9, 0xf0, 0x8a, 0xb5, 0x4a, 0x7c, 0xc2, 0x27, 0xfc, 0xad,
+	0x0a, 0xde, 0xd9, 0xc4, 0x3a, 0xc2, 0xfd, 0x7c, 0x23, 0xfe, 0x27, 0x70, 0x73, 0xa0, 0x7d, 0xd1,
+	0xa4, 0x3f, 0x7b, 0x36, 0x7e, 0x0a, 0xb7, 0x96, 0x61, 0xbb, 0x3a, 0xbe, 0x14, 0xa7, 0x93, 0x00,
+	0x5f, 0x5a, 0xf9, 0x3b, 0xc9, 0x4d, 0xa6, 0x13, 0xac, 0x9d, 0x12, 0x95, 0x13, 0xf6, 0xd6, 0x2a,
+	0xcd, 0x63, 0xc9, 0x9d, 0xb0, 0xb7, 0x46, 0x79, 0x17, 0x00, 0x67, 0xb2, 0x13, 0x9e, 0x28, 0x69,
+	0xd3, 0x1f, 0x7e, 0xeb, 0xb1, 0x06, 0xbe, 0xfc, 0x15, 0x34, 0x2f, 0x3e, 0x22, 0xc9, 0x5d, 0xf8,
+	0x74, 0x48, 0x1f, 0x1f, 0x1c, 0x74, 0xf7, 0x82, 0xfd, 0x2e, 0xed, 0xec, 0x0d, 0xbb, 0x2f, 0x7b,
+	0xc1, 0x51, 0xef, 0x79, 0xef, 0xe5, 0xb7, 0xbd, 0xe6, 0x0a, 0x69, 0xc0, 0x6a, 0xb7, 0xf7, 0x94,
+	0x76, 0x06, 0x83, 0xa6, 0x43, 0x00, 0x6a, 0x1d, 0x33, 0x2e, 0x7d, 0xf9, 0x10, 0xea, 0xf3, 0xfe,
+	0x92, 0x5c, 0x87, 0x46, 0xb7, 0x1f, 0xf4, 0x5e, 0x0e, 0x83, 0xa3, 0x41, 0x67, 0xbf, 0xb9, 0x42,
+	0x5c, 0xa8, 0x74, 0xfb, 0xe7, 0xdb, 0x4d, 0xc7, 0x8e, 0x76, 0x9a, 0xa5, 0xf6, 0x1f, 0x1d, 0xa8,
+	0xe9, 0xf3, 0x13, 0x64, 0x1f, 0xea, 0xf3, 0x7f, 0x0e, 0xc8, 0xbc, 0x8d, 0xb8, 0xf8, 0xff, 0xd9,
+	0xc6, 0xa7, 0x97, 0x68, 0x6c, 0x25, 0x5d, 0x79, 0xe8, 0x90, 0xe7, 0xb0, 0x56, 0x3c, 0x3f, 0x72,
+	0x7b, 0xfe, 0x74, 0x78, 0xf7, 0xb0, 0x37, 0xee, 0x5c, 0xae, 0xcc, 0x3f, 0xd7, 0xfe, 0xde, 0x01,
+	0xf7, 0xe5, 0xb1, 0x49, 0x19, 0x1f, 0xc9, 0xbe, 0x0e, 0xc0, 0xc2, 0xb7, 0xc8, 0x9c, 0xfc, 0x4e,
+	0x90, 0x6c, 0x6c, 0x5c, 0xa6, 0xca, 0x3f, 0xf4, 0x51, 0xb7, 0x79, 0x5c, 0xd3, 0x89, 0xe2, 0xd1,
+	0x7f, 0x06, 0x00, 0xa7, 0x43, 0x76, 0x2b, 0x07, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1306,3 +2528,175 @@ var _Tracer_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "tracer.proto",
 }
+
+// ObserverClient is the client API for Observer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ObserverClient interface {
+	GetTraces(ctx context.Context, in *GetTracesRequest, opts ...grpc.CallOption) (Observer_GetTracesClient, error)
+	ListTraces(ctx context.Context, in *ListTracesRequest, opts ...grpc.CallOption) (*ListTracesResponse, error)
+	ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (*ServerStatusResponse, error)
+}
+
+type observerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewObserverClient(cc grpc.ClientConnInterface) ObserverClient {
+	return &observerClient{cc}
+}
+
+func (c *observerClient) GetTraces(ctx context.Context, in *GetTracesRequest, opts ...grpc.CallOption) (Observer_GetTracesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Observer_serviceDesc.Streams[0], "/tracer.Observer/GetTraces", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &observerGetTracesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Observer_GetTracesClient interface {
+	Recv() (*GetTracesResponse, error)
+	grpc.ClientStream
+}
+
+type observerGetTracesClient struct {
+	grpc.ClientStream
+}
+
+func (x *observerGetTracesClient) Recv() (*GetTracesResponse, error) {
+	m := new(GetTracesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *observerClient) ListTraces(ctx context.Context, in *ListTracesRequest, opts ...grpc.CallOption) (*ListTracesResponse, error) {
+	out := new(ListTracesResponse)
+	err := c.cc.Invoke(ctx, "/tracer.Observer/ListTraces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (*ServerStatusResponse, error) {
+	out := new(ServerStatusResponse)
+	err := c.cc.Invoke(ctx, "/tracer.Observer/ServerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ObserverServer is the server API for Observer service.
+type ObserverServer interface {
+	GetTraces(*GetTracesRequest, Observer_GetTracesServer) error
+	ListTraces(context.Context, *ListTracesRequest) (*ListTracesResponse, error)
+	ServerStatus(context.Context, *ServerStatusRequest) (*ServerStatusResponse, error)
+}
+
+// UnimplementedObserverServer can be embedded to have forward compatible implementations.
+type UnimplementedObserverServer struct {
+}
+
+func (*UnimplementedObserverServer) GetTraces(req *GetTracesRequest, srv Observer_GetTracesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTraces not implemented")
+}
+func (*UnimplementedObserverServer) ListTraces(ctx context.Context, req *ListTracesRequest) (*ListTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTraces not implemented")
+}
+func (*UnimplementedObserverServer) ServerStatus(ctx context.Context, req *ServerStatusRequest) (*ServerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerStatus not implemented")
+}
+
+func RegisterObserverServer(s *grpc.Server, srv ObserverServer) {
+	s.RegisterService(&_Observer_serviceDesc, srv)
+}
+
+func _Observer_GetTraces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTracesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObserverServer).GetTraces(m, &observerGetTracesServer{stream})
+}
+
+type Observer_GetTracesServer interface {
+	Send(*GetTracesResponse) error
+	grpc.ServerStream
+}
+
+type observerGetTracesServer struct {
+	grpc.ServerStream
+}
+
+func (x *observerGetTracesServer) Send(m *GetTracesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Observer_ListTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).ListTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tracer.Observer/ListTraces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).ListTraces(ctx, req.(*ListTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_ServerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).ServerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tracer.Observer/ServerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).ServerStatus(ctx, req.(*ServerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Observer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tracer.Observer",
+	HandlerType: (*ObserverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTraces",
+			Handler:    _Observer_ListTraces_Handler,
+		},
+		{
+			MethodName: "ServerStatus",
+			Handler:    _Observer_ServerStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetTraces",
+			Handler:       _Observer_GetTraces_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tracer.proto",
+}